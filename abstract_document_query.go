@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"reflect"
 	"strconv"
 	"strings"
@@ -47,6 +48,11 @@ type abstractDocumentQuery struct {
 	theWaitForNonStaleResults bool
 
 	includes []string
+	// counters, time series etc. to include, nil if none
+	queryIncludes *QueryIncludeBuilder
+
+	// client-side transformations of results, applied by GetResults
+	resultTransformers []*ResultTransformer
 
 	queryStats *QueryStatistics
 
@@ -54,6 +60,8 @@ type abstractDocumentQuery struct {
 
 	disableCaching bool
 
+	projectionBehavior ProjectionBehavior
+
 	isInMoreLikeThis bool
 
 	// Go doesn't allow comparing functions so to remove we use index returned
@@ -163,6 +171,9 @@ func (q *abstractDocumentQuery) initializeQueryOperation() (*queryOperation, err
 }
 
 func (q *abstractDocumentQuery) GetIndexQuery() (*IndexQuery, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
 	query, err := q.string()
 	if err != nil {
 		return nil, err
@@ -367,6 +378,24 @@ func (q *abstractDocumentQuery) include(path string) {
 	q.includes = append(q.includes, path)
 }
 
+func (q *abstractDocumentQuery) includeWithBuilder(builder *QueryIncludeBuilder) error {
+	if err := builder.validate(); err != nil {
+		return err
+	}
+	q.includes = append(q.includes, builder.documentsToInclude...)
+
+	if q.queryIncludes == nil {
+		q.queryIncludes = &QueryIncludeBuilder{}
+	}
+	dst := q.queryIncludes
+	dst.countersToInclude = append(dst.countersToInclude, builder.countersToInclude...)
+	dst.includeAllCounters = dst.includeAllCounters || builder.includeAllCounters
+	dst.timeSeriesToInclude = append(dst.timeSeriesToInclude, builder.timeSeriesToInclude...)
+	dst.compareExchangeValuesToInclude = append(dst.compareExchangeValuesToInclude, builder.compareExchangeValuesToInclude...)
+	dst.revisionsToInclude = append(dst.revisionsToInclude, builder.revisionsToInclude...)
+	return dst.validate()
+}
+
 func (q *abstractDocumentQuery) take(count int) {
 	q.pageSize = &count
 }
@@ -376,6 +405,9 @@ func (q *abstractDocumentQuery) skip(count int) {
 }
 
 func (q *abstractDocumentQuery) whereLucene(fieldName string, whereClause string) error {
+	if fieldName == "" {
+		return newIllegalArgumentError("fieldName cannot be empty")
+	}
 	var err error
 	fieldName, err = q.ensureValidFieldName(fieldName, false)
 	if err != nil {
@@ -901,6 +933,14 @@ func (q *abstractDocumentQuery) whereLessThanOrEqual(fieldName string, value int
 }
 
 func (q *abstractDocumentQuery) whereRegex(fieldName string, pattern string) error {
+	if fieldName == "" {
+		return newIllegalArgumentError("fieldName cannot be empty")
+	}
+	var err error
+	fieldName, err = q.ensureValidFieldName(fieldName, false)
+	if err != nil {
+		return err
+	}
 	tokensRef, err := q.getCurrentWhereTokensRef()
 	if err != nil {
 		return err
@@ -1016,6 +1056,10 @@ func (q *abstractDocumentQuery) fuzzy(fuzzy float64) error {
 		return newIllegalStateError("Missing where clause")
 	}
 
+	if whereToken.whereOperator != whereOperatorEquals {
+		return newIllegalStateError("Fuzzy can only be used right after where clause with equals operator")
+	}
+
 	if fuzzy < 0.0 || fuzzy > 1.0 {
 		return newIllegalArgumentError("Fuzzy distance must be between 0.0 and 1.0")
 	}
@@ -1041,6 +1085,10 @@ func (q *abstractDocumentQuery) proximity(proximity int) error {
 		return newIllegalStateError("Missing where clause")
 	}
 
+	if whereToken.whereOperator != whereOperatorSearch {
+		return newIllegalStateError("Proximity can only be used right after search clause")
+	}
+
 	if proximity < 1 {
 		return newIllegalArgumentError("Proximity distance must be a positive number")
 	}
@@ -1081,6 +1129,25 @@ func (q *abstractDocumentQuery) orderByDescendingWithOrdering(field string, orde
 	return nil
 }
 
+func (q *abstractDocumentQuery) orderByWithSorter(field string, sorterName string, descending bool) error {
+	if err := q.assertNoRawQuery(); err != nil {
+		return err
+	}
+	if stringIsBlank(sorterName) {
+		return newIllegalArgumentError("sorterName cannot be empty")
+	}
+	f, err := q.ensureValidFieldName(field, false)
+	if err != nil {
+		return err
+	}
+	if descending {
+		q.orderByTokens = append(q.orderByTokens, orderByTokenCreateDescendingWithSorter(f, sorterName))
+	} else {
+		q.orderByTokens = append(q.orderByTokens, orderByTokenCreateAscendingWithSorter(f, sorterName))
+	}
+	return nil
+}
+
 func (q *abstractDocumentQuery) orderByScore() error {
 	if err := q.assertNoRawQuery(); err != nil {
 		return err
@@ -1134,6 +1201,7 @@ func (q *abstractDocumentQuery) generateIndexQuery(query string) *IndexQuery {
 	indexQuery.waitForNonStaleResultsTimeout = q.timeout
 	indexQuery.queryParameters = q.queryParameters
 	indexQuery.disableCaching = q.disableCaching
+	indexQuery.projectionBehavior = q.projectionBehavior
 
 	if q.pageSize != nil {
 		indexQuery.pageSize = *q.pageSize
@@ -1200,7 +1268,9 @@ func (q *abstractDocumentQuery) string() (string, error) {
 		return "", err
 	}
 	err = q.buildOrderBy(queryText)
-
+	if err != nil {
+		return "", err
+	}
 	err = q.buildLoad(queryText)
 	if err != nil {
 		return "", err
@@ -1218,17 +1288,10 @@ func (q *abstractDocumentQuery) string() (string, error) {
 }
 
 func (q *abstractDocumentQuery) buildInclude(queryText *strings.Builder) error {
-	if len(q.includes) == 0 {
-		return nil
-	}
+	var parts []string
 
 	q.includes = stringArrayRemoveDuplicates(q.includes)
-	queryText.WriteString(" include ")
-	for i, include := range q.includes {
-		if i > 0 {
-			queryText.WriteString(",")
-		}
-
+	for _, include := range q.includes {
 		requiredQuotes := false
 
 		for _, ch := range include {
@@ -1239,17 +1302,53 @@ func (q *abstractDocumentQuery) buildInclude(queryText *strings.Builder) error {
 		}
 
 		if requiredQuotes {
-			s := strings.Replace(include, "'", "\\'", -1)
-			queryText.WriteString("'")
-			queryText.WriteString(s)
-			queryText.WriteString("'")
+			parts = append(parts, quoteIncludeString(include))
 		} else {
-			queryText.WriteString(include)
+			parts = append(parts, include)
 		}
 	}
+
+	if b := q.queryIncludes; b != nil {
+		if b.includeAllCounters {
+			parts = append(parts, "counters()")
+		}
+		for _, name := range stringArrayRemoveDuplicates(b.countersToInclude) {
+			parts = append(parts, "counters("+quoteIncludeString(name)+")")
+		}
+		for _, ts := range b.timeSeriesToInclude {
+			s := "timeseries(" + quoteIncludeString(ts.name)
+			if ts.from != nil || ts.to != nil {
+				s += ", " + timeIncludeString(ts.from) + ", " + timeIncludeString(ts.to)
+			}
+			parts = append(parts, s+")")
+		}
+		for _, path := range stringArrayRemoveDuplicates(b.compareExchangeValuesToInclude) {
+			parts = append(parts, "cmpxchg("+quoteIncludeString(path)+")")
+		}
+		for _, path := range stringArrayRemoveDuplicates(b.revisionsToInclude) {
+			parts = append(parts, "revisions("+quoteIncludeString(path)+")")
+		}
+	}
+
+	if len(parts) == 0 {
+		return nil
+	}
+	queryText.WriteString(" include ")
+	queryText.WriteString(strings.Join(parts, ","))
 	return nil
 }
 
+func quoteIncludeString(s string) string {
+	return "'" + strings.Replace(s, "'", "\\'", -1) + "'"
+}
+
+func timeIncludeString(t *time.Time) string {
+	if t == nil {
+		return "null"
+	}
+	return "'" + Time(t.UTC()).Format() + "'"
+}
+
 func (q *abstractDocumentQuery) intersect() error {
 
 	tokensRef, err := q.getCurrentWhereTokensRef()
@@ -1364,7 +1463,7 @@ func (q *abstractDocumentQuery) containsAll(fieldName string, values []interface
 
 func (q *abstractDocumentQuery) distinct() error {
 	if q.isDistinct() {
-		return newIllegalStateError("The is already a distinct query")
+		return newIllegalStateError("This is already a distinct query")
 	}
 
 	if len(q.selectTokens) == 0 {
@@ -1615,6 +1714,8 @@ func (q *abstractDocumentQuery) negateIfNeeded(tokensRef *[]queryToken, fieldNam
 	return nil
 }
 
+// flattens nested collections so that e.g. WhereIn("Name", []interface{}{[]string{"a", "b"}})
+// is equivalent to WhereIn("Name", []interface{}{"a", "b"})
 func abstractDocumentQueryUnpackCollection(items []interface{}) []interface{} {
 	var results []interface{}
 
@@ -1622,9 +1723,24 @@ func abstractDocumentQueryUnpackCollection(items []interface{}) []interface{} {
 		if itemCollection, ok := item.([]interface{}); ok {
 			els := abstractDocumentQueryUnpackCollection(itemCollection)
 			results = append(results, els...)
-		} else {
+			continue
+		}
+
+		rv := reflect.ValueOf(item)
+		kind := rv.Kind()
+		// []byte is a single value, not a collection
+		isCollection := (kind == reflect.Slice || kind == reflect.Array) && rv.Type().Elem().Kind() != reflect.Uint8
+		if !isCollection {
 			results = append(results, item)
+			continue
+		}
+		n := rv.Len()
+		itemCollection := make([]interface{}, n)
+		for i := 0; i < n; i++ {
+			itemCollection[i] = rv.Index(i).Interface()
 		}
+		els := abstractDocumentQueryUnpackCollection(itemCollection)
+		results = append(results, els...)
 	}
 
 	return results
@@ -2052,7 +2168,7 @@ func (q *abstractDocumentQuery) assertIsDynamicQuery(dynamicField DynamicSpatial
 	return nil
 }
 
-func (q *abstractDocumentQuery) initSync() error {
+func (q *abstractDocumentQuery) initSync(ctx context.Context) error {
 	if q.queryOperation != nil {
 		return nil
 	}
@@ -2071,10 +2187,10 @@ func (q *abstractDocumentQuery) initSync() error {
 	if err != nil {
 		return err
 	}
-	return q.executeActualQuery()
+	return q.executeActualQuery(ctx)
 }
 
-func (q *abstractDocumentQuery) executeActualQuery() error {
+func (q *abstractDocumentQuery) executeActualQuery(ctx context.Context) error {
 	{
 		context := q.queryOperation.enterQueryContext()
 		defer func() {
@@ -2085,7 +2201,7 @@ func (q *abstractDocumentQuery) executeActualQuery() error {
 		if err != nil {
 			return err
 		}
-		if err = q.theSession.GetRequestExecutor().ExecuteCommand(command, q.theSession.sessionInfo); err != nil {
+		if err = q.theSession.GetRequestExecutor().ExecuteCommandWithContext(ctx, command, q.theSession.sessionInfo); err != nil {
 			return err
 		}
 		if err = q.queryOperation.setResult(command.Result); err != nil {
@@ -2098,7 +2214,7 @@ func (q *abstractDocumentQuery) executeActualQuery() error {
 
 // GetQueryResult returns results of a query
 func (q *abstractDocumentQuery) getQueryResult() (*QueryResult, error) {
-	err := q.initSync()
+	err := q.initSync(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -2115,6 +2231,11 @@ func checkValidGetResultsArg(v interface{}, argName string) error {
 // GetResults executes the query and sets results to returned values.
 // results should be of type *[]<type>
 func (q *abstractDocumentQuery) GetResults(results interface{}) error {
+	return q.GetResultsWithContext(context.Background(), results)
+}
+
+// GetResultsWithContext is like GetResults but gives up when ctx is cancelled
+func (q *abstractDocumentQuery) GetResultsWithContext(ctx context.Context, results interface{}) error {
 	// Note: in Java it's called ToList
 	if q.err != nil {
 		return q.err
@@ -2122,7 +2243,10 @@ func (q *abstractDocumentQuery) GetResults(results interface{}) error {
 	if q.err = checkValidGetResultsArg(results, "results"); q.err != nil {
 		return q.err
 	}
-	return q.executeQueryOperation(results, -1)
+	if len(q.resultTransformers) > 0 {
+		return q.getTransformedResults(ctx, results)
+	}
+	return q.executeQueryOperation(ctx, results, -1)
 }
 
 func checkValidSingleArg(v interface{}, argName string) error {
@@ -2150,7 +2274,7 @@ func (q *abstractDocumentQuery) First(result interface{}) error {
 	// create a pointer to a slice. executeQueryOperation creates the actual slice
 	sliceType := reflect.SliceOf(tp)
 	slicePtr := reflect.New(sliceType)
-	err := q.executeQueryOperation(slicePtr.Interface(), 1)
+	err := q.executeQueryOperation(context.Background(), slicePtr.Interface(), 1)
 	if err != nil {
 		return err
 	}
@@ -2181,7 +2305,7 @@ func (q *abstractDocumentQuery) Single(result interface{}) error {
 	// create a pointer to a slice. executeQueryOperation creates the actual slice
 	sliceType := reflect.SliceOf(tp)
 	slicePtr := reflect.New(sliceType)
-	err := q.executeQueryOperation(slicePtr.Interface(), 2)
+	err := q.executeQueryOperation(context.Background(), slicePtr.Interface(), 2)
 	if err != nil {
 		return err
 	}
@@ -2205,6 +2329,79 @@ func (q *abstractDocumentQuery) Count() (int, error) {
 	return queryResult.TotalResults, nil
 }
 
+// LongCount is like Count but returns the number of results as int64
+func (q *abstractDocumentQuery) LongCount() (int64, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	q.take(0)
+	queryResult, err := q.getQueryResult()
+	if err != nil {
+		return 0, err
+	}
+	if queryResult.LongTotalResults == 0 {
+		return int64(queryResult.TotalResults), nil
+	}
+	return queryResult.LongTotalResults, nil
+}
+
+// GetIndexEntries runs the query and returns raw index entries it matched
+// instead of documents. Useful for understanding why a query doesn't match
+// a given document.
+func (q *abstractDocumentQuery) GetIndexEntries() ([]map[string]interface{}, error) {
+	if err := q.theSession.enter("GetIndexEntries"); err != nil {
+		return nil, err
+	}
+	defer q.theSession.exit()
+
+	if q.err != nil {
+		return nil, q.err
+	}
+	indexQuery, err := q.GetIndexQuery()
+	if err != nil {
+		return nil, err
+	}
+	command, err := NewQueryCommand(q.conventions, indexQuery, false, true)
+	if err != nil {
+		return nil, err
+	}
+	if err = q.theSession.incrementRequestCount(); err != nil {
+		return nil, err
+	}
+	if err = q.theSession.GetRequestExecutor().ExecuteCommand(command, q.theSession.sessionInfo); err != nil {
+		return nil, err
+	}
+	if command.Result == nil {
+		return nil, nil
+	}
+	return command.Result.Results, nil
+}
+
+// Explain returns server's explanation of which index would be used
+// by the query and why. Only meaningful for dynamic (collection) queries
+func (q *abstractDocumentQuery) Explain() ([]*ExplainQueryResult, error) {
+	if err := q.theSession.enter("Explain"); err != nil {
+		return nil, err
+	}
+	defer q.theSession.exit()
+
+	if q.err != nil {
+		return nil, q.err
+	}
+	indexQuery, err := q.GetIndexQuery()
+	if err != nil {
+		return nil, err
+	}
+	command := NewExplainQueryCommand(q.conventions, indexQuery)
+	if err = q.theSession.incrementRequestCount(); err != nil {
+		return nil, err
+	}
+	if err = q.theSession.GetRequestExecutor().ExecuteCommand(command, q.theSession.sessionInfo); err != nil {
+		return nil, err
+	}
+	return command.Result, nil
+}
+
 // Any returns true if query returns at least one result
 func (q *abstractDocumentQuery) Any() (bool, error) {
 	if q.err != nil {
@@ -2215,7 +2412,7 @@ func (q *abstractDocumentQuery) Any() (bool, error) {
 
 		q.take(1)
 
-		err := q.initSync()
+		err := q.initSync(context.Background())
 		if err != nil {
 			return false, err
 		}
@@ -2230,12 +2427,17 @@ func (q *abstractDocumentQuery) Any() (bool, error) {
 	return queryResult.TotalResults > 0, nil
 }
 
-func (q *abstractDocumentQuery) executeQueryOperation(results interface{}, take int) error {
+func (q *abstractDocumentQuery) executeQueryOperation(ctx context.Context, results interface{}, take int) error {
+	if err := q.theSession.enter("Query"); err != nil {
+		return err
+	}
+	defer q.theSession.exit()
+
 	if take != -1 && (q.pageSize == nil || *q.pageSize > take) {
 		q.take(take)
 	}
 
-	err := q.initSync()
+	err := q.initSync(ctx)
 	if err != nil {
 		return err
 	}
@@ -2287,17 +2489,18 @@ func (q *abstractDocumentQuery) Lazily() (*Lazy, error) {
 	return q.theSession.session.addLazyOperation(lazyQueryOperation, nil, nil), nil
 }
 
-// CountLazily returns a lazy operation that returns number of results in a query. It'll set *count to
-// number of results after Lazy.GetResult() is called.
-// results should be of type []<type> and is only provided so that we know this is a query for <type>
-// TODO: figure out better API.
+// CountLazily returns a lazy operation that returns number of results in a query.
+// The count is executed together with other lazy operations and can be
+// retrieved with Lazy.GetValue(&n) where n is an int.
 func (q *abstractDocumentQuery) CountLazily() (*Lazy, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
 	if q.queryOperation == nil {
 		q.take(0)
-		var err error
-		q.queryOperation, err = q.initializeQueryOperation()
-		if err != nil {
-			return nil, err
+		q.queryOperation, q.err = q.initializeQueryOperation()
+		if q.err != nil {
+			return nil, q.err
 		}
 	}
 
@@ -2350,8 +2553,11 @@ func (q *abstractDocumentQuery) assertCanSuggest() error {
 		return newIllegalStateError("Cannot add suggest when WHERE statements are present.")
 	}
 
-	if len(q.selectTokens) > 0 {
-		return newIllegalStateError("Cannot add suggest when SELECT statements are present.")
+	// multiple suggestions are allowed, other projections are not
+	for _, token := range q.selectTokens {
+		if _, ok := token.(*suggestToken); !ok {
+			return newIllegalStateError("Cannot add suggest when SELECT statements are present.")
+		}
 	}
 
 	if len(q.orderByTokens) > 0 {
@@ -12,10 +12,11 @@ type IndexCreationTask struct {
 
 	Reduce string
 
-	Conventions       *DocumentConventions
-	AdditionalSources map[string]string
-	Priority          IndexPriority
-	LockMode          IndexLockMode
+	Conventions          *DocumentConventions
+	AdditionalSources    map[string]string
+	AdditionalAssemblies []*AdditionalAssembly
+	Priority             IndexPriority
+	LockMode             IndexLockMode
 
 	StoresStrings         map[string]FieldStorage
 	IndexesStrings        map[string]FieldIndexing
@@ -26,6 +27,9 @@ type IndexCreationTask struct {
 
 	OutputReduceToCollection string
 
+	// Configuration holds indexing options, e.g. "Indexing.MapTimeoutInSec"
+	Configuration IndexConfiguration
+
 	// Note: in Go IndexName must provided explicitly
 	// In Java it's dynamically calculated as getClass().getSimpleName()
 	IndexName string
@@ -70,10 +74,49 @@ func (t *IndexCreationTask) CreateIndexDefinition() *IndexDefinition {
 	validate := len(t.Maps) == 0
 
 	def := indexDefinitionBuilder.toIndexDefinition(t.Conventions, validate)
-	def.Maps = append(def.Maps, t.Maps...)
+	if len(t.Maps) > 0 {
+		def.Maps = append(def.Maps, t.Maps...)
+		// the type depends on the maps, e.g. JavaScript vs. C#
+		def.SetType(IndexTypeNone)
+		def.updateIndexTypeAndMaps()
+	}
+	def.AdditionalAssemblies = t.AdditionalAssemblies
+	for k, v := range t.Configuration {
+		def.GetConfiguration()[k] = v
+	}
 	return def
 }
 
+func (t *IndexCreationTask) validate() error {
+	if t.IndexName == "" {
+		return newIllegalArgumentError("IndexName cannot be empty")
+	}
+	if t.Map == "" && len(t.Maps) == 0 {
+		return newIllegalStateError("Map is required to generate an index, you cannot create an index without a valid Map property (in index %s).", t.IndexName)
+	}
+	return nil
+}
+
+// toIndexDefinition creates IndexDefinition to be sent to the server,
+// using conventions for the duration of the call
+func (t *IndexCreationTask) toIndexDefinition(conventions *DocumentConventions) (*IndexDefinition, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+
+	oldConventions := t.Conventions
+	defer func() { t.Conventions = oldConventions }()
+	if conventions != nil {
+		t.Conventions = conventions
+	}
+
+	indexDefinition := t.CreateIndexDefinition()
+	indexDefinition.Name = t.IndexName
+	indexDefinition.LockMode = t.LockMode
+	indexDefinition.Priority = t.Priority
+	return indexDefinition, nil
+}
+
 // IsMapReduce returns true if this is map-reduce index
 func (t *IndexCreationTask) IsMapReduce() bool {
 	return t.Reduce != ""
@@ -85,9 +128,6 @@ func (t *IndexCreationTask) Execute(store *DocumentStore, conventions *DocumentC
 }
 
 func (t *IndexCreationTask) putIndex(store *DocumentStore, conventions *DocumentConventions, database string) error {
-	oldConventions := t.Conventions
-	defer func() { t.Conventions = oldConventions }()
-
 	conv := conventions
 	if conv == nil {
 		conv = t.Conventions
@@ -95,12 +135,11 @@ func (t *IndexCreationTask) putIndex(store *DocumentStore, conventions *Document
 	if conv == nil {
 		conv = store.GetConventions()
 	}
-	t.Conventions = conv
 
-	indexDefinition := t.CreateIndexDefinition()
-	indexDefinition.Name = t.IndexName
-	indexDefinition.LockMode = t.LockMode
-	indexDefinition.Priority = t.Priority
+	indexDefinition, err := t.toIndexDefinition(conv)
+	if err != nil {
+		return err
+	}
 
 	op := NewPutIndexesOperation(indexDefinition)
 	if database == "" {
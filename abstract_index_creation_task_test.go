@@ -0,0 +1,85 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexCreationTaskToIndexDefinition(t *testing.T) {
+	task := NewIndexCreationTask("Users/ByName")
+	task.Map = "from u in docs.Users select new { u.Name }"
+	task.LockMode = IndexLockModeLockedIgnore
+	task.Priority = IndexPriorityHigh
+	task.Configuration = IndexConfiguration{"Indexing.MapTimeoutInSec": "30"}
+	task.Store("Name", FieldStorageYes)
+	task.Analyze("Name", "StandardAnalyzer")
+
+	conventions := NewDocumentConventions()
+	def, err := task.toIndexDefinition(conventions)
+	assert.NoError(t, err)
+	assert.Nil(t, task.Conventions)
+	assert.Equal(t, "Users/ByName", def.Name)
+	assert.Equal(t, IndexLockModeLockedIgnore, def.LockMode)
+	assert.Equal(t, IndexPriorityHigh, def.Priority)
+	assert.Equal(t, "30", def.Configuration["Indexing.MapTimeoutInSec"])
+	assert.Equal(t, FieldStorageYes, def.Fields["Name"].Storage)
+	assert.Equal(t, "StandardAnalyzer", def.Fields["Name"].Analyzer)
+
+	defs, err := indexCreationCreateIndexesToAdd([]*IndexCreationTask{task}, conventions)
+	assert.NoError(t, err)
+	assert.Equal(t, IndexLockModeLockedIgnore, defs[0].LockMode)
+
+	task.Priority = ""
+	defs, err = indexCreationCreateIndexesToAdd([]*IndexCreationTask{task}, conventions)
+	assert.NoError(t, err)
+	assert.Equal(t, IndexPriorityNormal, defs[0].Priority)
+
+	task.Map = ""
+	_, err = task.toIndexDefinition(conventions)
+	assert.Error(t, err)
+	_, err = indexCreationCreateIndexesToAdd([]*IndexCreationTask{task}, conventions)
+	assert.Error(t, err)
+	_, err = indexCreationCreateIndexesToAdd([]*IndexCreationTask{nil}, conventions)
+	assert.Error(t, err)
+}
+
+func TestIndexCreationTaskJavaScriptMaps(t *testing.T) {
+	task := NewIndexCreationTask("Users/ByNameJS")
+	task.Maps = []string{"map('Users', function (u) { return { Name: u.Name }; })"}
+	def, err := task.toIndexDefinition(NewDocumentConventions())
+	assert.NoError(t, err)
+	assert.Equal(t, IndexTypeJavaScriptMap, def.GetType())
+
+	task.Reduce = "groupBy(x => x.Name).aggregate(g => ({ Name: g.key }))"
+	def, err = task.toIndexDefinition(NewDocumentConventions())
+	assert.NoError(t, err)
+	assert.Equal(t, IndexTypeJavaScriptMapReduce, def.GetType())
+
+	task = NewIndexCreationTask("Users/ByName")
+	task.Maps = []string{"// users\nfrom u in docs.Users select new { u.Name }"}
+	assembly, err := AdditionalAssemblyFromNuGet("Newtonsoft.Json", "13.0.1", "", "Newtonsoft.Json")
+	assert.NoError(t, err)
+	task.AdditionalAssemblies = []*AdditionalAssembly{assembly}
+	def, err = task.toIndexDefinition(NewDocumentConventions())
+	assert.NoError(t, err)
+	assert.Equal(t, IndexTypeMap, def.GetType())
+	assert.Equal(t, "Newtonsoft.Json", def.AdditionalAssemblies[0].PackageName)
+}
+
+func TestAdditionalAssembly(t *testing.T) {
+	_, err := AdditionalAssemblyOnlyUsings()
+	assert.Error(t, err)
+	_, err = AdditionalAssemblyFromRuntime(" ")
+	assert.Error(t, err)
+	_, err = AdditionalAssemblyFromPath("")
+	assert.Error(t, err)
+	_, err = AdditionalAssemblyFromNuGet("Bogus", "", "")
+	assert.Error(t, err)
+
+	assembly, err := AdditionalAssemblyFromRuntime("System.Xml", "System.Xml")
+	assert.NoError(t, err)
+	d, err := jsonMarshal(assembly)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"AssemblyName":"System.Xml","Usings":["System.Xml"]}`, string(d))
+}
@@ -0,0 +1,78 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &AddEtlOperation{}
+)
+
+// AddEtlOperation creates a new ETL task
+type AddEtlOperation struct {
+	configuration IEtlConfiguration
+
+	Command *AddEtlCommand
+}
+
+// NewAddEtlOperation returns new AddEtlOperation. configuration is
+// *RavenEtlConfiguration, *SqlEtlConfiguration or *OlapEtlConfiguration
+//
+// Deprecated: use operations.NewAddEtlOperation.
+func NewAddEtlOperation(configuration IEtlConfiguration) (*AddEtlOperation, error) {
+	if err := validateEtlConfiguration(configuration); err != nil {
+		return nil, err
+	}
+	return &AddEtlOperation{
+		configuration: configuration,
+	}, nil
+}
+
+func (o *AddEtlOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewAddEtlCommand(o.configuration)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &AddEtlCommand{}
+
+// AddEtlCommand describes "add etl" command
+type AddEtlCommand struct {
+	RavenCommandBase
+
+	configuration IEtlConfiguration
+
+	Result *EtlOperationResult
+}
+
+// NewAddEtlCommand returns new AddEtlCommand
+func NewAddEtlCommand(configuration IEtlConfiguration) *AddEtlCommand {
+	return &AddEtlCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		configuration: configuration,
+	}
+}
+
+func (c *AddEtlCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/etl"
+
+	d, err := jsonMarshal(c.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpPut(url, d)
+}
+
+func (c *AddEtlCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
+
+// EtlOperationResult is a result of AddEtlOperation and UpdateEtlOperation
+type EtlOperationResult struct {
+	RaftCommandIndex int64 `json:"RaftCommandIndex"`
+	TaskID           int64 `json:"TaskId"`
+}
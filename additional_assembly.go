@@ -0,0 +1,53 @@
+package ravendb
+
+// AdditionalAssembly describes a .NET assembly or NuGet package that
+// C# index definitions can use
+type AdditionalAssembly struct {
+	AssemblyName     string   `json:"AssemblyName,omitempty"`
+	AssemblyPath     string   `json:"AssemblyPath,omitempty"`
+	PackageName      string   `json:"PackageName,omitempty"`
+	PackageVersion   string   `json:"PackageVersion,omitempty"`
+	PackageSourceURL string   `json:"PackageSourceUrl,omitempty"`
+	Usings           []string `json:"Usings"`
+}
+
+// AdditionalAssemblyOnlyUsings adds using directives without additional assemblies
+func AdditionalAssemblyOnlyUsings(usings ...string) (*AdditionalAssembly, error) {
+	if len(usings) == 0 {
+		return nil, newIllegalArgumentError("usings cannot be empty")
+	}
+	return &AdditionalAssembly{Usings: usings}, nil
+}
+
+// AdditionalAssemblyFromRuntime references an assembly available in the server's runtime
+func AdditionalAssemblyFromRuntime(assemblyName string, usings ...string) (*AdditionalAssembly, error) {
+	if stringIsBlank(assemblyName) {
+		return nil, newIllegalArgumentError("assemblyName cannot be empty")
+	}
+	return &AdditionalAssembly{AssemblyName: assemblyName, Usings: usings}, nil
+}
+
+// AdditionalAssemblyFromPath references an assembly file on the server
+func AdditionalAssemblyFromPath(assemblyPath string, usings ...string) (*AdditionalAssembly, error) {
+	if stringIsBlank(assemblyPath) {
+		return nil, newIllegalArgumentError("assemblyPath cannot be empty")
+	}
+	return &AdditionalAssembly{AssemblyPath: assemblyPath, Usings: usings}, nil
+}
+
+// AdditionalAssemblyFromNuGet references a NuGet package. packageSourceURL
+// is optional and defaults to nuget.org
+func AdditionalAssemblyFromNuGet(packageName string, packageVersion string, packageSourceURL string, usings ...string) (*AdditionalAssembly, error) {
+	if stringIsBlank(packageName) {
+		return nil, newIllegalArgumentError("packageName cannot be empty")
+	}
+	if stringIsBlank(packageVersion) {
+		return nil, newIllegalArgumentError("packageVersion cannot be empty")
+	}
+	return &AdditionalAssembly{
+		PackageName:      packageName,
+		PackageVersion:   packageVersion,
+		PackageSourceURL: packageSourceURL,
+		Usings:           usings,
+	}, nil
+}
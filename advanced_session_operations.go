@@ -26,6 +26,10 @@ func (o *AdvancedSessionOperations) Revisions() *RevisionsSessionOperations {
 	return o.s.Revisions()
 }
 
+func (o *AdvancedSessionOperations) ClusterTransaction() (*ClusterTransactionOperations, error) {
+	return o.s.ClusterTransaction()
+}
+
 func (o *AdvancedSessionOperations) Eagerly() *EagerSessionOperations {
 	return o.s.Eagerly()
 }
@@ -227,6 +231,51 @@ func (o *AdvancedSessionOperations) SetMaxNumberOfRequestsPerSession(n int) {
 	o.s.maxNumberOfRequestsPerSession = n
 }
 
+// AggressivelyCacheFor enables aggressive caching for requests made by this session.
+// Cached responses younger than cacheDuration are served without contacting the server.
+// Call returned CancelFunc to restore previous caching behavior.
+func (o *AdvancedSessionOperations) AggressivelyCacheFor(cacheDuration time.Duration) (CancelFunc, error) {
+	store := o.s.GetDocumentStore()
+	store.mu.Lock()
+	cachingUsed := store.aggressiveCachingUsed
+	store.mu.Unlock()
+	if !cachingUsed {
+		err := store.listenToChangesAndUpdateTheCache(o.s.DatabaseName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	info := o.s.sessionInfo
+	oldOpts := info.aggressiveCaching
+	info.aggressiveCaching = &AggressiveCacheOptions{
+		Duration: cacheDuration,
+	}
+	restorer := func() {
+		info.aggressiveCaching = oldOpts
+	}
+	return restorer, nil
+}
+
+// GetSessionInfo returns information about the session, which can be used
+// to set its load balancing context with SessionInfo.SetContext
+func (o *AdvancedSessionOperations) GetSessionInfo() *SessionInfo {
+	return o.s.sessionInfo
+}
+
+// DisableCaching disables HTTP caching for requests made by this session.
+// Responses are neither served from nor stored in the cache.
+// Call returned CancelFunc to restore previous caching behavior.
+func (o *AdvancedSessionOperations) DisableCaching() CancelFunc {
+	info := o.s.sessionInfo
+	oldNoCaching := info.noCaching
+	info.noCaching = true
+	restorer := func() {
+		info.noCaching = oldNoCaching
+	}
+	return restorer
+}
+
 /*
 String storeIdentifier();
 boolean isUseOptimisticConcurrency();
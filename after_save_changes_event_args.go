@@ -1,5 +1,7 @@
 package ravendb
 
+import "time"
+
 // AfterSaveChangesEventArgs describes arguments for "after save changes" listener
 type AfterSaveChangesEventArgs struct {
 	documentMetadata *MetadataAsDictionary
@@ -7,13 +9,18 @@ type AfterSaveChangesEventArgs struct {
 	Session    *InMemoryDocumentSessionOperations
 	DocumentID string
 	Entity     interface{}
+	// ChangeVector and LastModified are assigned by the server
+	ChangeVector string
+	LastModified time.Time
 }
 
-func newAfterSaveChangesEventArgs(session *InMemoryDocumentSessionOperations, documentID string, entity interface{}) *AfterSaveChangesEventArgs {
+func newAfterSaveChangesEventArgs(session *InMemoryDocumentSessionOperations, saved *SavedDocument) *AfterSaveChangesEventArgs {
 	return &AfterSaveChangesEventArgs{
-		Session:    session,
-		DocumentID: documentID,
-		Entity:     entity,
+		Session:      session,
+		DocumentID:   saved.ID,
+		Entity:       saved.Entity,
+		ChangeVector: saved.ChangeVector,
+		LastModified: saved.LastModified,
 	}
 }
 
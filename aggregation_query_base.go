@@ -148,6 +148,20 @@ func (q *AggregationDocumentQuery) AndAggregateByFacet(facet FacetBase) *Aggrega
 	return q
 }
 
+// AndAggregateBy adds another facet configured by builder
+func (q *AggregationDocumentQuery) AndAggregateBy(builder func(*FacetBuilder)) *AggregationDocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	facet, err := buildFacet(builder)
+	if err != nil {
+		q.err = err
+		return q
+	}
+	q.err = q.source.aggregateBy(facet)
+	return q
+}
+
 func (q *AggregationDocumentQuery) GetIndexQuery() (*IndexQuery, error) {
 	return q.source.GetIndexQuery()
 }
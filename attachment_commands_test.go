@@ -0,0 +1,163 @@
+package ravendb
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeadAttachmentCommand(t *testing.T) {
+	node := &ServerNode{URL: "http://127.0.0.1:8080", Database: "db"}
+	changeVector := "A:1-abc"
+	cmd, err := NewHeadAttachmentCommand("users/1", "photo", &changeVector)
+	assert.NoError(t, err)
+
+	req, err := cmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodHead, req.Method)
+	assert.Equal(t, `"A:1-abc"`, req.Header.Get(headersIfNoneMatch))
+
+	cmd.StatusCode = http.StatusNotModified
+	assert.NoError(t, cmd.SetResponse(nil, false))
+	assert.Equal(t, changeVector, cmd.Result)
+
+	cmd.StatusCode = http.StatusNotFound
+	assert.NoError(t, cmd.SetResponse(nil, false))
+	assert.Equal(t, "", cmd.Result)
+}
+
+func TestPutAttachmentCommandStreamsContent(t *testing.T) {
+	node := &ServerNode{URL: "http://127.0.0.1:8080", Database: "db"}
+
+	_, err := NewPutAttachmentCommand("users/1", "photo", nil, "", nil)
+	assert.Error(t, err)
+
+	stream := strings.NewReader("skip-content")
+	_, _ = stream.Read(make([]byte, 5))
+	cmd, err := NewPutAttachmentCommand("users/1", "photo", stream, "image/png", nil)
+	assert.NoError(t, err)
+
+	// the second request is what's sent when failing over to another node
+	for i := 0; i < 2; i++ {
+		req, err := cmd.CreateRequest(node)
+		assert.NoError(t, err)
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, int64(7), req.ContentLength)
+		d, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "content", string(d))
+
+		body, err := req.GetBody()
+		assert.NoError(t, err)
+		d, err = ioutil.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, "content", string(d))
+	}
+}
+
+func TestGetAttachmentCommandRange(t *testing.T) {
+	node := &ServerNode{URL: "http://127.0.0.1:8080", Database: "db"}
+
+	op := NewGetAttachmentRangeOperation("users/1", "photo", 2, 4)
+	cmd, err := op.GetCommand(nil, nil, nil)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, "bytes=2-4", req.Header.Get("Range"))
+
+	// a server that doesn't support ranges sends the whole content
+	rsp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("0123456789")),
+	}
+	_, err = op.Command.processResponse(nil, rsp, "")
+	assert.NoError(t, err)
+	d, err := ioutil.ReadAll(op.Command.Result.Data)
+	assert.NoError(t, err)
+	assert.Equal(t, "234", string(d))
+
+	op = NewGetAttachmentRangeOperation("users/1", "photo", 5, -1)
+	_, err = op.GetCommand(nil, nil, nil)
+	assert.NoError(t, err)
+	req, err = op.Command.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, "bytes=5-", req.Header.Get("Range"))
+
+	// the first byte only
+	op = NewGetAttachmentRangeOperation("users/1", "photo", 0, 0)
+	_, err = op.GetCommand(nil, nil, nil)
+	assert.NoError(t, err)
+	req, err = op.Command.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, "bytes=0-0", req.Header.Get("Range"))
+
+	_, err = NewGetAttachmentRangeOperation("users/1", "photo", 5, 4).GetCommand(nil, nil, nil)
+	assert.Error(t, err)
+	_, err = NewGetAttachmentRangeOperation("users/1", "photo", -1, 4).GetCommand(nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestGetAttachmentsCommand(t *testing.T) {
+	_, err := NewGetAttachmentsCommand(nil, AttachmentDocument)
+	assert.Error(t, err)
+	_, err = NewGetAttachmentsCommand([]*AttachmentRequest{{DocumentID: "users/1"}}, AttachmentDocument)
+	assert.Error(t, err)
+
+	cmd, err := NewGetAttachmentsCommand([]*AttachmentRequest{
+		{DocumentID: "users/1", Name: "a"},
+		{DocumentID: "users/2", Name: "b"},
+	}, "")
+	assert.NoError(t, err)
+
+	body := `{"AttachmentsMetadata":[{"Name":"a","Size":3,"DocumentId":"users/1"},{"Name":"b","Size":2,"DocumentId":"users/2"}]}abcde`
+	rsp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+	_, err = cmd.processResponse(nil, rsp, "")
+	assert.NoError(t, err)
+	it := cmd.Result
+	defer it.Close()
+
+	// content of an attachment is skipped if not read
+	first, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", first.Details.Name)
+
+	second, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "users/2", second.Details.DocumentID)
+	d, err := ioutil.ReadAll(second.Data)
+	assert.NoError(t, err)
+	assert.Equal(t, "de", string(d))
+
+	_, err = it.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestPutAttachmentCommandFailover(t *testing.T) {
+	nodeA, nodeB := newFakeNode(), newFakeNode()
+	defer nodeA.server.Close()
+	defer nodeB.server.Close()
+	re := newFailoverTestExecutor(nodeA, nodeB)
+	defer re.Close()
+	nodeA.setDown(true)
+
+	// a non-seekable stream might be partly consumed by the failed request
+	cmd, err := NewPutAttachmentCommand("users/1", "photo", ioutil.NopCloser(strings.NewReader("content")), "", nil)
+	assert.NoError(t, err)
+	err = re.ExecuteCommand(cmd, nil)
+	assert.IsType(t, &IllegalStateError{}, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&nodeB.requests))
+
+	cmd, err = NewPutAttachmentCommand("users/1", "photo", strings.NewReader("content"), "", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&nodeB.requests))
+}
@@ -9,9 +9,13 @@ import (
 
 // AttachmentResult represents an attachment
 type AttachmentResult struct {
-	Data     io.Reader
-	Details  *AttachmentDetails
-	response *http.Response
+	Data    io.Reader
+	Details *AttachmentDetails
+	// NotModified is true if attachment was requested with a change vector
+	// and it didn't change. In that case Data is nil and Details only
+	// has the name, document id and change vector
+	NotModified bool
+	response    *http.Response
 }
 
 func newAttachmentResult(response *http.Response, details *AttachmentDetails) *AttachmentResult {
@@ -24,7 +28,7 @@ func newAttachmentResult(response *http.Response, details *AttachmentDetails) *A
 
 // Close closes the attachment
 func (r *AttachmentResult) Close() error {
-	if r.response.Body != nil {
+	if r.response != nil && r.response.Body != nil {
 		return r.response.Body.Close()
 	}
 	return nil
@@ -0,0 +1,75 @@
+package ravendb
+
+// GetBackupConfigurationScript describes a script executed by the server
+// to obtain backup destination settings, e.g. to read secrets from a vault
+type GetBackupConfigurationScript struct {
+	Exec        string `json:"Exec"`
+	Arguments   string `json:"Arguments"`
+	TimeoutInMs int    `json:"TimeoutInMs"`
+}
+
+// BackupSettings are settings common to all backup destinations
+type BackupSettings struct {
+	Disabled                     bool                          `json:"Disabled"`
+	GetBackupConfigurationScript *GetBackupConfigurationScript `json:"GetBackupConfigurationScript,omitempty"`
+}
+
+// LocalSettings describes backup to a local folder on the server
+type LocalSettings struct {
+	BackupSettings
+	FolderPath string `json:"FolderPath"`
+}
+
+// AmazonSettings are settings common to Amazon S3 and Glacier destinations
+type AmazonSettings struct {
+	BackupSettings
+	AwsAccessKey     string `json:"AwsAccessKey"`
+	AwsSecretKey     string `json:"AwsSecretKey"`
+	AwsSessionToken  string `json:"AwsSessionToken,omitempty"`
+	AwsRegionName    string `json:"AwsRegionName"`
+	RemoteFolderName string `json:"RemoteFolderName,omitempty"`
+}
+
+// S3Settings describes backup to Amazon S3 or S3-compatible storage
+type S3Settings struct {
+	AmazonSettings
+	BucketName string `json:"BucketName"`
+	// CustomServerURL is the url of S3-compatible storage
+	CustomServerURL string `json:"CustomServerUrl,omitempty"`
+	ForcePathStyle  bool   `json:"ForcePathStyle"`
+}
+
+// GlacierSettings describes backup to Amazon Glacier
+type GlacierSettings struct {
+	AmazonSettings
+	VaultName string `json:"VaultName"`
+}
+
+// AzureSettings describes backup to Azure Blob Storage.
+// Either AccountKey or SasToken must be provided
+type AzureSettings struct {
+	BackupSettings
+	StorageContainer string `json:"StorageContainer"`
+	RemoteFolderName string `json:"RemoteFolderName,omitempty"`
+	AccountName      string `json:"AccountName"`
+	AccountKey       string `json:"AccountKey,omitempty"`
+	SasToken         string `json:"SasToken,omitempty"`
+}
+
+// GoogleCloudSettings describes backup to Google Cloud Storage
+type GoogleCloudSettings struct {
+	BackupSettings
+	BucketName       string `json:"BucketName"`
+	RemoteFolderName string `json:"RemoteFolderName,omitempty"`
+	// GoogleCredentialsJSON is content of the service account key file
+	GoogleCredentialsJSON string `json:"GoogleCredentialsJson"`
+}
+
+// FtpSettings describes backup to an FTP server
+type FtpSettings struct {
+	BackupSettings
+	URL                 string `json:"Url"`
+	UserName            string `json:"UserName"`
+	Password            string `json:"Password"`
+	CertificateAsBase64 string `json:"CertificateAsBase64,omitempty"`
+}
@@ -0,0 +1,16 @@
+package ravendb
+
+// BackupType describes the type of a periodic backup
+type BackupType string
+
+const (
+	BackupTypeBackup   BackupType = "Backup"
+	BackupTypeSnapshot BackupType = "Snapshot"
+)
+
+var backupTypeValues = []string{string(BackupTypeBackup), string(BackupTypeSnapshot)}
+
+// MarshalJSON returns an error if BackupType is not one of the defined values
+func (t BackupType) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(t), "BackupType", backupTypeValues)
+}
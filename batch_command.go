@@ -23,6 +23,7 @@ type BatchCommand struct {
 	commands          []ICommandData
 	attachmentStreams []io.Reader
 	options           *BatchOptions
+	transactionMode   TransactionMode
 
 	Result *JSONArrayResult
 }
@@ -82,12 +83,15 @@ func (c *BatchCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	v := map[string]interface{}{
 		"Commands": a,
 	}
+	if c.transactionMode == TransactionModeClusterWide {
+		v["TransactionMode"] = c.transactionMode
+	}
 	js, err := jsonMarshal(v)
 	if err != nil {
 		return nil, err
 	}
 	if len(c.attachmentStreams) == 0 {
-		return NewHttpPost(url, js)
+		return newHttpPostCompressed(url, js, c.conventions.UseCompression)
 	}
 
 	body := &bytes.Buffer{}
@@ -175,7 +179,7 @@ func (c *BatchCommand) appendOptions(sb string) string {
 		}
 
 		for _, specificIndex := range _options.waitForSpecificIndexes {
-			sb += "&waitForSpecificIndex=" + specificIndex
+			sb += "&waitForSpecificIndex=" + urlUtilsEscapeDataString(specificIndex)
 		}
 	}
 	return sb
@@ -0,0 +1,26 @@
+package ravendb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchCommandWaitForIndexesOptions(t *testing.T) {
+	session := &InMemoryDocumentSessionOperations{}
+	session.WaitForIndexesAfterSaveChanges(func(builder *IndexesWaitOptsBuilder) {
+		builder.WithTimeout(time.Second * 30).ThrowOnTimeout(true).WaitForIndexes("Users/ByName")
+	})
+	cmd := &BatchCommand{options: session.saveChangesOptions}
+	got := cmd.appendOptions("/bulk_docs")
+	assert.Equal(t, "/bulk_docs?&waitForIndexesTimeout=00:00:30&waitForIndexThrow=true&waitForSpecificIndex=Users%2FByName", got)
+
+	session = &InMemoryDocumentSessionOperations{}
+	session.WaitForReplicationAfterSaveChanges(func(builder *ReplicationWaitOptsBuilder) {
+		builder.Majority(true)
+	})
+	cmd = &BatchCommand{options: session.saveChangesOptions}
+	got = cmd.appendOptions("/bulk_docs")
+	assert.Equal(t, "/bulk_docs?&waitForReplicasTimeout=00:00:15&throwOnTimeoutInWaitForReplicas=true&numberOfReplicasToWaitFor=majority", got)
+}
@@ -31,17 +31,24 @@ func (b *BatchOperation) createRequest() (*BatchCommand, error) {
 
 	b.entities = result.entities
 
-	return newBatchCommand(b.session.GetConventions(), result.sessionCommands, result.options)
+	cmd, err := newBatchCommand(b.session.GetConventions(), result.sessionCommands, result.options)
+	if err != nil {
+		return nil, err
+	}
+	cmd.transactionMode = b.session.transactionMode
+	return cmd, nil
 }
 
-func (b *BatchOperation) setResult(result []map[string]interface{}) error {
+func (b *BatchOperation) setResult(result []map[string]interface{}) (*SaveChangesResult, error) {
 	if len(result) == 0 {
-		return throwOnNullResult()
+		return nil, throwOnNullResult()
 	}
+	var err error
+	res := &SaveChangesResult{}
 	for i := 0; i < b.sessionCommandsCount; i++ {
 		batchResult := result[i]
 		if batchResult == nil {
-			return newIllegalArgumentError("batchResult cannot be nil")
+			return nil, newIllegalArgumentError("batchResult cannot be nil")
 		}
 		typ, _ := jsonGetAsText(batchResult, "Type")
 		if typ != "PUT" {
@@ -54,11 +61,11 @@ func (b *BatchOperation) setResult(result []map[string]interface{}) error {
 		}
 		changeVector := jsonGetAsTextPointer(batchResult, MetadataChangeVector)
 		if changeVector == nil {
-			return newIllegalStateError("PUT response is invalid. @change-vector is missing on " + documentInfo.id)
+			return nil, newIllegalStateError("PUT response is invalid. @change-vector is missing on " + documentInfo.id)
 		}
 		id, _ := jsonGetAsText(batchResult, MetadataID)
 		if id == "" {
-			return newIllegalStateError("PUT response is invalid. @id is missing on " + documentInfo.id)
+			return nil, newIllegalStateError("PUT response is invalid. @id is missing on " + documentInfo.id)
 		}
 
 		for propertyName, v := range batchResult {
@@ -79,9 +86,86 @@ func (b *BatchOperation) setResult(result []map[string]interface{}) error {
 		b.session.documentsByID.add(documentInfo)
 		b.session.generateEntityIDOnTheClient.trySetIdentity(entity, id)
 
-		afterSaveChangesEventArgs := newAfterSaveChangesEventArgs(b.session, documentInfo.id, documentInfo.entity)
+		saved := &SavedDocument{
+			ID:           id,
+			ChangeVector: *changeVector,
+			Entity:       documentInfo.entity,
+		}
+		if lastModified, ok := jsonGetAsString(batchResult, MetadataLastModified); ok {
+			if saved.LastModified, err = ParseTime(lastModified); err != nil {
+				return nil, err
+			}
+		}
+		res.Documents = append(res.Documents, saved)
+
+		afterSaveChangesEventArgs := newAfterSaveChangesEventArgs(b.session, saved)
 		b.session.onAfterSaveChangesInvoke(afterSaveChangesEventArgs)
 	}
+	// results of deferred commands
+	for i := b.sessionCommandsCount; i < len(result); i++ {
+		batchResult := result[i]
+		switch typ, _ := jsonGetAsText(batchResult, "Type"); typ {
+		case CommandPatch:
+			if err := b.handlePatch(batchResult, res); err != nil {
+				return nil, err
+			}
+		case CommandCounters:
+			b.session.registerCountersBatchResult(batchResult)
+		case CommandTimeSeries:
+			b.session.registerTimeSeriesBatchResult(batchResult)
+		case CommandForceRevisionCreation:
+			b.session.registerForceRevisionResult(batchResult)
+		}
+	}
+	if b.session.clusterSession != nil {
+		if err := b.session.clusterSession.updateState(result); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// handlePatch updates a patched document tracked by the session
+// with its new change vector and adds it to res
+func (b *BatchOperation) handlePatch(batchResult map[string]interface{}, res *SaveChangesResult) error {
+	status, _ := jsonGetAsText(batchResult, "PatchStatus")
+	if status != PatchStatusCreated && status != PatchStatusPatched {
+		return nil
+	}
+	id, _ := jsonGetAsText(batchResult, "Id")
+	documentInfo := b.session.documentsByID.getValue(id)
+	if documentInfo == nil {
+		return nil
+	}
+	changeVector := jsonGetAsTextPointer(batchResult, "ChangeVector")
+	if changeVector == nil {
+		return newIllegalStateError("PATCH response is invalid. ChangeVector is missing on " + id)
+	}
+
+	documentInfo.changeVector = changeVector
+	meta := documentInfo.metadata
+	meta[MetadataID] = id
+	meta[MetadataChangeVector] = *changeVector
+	saved := &SavedDocument{
+		ID:           id,
+		ChangeVector: *changeVector,
+		Entity:       documentInfo.entity,
+	}
+	if lastModified, ok := jsonGetAsString(batchResult, "LastModified"); ok {
+		var err error
+		if saved.LastModified, err = ParseTime(lastModified); err != nil {
+			return err
+		}
+		meta[MetadataLastModified] = lastModified
+	}
+	if documentInfo.document != nil {
+		documentInfo.document[MetadataKey] = meta
+	}
+	documentInfo.metadataInstance = nil
+	res.Documents = append(res.Documents, saved)
+
+	afterSaveChangesEventArgs := newAfterSaveChangesEventArgs(b.session, saved)
+	b.session.onAfterSaveChangesInvoke(afterSaveChangesEventArgs)
 	return nil
 }
 
@@ -0,0 +1,63 @@
+package ravendb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveChangesResultIncludesPatchedDocuments(t *testing.T) {
+	lastModified := "2020-01-02T03:04:05.0000000Z"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/docs"):
+			w.Write([]byte(`{"Results":[{"Name":"John","@metadata":{"@id":"users/1","@change-vector":"A:1-x","@collection":"Users"}}],"Includes":{}}`))
+		case strings.HasSuffix(r.URL.Path, "/bulk_docs"):
+			w.Write([]byte(`{"Results":[{"Type":"PATCH","Id":"users/1","ChangeVector":"A:2-x","LastModified":"` + lastModified + `","PatchStatus":"Patched"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	store := NewDocumentStore([]string{server.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	assert.NoError(t, store.Initialize())
+	defer store.Close()
+
+	var events []*AfterSaveChangesEventArgs
+	store.AddAfterSaveChangesListener(func(event *AfterSaveChangesEventArgs) {
+		events = append(events, event)
+	})
+
+	session, err := store.OpenSession("")
+	assert.NoError(t, err)
+	defer session.Close()
+	var user *User
+	assert.NoError(t, session.Load(&user, "users/1"))
+	assert.NoError(t, session.Patch(user, "Name", "Mary"))
+
+	result, err := session.SaveChangesWithResult()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(result.Documents))
+	saved := result.GetDocument(user)
+	assert.NotNil(t, saved)
+	assert.Equal(t, "users/1", saved.ID)
+	assert.Equal(t, "A:2-x", saved.ChangeVector)
+	expected, err := ParseTime(lastModified)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, saved.LastModified)
+
+	changeVector, err := session.Advanced().GetChangeVectorFor(user)
+	assert.NoError(t, err)
+	assert.Equal(t, "A:2-x", *changeVector)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, "A:2-x", events[0].ChangeVector)
+
+	lastModified = "yesterday"
+	assert.NoError(t, session.Patch(user, "Name", "Bob"))
+	_, err = session.SaveChangesWithResult()
+	assert.Error(t, err)
+}
@@ -0,0 +1,228 @@
+package ravendb
+
+import (
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+// Counter operations and time series appends are accumulated per document
+// and sent as a single command, unlike documents and attachments which
+// are written to the request immediately.
+
+// maximum number of counter operations or time series appends sent in one command
+const bulkInsertMaxPendingEntries = 1024
+
+const (
+	bulkInsertCommandCounters   = "Counters"
+	bulkInsertCommandTimeSeries = "TimeSeriesBulkInsert"
+)
+
+type bulkInsertPendingCommand struct {
+	typ string
+	id  string
+	// name of the time series
+	name    string
+	entries []interface{}
+}
+
+// addPending adds an entry to the pending command, writing the previous
+// command first if it's for a different document or time series
+func (o *BulkInsertOperation) addPending(typ string, id string, name string, entry interface{}) error {
+	p := o.pending
+	if p != nil && (p.typ != typ || p.id != id || p.name != name) {
+		if err := o.flushPending(); err != nil {
+			return err
+		}
+		p = nil
+	}
+	if p == nil {
+		p = &bulkInsertPendingCommand{
+			typ:  typ,
+			id:   id,
+			name: name,
+		}
+		o.pending = p
+	}
+	p.entries = append(p.entries, entry)
+	if len(p.entries) >= bulkInsertMaxPendingEntries {
+		return o.flushPending()
+	}
+	return nil
+}
+
+// flushPending writes the pending command, if any, to the request
+func (o *BulkInsertOperation) flushPending() error {
+	p := o.pending
+	if p == nil {
+		return nil
+	}
+	o.pending = nil
+
+	m := map[string]interface{}{
+		"Id":   p.id,
+		"Type": p.typ,
+	}
+	switch p.typ {
+	case bulkInsertCommandCounters:
+		m["Counters"] = map[string]interface{}{
+			"DocumentId": p.id,
+			"Operations": p.entries,
+		}
+	case bulkInsertCommandTimeSeries:
+		m["TimeSeries"] = map[string]interface{}{
+			"Name":       p.name,
+			"TimeFormat": "UnixTimeInMs",
+			"Appends":    p.entries,
+		}
+	default:
+		panicIf(true, "unknown pending command type %s", p.typ)
+	}
+	return o.writeCommand(p.id, m, nil)
+}
+
+// AttachmentsBulkInsert stores attachments of a document as part of bulk insert
+type AttachmentsBulkInsert struct {
+	operation *BulkInsertOperation
+	id        string
+}
+
+// AttachmentsFor returns AttachmentsBulkInsert for storing attachments
+// of a document with a given id
+func (o *BulkInsertOperation) AttachmentsFor(id string) *AttachmentsBulkInsert {
+	return &AttachmentsBulkInsert{
+		operation: o,
+		id:        id,
+	}
+}
+
+// Store stores an attachment with a given name and content read from data.
+// contentType is optional. The content is read into memory because
+// the server needs to know its size upfront
+func (a *AttachmentsBulkInsert) Store(name string, data io.Reader, contentType string) error {
+	o := a.operation
+	if !o.concurrentCheck.compareAndSet(0, 1) {
+		return newIllegalStateError("Bulk Insert Store methods cannot be executed concurrently.")
+	}
+	defer o.concurrentCheck.set(0)
+
+	if err := bulkInsertOperationVerifyValidID(a.id); err != nil {
+		return err
+	}
+	if stringIsEmpty(name) {
+		return newIllegalArgumentError("name cannot be empty")
+	}
+	if data == nil {
+		return newIllegalArgumentError("data cannot be nil")
+	}
+	if err := o.prepareWrite(); err != nil {
+		return err
+	}
+
+	d, err := ioutil.ReadAll(data)
+	if err != nil {
+		return err
+	}
+
+	m := map[string]interface{}{
+		"Id":            a.id,
+		"Type":          "AttachmentPUT",
+		"Name":          name,
+		"ContentLength": len(d),
+	}
+	if contentType != "" {
+		m["ContentType"] = contentType
+	}
+	return o.writeCommand(a.id, m, d)
+}
+
+// CountersBulkInsert modifies counters of a document as part of bulk insert
+type CountersBulkInsert struct {
+	operation *BulkInsertOperation
+	id        string
+}
+
+// CountersFor returns CountersBulkInsert for modifying counters
+// of a document with a given id
+func (o *BulkInsertOperation) CountersFor(id string) *CountersBulkInsert {
+	return &CountersBulkInsert{
+		operation: o,
+		id:        id,
+	}
+}
+
+// Increment increments a counter by delta, creating the counter if needed
+func (c *CountersBulkInsert) Increment(name string, delta int64) error {
+	o := c.operation
+	if !o.concurrentCheck.compareAndSet(0, 1) {
+		return newIllegalStateError("Bulk Insert Store methods cannot be executed concurrently.")
+	}
+	defer o.concurrentCheck.set(0)
+
+	if err := bulkInsertOperationVerifyValidID(c.id); err != nil {
+		return err
+	}
+	if stringIsEmpty(name) {
+		return newIllegalArgumentError("name cannot be empty")
+	}
+	if err := o.prepareWrite(); err != nil {
+		return err
+	}
+
+	op := map[string]interface{}{
+		"Type":        "Increment",
+		"CounterName": name,
+		"Delta":       delta,
+	}
+	return o.addPending(bulkInsertCommandCounters, c.id, "", op)
+}
+
+// TimeSeriesBulkInsert appends entries to a time series as part of bulk insert
+type TimeSeriesBulkInsert struct {
+	operation *BulkInsertOperation
+	id        string
+	name      string
+}
+
+// TimeSeriesFor returns TimeSeriesBulkInsert for appending entries
+// to time series name of a document with a given id
+func (o *BulkInsertOperation) TimeSeriesFor(id string, name string) *TimeSeriesBulkInsert {
+	return &TimeSeriesBulkInsert{
+		operation: o,
+		id:        id,
+		name:      name,
+	}
+}
+
+// Append appends an entry with given values at timestamp. tag is optional
+func (ts *TimeSeriesBulkInsert) Append(timestamp time.Time, values []float64, tag string) error {
+	o := ts.operation
+	if !o.concurrentCheck.compareAndSet(0, 1) {
+		return newIllegalStateError("Bulk Insert Store methods cannot be executed concurrently.")
+	}
+	defer o.concurrentCheck.set(0)
+
+	if err := bulkInsertOperationVerifyValidID(ts.id); err != nil {
+		return err
+	}
+	if stringIsEmpty(ts.name) {
+		return newIllegalArgumentError("time series name cannot be empty")
+	}
+	if len(values) == 0 {
+		return newIllegalArgumentError("values cannot be empty")
+	}
+	if err := o.prepareWrite(); err != nil {
+		return err
+	}
+
+	// the server expects [unix time in ms, number of values, values..., tag]
+	entry := make([]interface{}, 0, len(values)+3)
+	entry = append(entry, timestamp.UnixNano()/int64(time.Millisecond), len(values))
+	for _, v := range values {
+		entry = append(entry, v)
+	}
+	if tag != "" {
+		entry = append(entry, tag)
+	}
+	return o.addPending(bulkInsertCommandTimeSeries, ts.id, ts.name, entry)
+}
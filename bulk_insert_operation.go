@@ -1,15 +1,24 @@
 package ravendb
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"io"
 	"net/http"
 	"strings"
+	"sync/atomic"
 )
 
 // Note: the implementation details are different from Java
 // We take advantage of a pipe: a read end is passed as io.Reader
 // to the request. A write end is what we use to write to the request.
+// Writes are buffered so that we don't hand every document to the http
+// transport separately. Since the length of the body is not known
+// upfront, the request uses chunked transfer encoding.
+
+// size of the buffer for documents written to the request
+const bulkInsertBufferSize = 64 * 1024
 
 var _ RavenCommand = &BulkInsertCommand{}
 
@@ -35,14 +44,22 @@ func NewBulkInsertCommand(id int64, stream io.Reader, useCompression bool) *Bulk
 		id:             id,
 		useCompression: useCompression,
 	}
+	// the server doesn't send anything back on success
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
 	return cmd
 }
 
 func (c *BulkInsertCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	url := node.URL + "/databases/" + node.Database + "/bulk_insert?id=" + i64toa(c.id)
-	// TODO: implement compression. It must be attached to the writer
-	//message.setEntity(useCompression ? new GzipCompressingEntity(_stream) : _stream)
-	return newHttpPostReader(url, c.stream)
+	req, err := newHttpPostReader(url, c.stream)
+	if err != nil {
+		return nil, err
+	}
+	// the stream is compressed by BulkInsertOperation as it's being written
+	if c.useCompression {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	return req, nil
 }
 
 func (c *BulkInsertCommand) SetResponse(response []byte, fromCache bool) error {
@@ -69,8 +86,13 @@ type BulkInsertOperation struct {
 
 	reader        *io.PipeReader
 	currentWriter *io.PipeWriter
+	// buffers writes to currentWriter
+	writer *bufio.Writer
+	// if not nil, compresses data between writer and currentWriter
+	compressor *gzip.Writer
 
-	first       bool
+	first bool
+	// accessed atomically, Abort can run concurrently with WaitForID
 	operationID int64
 
 	useCompression bool
@@ -80,6 +102,12 @@ type BulkInsertOperation struct {
 	conventions *DocumentConventions
 	err         error
 
+	closed  bool
+	aborted atomicInteger
+
+	// counter operations or time series appends not yet written
+	pending *bulkInsertPendingCommand
+
 	Command *BulkInsertCommand
 }
 
@@ -100,6 +128,13 @@ func NewBulkInsertOperation(database string, store *DocumentStore) *BulkInsertOp
 		currentWriter:               writer,
 		operationID:                 -1,
 		first:                       true,
+		useCompression:              store.GetConventions().UseCompression,
+	}
+	if res.useCompression {
+		res.compressor = gzip.NewWriter(writer)
+		res.writer = bufio.NewWriterSize(res.compressor, bulkInsertBufferSize)
+	} else {
+		res.writer = bufio.NewWriterSize(writer, bulkInsertBufferSize)
 	}
 	return res
 }
@@ -116,14 +151,14 @@ func (o *BulkInsertOperation) throwBulkInsertAborted(e error, flushEx error) err
 }
 
 func (o *BulkInsertOperation) getErrorFromOperation() error {
-	stateRequest := NewGetOperationStateCommand(o.requestExecutor.GetConventions(), o.operationID)
+	stateRequest := NewGetOperationStateCommand(o.requestExecutor.GetConventions(), o.getOperationID())
 	err := o.requestExecutor.ExecuteCommand(stateRequest, nil)
 	if err != nil {
 		return err
 	}
 
 	status, _ := jsonGetAsText(stateRequest.Result, "Status")
-	if status != "Faulted" {
+	if OperationStatus(status) != OperationStatusFaulted {
 		return nil
 	}
 
@@ -141,7 +176,7 @@ func (o *BulkInsertOperation) getErrorFromOperation() error {
 
 // WaitForID waits for operation id to finish
 func (o *BulkInsertOperation) WaitForID() error {
-	if o.operationID != -1 {
+	if o.getOperationID() != -1 {
 		return nil
 	}
 
@@ -150,10 +185,14 @@ func (o *BulkInsertOperation) WaitForID() error {
 	if o.err != nil {
 		return o.err
 	}
-	o.operationID = bulkInsertGetIDRequest.Result
+	atomic.StoreInt64(&o.operationID, bulkInsertGetIDRequest.Result)
 	return nil
 }
 
+func (o *BulkInsertOperation) getOperationID() int64 {
+	return atomic.LoadInt64(&o.operationID)
+}
+
 // StoreWithID stores an entity with a given id
 func (o *BulkInsertOperation) StoreWithID(entity interface{}, id string, metadata *MetadataAsDictionary) error {
 	if !o.concurrentCheck.compareAndSet(0, 1) {
@@ -161,15 +200,62 @@ func (o *BulkInsertOperation) StoreWithID(entity interface{}, id string, metadat
 	}
 	defer o.concurrentCheck.set(0)
 
+	if err := bulkInsertOperationVerifyValidID(id); err != nil {
+		return err
+	}
+	if err := o.prepareWrite(); err != nil {
+		return err
+	}
+
+	if metadata == nil {
+		metadata = &MetadataAsDictionary{}
+	}
+
+	var jsNode map[string]interface{}
+	if doc, ok := entity.(map[string]interface{}); ok {
+		// raw documents have no Go type to derive collection from
+		// and keep their own metadata, merged with provided metadata
+		jsNode = bulkInsertMergeMetadata(doc, metadata)
+	} else {
+		if !metadata.ContainsKey(MetadataCollection) {
+			collection := o.requestExecutor.GetConventions().getCollectionName(entity)
+			if collection != "" {
+				metadata.Put(MetadataCollection, collection)
+			}
+		}
+		if !metadata.ContainsKey(MetadataRavenGoType) {
+			goType := o.requestExecutor.GetConventions().getGoTypeName(entity)
+			if goType != "" {
+				metadata.Put(MetadataRavenGoType, goType)
+			}
+		}
+
+		documentInfo := &documentInfo{}
+		documentInfo.metadataInstance = metadata
+		jsNode = convertEntityToJSON(entity, documentInfo)
+	}
+
+	m := map[string]interface{}{}
+	m["Id"] = id
+	m["Type"] = "PUT"
+	m["Document"] = jsNode
+	return o.writeCommand(id, m, nil)
+}
+
+// prepareWrite checks that we can write to the request and starts
+// the request if needed. Must be called with concurrentCheck set
+func (o *BulkInsertOperation) prepareWrite() error {
+	if o.aborted.get() != 0 {
+		return newBulkInsertAbortedError("Bulk insert was aborted")
+	}
+	if o.closed {
+		return newIllegalStateError("Bulk insert was already closed")
+	}
 	// early exit if we failed previously
 	if o.err != nil {
 		return o.err
 	}
 
-	err := bulkInsertOperationVerifyValidID(id)
-	if err != nil {
-		return err
-	}
 	o.err = o.WaitForID()
 	if o.err != nil {
 		return o.err
@@ -180,32 +266,25 @@ func (o *BulkInsertOperation) StoreWithID(entity interface{}, id string, metadat
 	}
 
 	if o.bulkInsertExecuteTask.IsCompletedExceptionally() {
-		_, err = o.bulkInsertExecuteTask.Get()
+		_, err := o.bulkInsertExecuteTask.Get()
 		panicIf(err == nil, "err should not be nil")
 		return o.throwBulkInsertAborted(err, nil)
 	}
+	return nil
+}
 
-	if metadata == nil {
-		metadata = &MetadataAsDictionary{}
+// writeCommand writes a command for document id to the request,
+// followed by raw data (if any)
+func (o *BulkInsertOperation) writeCommand(id string, command map[string]interface{}, data []byte) error {
+	if err := o.flushPending(); err != nil {
+		return err
 	}
 
-	if !metadata.ContainsKey(MetadataCollection) {
-		collection := o.requestExecutor.GetConventions().getCollectionName(entity)
-		if collection != "" {
-			metadata.Put(MetadataCollection, collection)
-		}
-	}
-	if !metadata.ContainsKey(MetadataRavenGoType) {
-		goType := o.requestExecutor.GetConventions().getGoTypeName(entity)
-		if goType != "" {
-			metadata.Put(MetadataRavenGoType, goType)
-		}
+	d, err := jsonMarshal(command)
+	if err != nil {
+		return err
 	}
 
-	documentInfo := &documentInfo{}
-	documentInfo.metadataInstance = metadata
-	jsNode := convertEntityToJSON(entity, documentInfo)
-
 	var b bytes.Buffer
 	if o.first {
 		b.WriteByte('[')
@@ -213,60 +292,58 @@ func (o *BulkInsertOperation) StoreWithID(entity interface{}, id string, metadat
 	} else {
 		b.WriteByte(',')
 	}
-	m := map[string]interface{}{}
-	m["Id"] = o.escapeID(id)
-	m["Type"] = "PUT"
-	m["Document"] = jsNode
-
-	d, err := jsonMarshal(m)
-	if err != nil {
-		return err
-	}
 	b.Write(d)
 
-	_, o.err = o.currentWriter.Write(b.Bytes())
-	if o.err != nil {
-		err = o.getErrorFromOperation()
-		if err != nil {
-			o.err = err
-			return o.err
-		}
-		// TODO:
-		//o.err = o.throwOnUnavailableStream()
+	if _, err = o.writer.Write(b.Bytes()); err == nil && len(data) > 0 {
+		_, err = o.writer.Write(data)
+	}
+	if err != nil {
+		o.err = o.throwOnUnavailableStream(id, err)
 		return o.err
 	}
-	return o.err
+	return nil
 }
 
-func (o *BulkInsertOperation) escapeID(input string) string {
-	if !strings.Contains(input, `"`) {
-		return input
-	}
-	var res bytes.Buffer
-	for i := 0; i < len(input); i++ {
-		c := input[i]
-		if c == '"' {
-			if i == 0 || input[i-1] != '\\' {
-				res.WriteByte('\\')
-			}
+// bulkInsertMergeMetadata returns a shallow copy of doc with metadata
+// added to its @metadata
+func bulkInsertMergeMetadata(doc map[string]interface{}, metadata *MetadataAsDictionary) map[string]interface{} {
+	res := make(map[string]interface{}, len(doc)+1)
+	for k, v := range doc {
+		res[k] = v
+	}
+	entries := metadata.EntrySet()
+	if len(entries) == 0 {
+		return res
+	}
+	metadataNode := map[string]interface{}{}
+	if existing, ok := doc[MetadataKey].(map[string]interface{}); ok {
+		for k, v := range existing {
+			metadataNode[k] = v
 		}
-		res.WriteByte(c)
 	}
-	return res.String()
+	for k, v := range entries {
+		metadataNode[k] = v
+	}
+	res[MetadataKey] = metadataNode
+	return res
 }
 
 func (o *BulkInsertOperation) ensureCommand() error {
 	if o.Command != nil {
 		return nil
 	}
-	bulkCommand := NewBulkInsertCommand(o.operationID, o.reader, o.useCompression)
+	bulkCommand := NewBulkInsertCommand(o.getOperationID(), o.reader, o.useCompression)
 	panicIf(o.bulkInsertExecuteTask != nil, "already started _bulkInsertExecuteTask")
 	o.bulkInsertExecuteTask = newCompletableFuture()
 	go func() {
 		err := o.requestExecutor.ExecuteCommand(bulkCommand, nil)
+		// the request might have failed before reading the body.
+		// Unblock writers that would otherwise wait for a reader forever
 		if err != nil {
+			o.reader.CloseWithError(err)
 			o.bulkInsertExecuteTask.completeWithError(err)
 		} else {
+			o.reader.Close()
 			o.bulkInsertExecuteTask.complete(nil)
 		}
 	}()
@@ -275,17 +352,21 @@ func (o *BulkInsertOperation) ensureCommand() error {
 	return nil
 }
 
-// Abort aborts insert operation
+// Abort aborts insert operation. Documents that were already sent
+// might be stored. After Abort, Store and Close return BulkInsertAbortedError
 func (o *BulkInsertOperation) Abort() error {
-	if o.operationID == -1 {
-		return nil // nothing was done, nothing to kill
+	if !o.aborted.compareAndSet(0, 1) {
+		return nil
 	}
+	// terminates the request and releases Store blocked on writing to it
+	defer o.reader.CloseWithError(newBulkInsertAbortedError("Bulk insert was aborted"))
 
-	if err := o.WaitForID(); err != nil {
-		return err
+	operationID := o.getOperationID()
+	if operationID == -1 {
+		return nil // nothing was done, nothing to kill
 	}
 
-	command, err := NewKillOperationCommand(i64toa(o.operationID))
+	command, err := NewKillOperationCommand(i64toa(operationID))
 	if err != nil {
 		return err
 	}
@@ -299,28 +380,62 @@ func (o *BulkInsertOperation) Abort() error {
 	return nil
 }
 
-// Close closes operation
+// Close finishes sending documents and waits until the server processes them.
+// It's safe to call Close more than once
 func (o *BulkInsertOperation) Close() error {
-	if o.operationID == -1 {
+	if !o.concurrentCheck.compareAndSet(0, 1) {
+		return newIllegalStateError("Bulk Insert Close cannot be executed concurrently with Store.")
+	}
+	defer o.concurrentCheck.set(0)
+
+	if o.closed {
+		return o.err
+	}
+	o.closed = true
+
+	if o.aborted.get() != 0 {
+		o.currentWriter.Close()
+		o.err = newBulkInsertAbortedError("Bulk insert was aborted")
+		return o.err
+	}
+
+	if o.bulkInsertExecuteTask == nil {
 		// closing without calling a single Store.
-		return nil
+		o.currentWriter.Close()
+		return o.err
 	}
 
-	d := []byte{']'}
-	_, err := o.currentWriter.Write(d)
-	errClose := o.currentWriter.Close()
-	if o.bulkInsertExecuteTask != nil {
-		_, err2 := o.bulkInsertExecuteTask.Get()
-		if err2 != nil && err == nil {
-			err = o.throwBulkInsertAborted(err, errClose)
-		}
+	if o.err == nil {
+		o.err = o.flushPending()
+	}
+	if o.err != nil {
+		// Store already failed, the request is terminated or about to be
+		o.currentWriter.Close()
+		_, _ = o.bulkInsertExecuteTask.Get()
+		return o.err
 	}
 
-	if err != nil {
-		o.err = err
-		return err
+	if o.first {
+		// the request was started but nothing was written
+		o.writer.WriteByte('[')
 	}
-	return nil
+	o.writer.WriteByte(']')
+	errFlush := o.writer.Flush()
+	if o.compressor != nil && errFlush == nil {
+		errFlush = o.compressor.Close()
+	}
+	o.currentWriter.Close()
+
+	if _, err := o.bulkInsertExecuteTask.Get(); err != nil {
+		if _, ok := err.(*BulkInsertAbortedError); ok {
+			o.err = err
+		} else {
+			o.err = o.throwBulkInsertAborted(err, errFlush)
+		}
+	} else if errFlush != nil {
+		o.err = o.throwBulkInsertAborted(errFlush, nil)
+	}
+	return o.err
 }
 
 // Store schedules entity for storing and returns its id. metadata can be nil
@@ -358,15 +473,20 @@ func (o *BulkInsertOperation) GetID(entity interface{}) (string, error) {
 	return idRef, nil
 }
 
+// throwOnUnavailableStream returns an error explaining why writing
+// document with a given id to the request failed
 func (o *BulkInsertOperation) throwOnUnavailableStream(id string, innerEx error) error {
-	// TODO: don't know how/if this translates to Go
-	//_streamExposerContent.errorOnProcessingRequest(new BulkInsertAbortedError("Write to stream failed at document with id " + id, innerEx))
-
-	_, err := o.bulkInsertExecuteTask.Get()
-	if err != nil {
-		return unwrapError(err)
+	if err, ok := innerEx.(*BulkInsertAbortedError); ok {
+		return err
 	}
-	return nil
+	// writing fails when the request is done so it has the actual reason
+	if _, err := o.bulkInsertExecuteTask.Get(); err != nil {
+		innerEx = err
+	}
+	if err := o.getErrorFromOperation(); err != nil {
+		innerEx = err
+	}
+	return newBulkInsertAbortedError("Write to stream failed at document with id %s, error: %s", id, innerEx)
 }
 
 func bulkInsertOperationVerifyValidID(id string) error {
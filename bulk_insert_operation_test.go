@@ -0,0 +1,223 @@
+package ravendb
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newBulkInsertTestStore returns a store talking to a fake server whose
+// bulk insert endpoint is handled by bulkInsert
+func newBulkInsertTestStore(t *testing.T, bulkInsert http.HandlerFunc) (*DocumentStore, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/bulk_insert"):
+			bulkInsert(w, r)
+		case strings.HasSuffix(r.URL.Path, "/operations/next-operation-id"):
+			w.Write([]byte(`{"Id": 1}`))
+		case strings.HasSuffix(r.URL.Path, "/operations/kill"):
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	store := NewDocumentStore([]string{server.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	assert.NoError(t, store.Initialize())
+	return store, func() {
+		store.Close()
+		server.Close()
+	}
+}
+
+func TestBulkInsertOperationStreamsDocuments(t *testing.T) {
+	var body []byte
+	store, cleanup := newBulkInsertTestStore(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, int64(-1), r.ContentLength)
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	})
+	defer cleanup()
+
+	bulkInsert := store.BulkInsert("")
+	assert.NoError(t, bulkInsert.StoreWithID(&User{}, "users/1", nil))
+	assert.NoError(t, bulkInsert.StoreWithID(map[string]interface{}{"Name": "raw"}, `users/"2"`, nil))
+	assert.NoError(t, bulkInsert.Close())
+	// closing again is a no-op
+	assert.NoError(t, bulkInsert.Close())
+
+	var commands []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(body, &commands))
+	assert.Equal(t, 2, len(commands))
+	assert.Equal(t, "users/1", commands[0]["Id"])
+	assert.Equal(t, "PUT", commands[0]["Type"])
+	// ids are JSON-encoded once, without escaping them by hand first
+	assert.Equal(t, `users/"2"`, commands[1]["Id"])
+
+	err := bulkInsert.StoreWithID(&User{}, "users/3", nil)
+	assert.IsType(t, &IllegalStateError{}, err)
+}
+
+func TestBulkInsertOperationUsesRegisteredIdGenerator(t *testing.T) {
+	var body []byte
+	store, cleanup := newBulkInsertTestStore(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+	})
+	defer cleanup()
+	var dbNames []string
+	store.GetConventions().RegisterIdGenerator("Users", func(dbName string, entity interface{}) (string, error) {
+		dbNames = append(dbNames, dbName)
+		return "users/" + entity.(*User).Name, nil
+	})
+
+	bulkInsert := store.BulkInsert("")
+	id, err := bulkInsert.Store(&User{Name: "John"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "users/John", id)
+	assert.NoError(t, bulkInsert.Close())
+
+	assert.Equal(t, []string{"db"}, dbNames)
+	var commands []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(body, &commands))
+	assert.Equal(t, 1, len(commands))
+	assert.Equal(t, "users/John", commands[0]["Id"])
+}
+
+func TestBulkInsertOperationCompression(t *testing.T) {
+	var body []byte
+	var contentEncoding string
+	store, cleanup := newBulkInsertTestStore(t, func(w http.ResponseWriter, r *http.Request) {
+		contentEncoding = r.Header.Get("Content-Encoding")
+		gz, err := gzip.NewReader(r.Body)
+		if assert.NoError(t, err) {
+			body, _ = ioutil.ReadAll(gz)
+		}
+	})
+	defer cleanup()
+	store.GetConventions().UseCompression = true
+
+	bulkInsert := store.BulkInsert("")
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, bulkInsert.StoreWithID(&User{}, "users/"+strconv.Itoa(i), nil))
+	}
+	assert.NoError(t, bulkInsert.Close())
+
+	assert.Equal(t, "gzip", contentEncoding)
+	var commands []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(body, &commands))
+	assert.Equal(t, 100, len(commands))
+}
+
+func TestBulkInsertOperationFailedRequest(t *testing.T) {
+	// the server fails without reading the documents
+	store, cleanup := newBulkInsertTestStore(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"Type": "System.InvalidOperationException", "Message": "failed"}`))
+	})
+	defer cleanup()
+
+	bulkInsert := store.BulkInsert("")
+	var err error
+	doc := map[string]interface{}{"Data": strings.Repeat("x", bulkInsertBufferSize)}
+	for i := 0; i < 10 && err == nil; i++ {
+		err = bulkInsert.StoreWithID(doc, "docs/"+strconv.Itoa(i), nil)
+	}
+	if err == nil {
+		err = bulkInsert.Close()
+	}
+	assert.IsType(t, &BulkInsertAbortedError{}, err)
+	assert.Equal(t, err, bulkInsert.Close())
+}
+
+func TestBulkInsertOperationAbort(t *testing.T) {
+	store, cleanup := newBulkInsertTestStore(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+	})
+	defer cleanup()
+
+	bulkInsert := store.BulkInsert("")
+	assert.NoError(t, bulkInsert.Abort())
+	err := bulkInsert.StoreWithID(&User{}, "users/1", nil)
+	assert.IsType(t, &BulkInsertAbortedError{}, err)
+	err = bulkInsert.Close()
+	assert.IsType(t, &BulkInsertAbortedError{}, err)
+}
+
+func TestBulkInsertOperationAbortConcurrentWithWaitForID(t *testing.T) {
+	store, cleanup := newBulkInsertTestStore(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+	})
+	defer cleanup()
+
+	bulkInsert := store.BulkInsert("")
+	done := make(chan error, 1)
+	go func() {
+		done <- bulkInsert.WaitForID()
+	}()
+	assert.NoError(t, bulkInsert.Abort())
+	assert.NoError(t, <-done)
+	assert.Equal(t, int64(1), bulkInsert.getOperationID())
+	err := bulkInsert.StoreWithID(&User{}, "users/1", nil)
+	assert.IsType(t, &BulkInsertAbortedError{}, err)
+}
+
+func TestBulkInsertOperationAttachmentsCountersAndTimeSeries(t *testing.T) {
+	var body []byte
+	store, cleanup := newBulkInsertTestStore(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+	})
+	defer cleanup()
+
+	bulkInsert := store.BulkInsert("")
+	assert.NoError(t, bulkInsert.StoreWithID(&User{}, "users/1", nil))
+	counters := bulkInsert.CountersFor("users/1")
+	assert.NoError(t, counters.Increment("likes", 1))
+	assert.NoError(t, counters.Increment("dislikes", 2))
+	assert.NoError(t, bulkInsert.AttachmentsFor("users/1").Store("photo.png", strings.NewReader("PNG"), "image/png"))
+	heartRate := bulkInsert.TimeSeriesFor("users/1", "HeartRate")
+	ts := time.Unix(1600000000, 0)
+	assert.NoError(t, heartRate.Append(ts, []float64{60}, "watch"))
+	assert.NoError(t, heartRate.Append(ts.Add(time.Second), []float64{61, 62}, ""))
+
+	assert.Error(t, bulkInsert.AttachmentsFor("users/1").Store("", strings.NewReader("x"), ""))
+	assert.Error(t, bulkInsert.TimeSeriesFor("users/1", "HeartRate").Append(ts, nil, ""))
+	assert.Error(t, bulkInsert.CountersFor("").Increment("likes", 1))
+	assert.NoError(t, bulkInsert.Close())
+
+	// attachment content follows its command as raw bytes
+	s := string(body)
+	idx := strings.Index(s, `"Type":"AttachmentPUT"}PNG,`)
+	assert.True(t, idx > 0, "body: %s", s)
+	s = s[:idx] + `"Type":"AttachmentPUT"},` + s[idx+len(`"Type":"AttachmentPUT"}PNG,`):]
+
+	var commands []map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(s), &commands))
+	assert.Equal(t, 4, len(commands))
+	assert.Equal(t, "PUT", commands[0]["Type"])
+
+	// counter operations are sent together, before the attachment
+	assert.Equal(t, "Counters", commands[1]["Type"])
+	ops := commands[1]["Counters"].(map[string]interface{})["Operations"].([]interface{})
+	assert.Equal(t, 2, len(ops))
+	assert.Equal(t, "likes", ops[0].(map[string]interface{})["CounterName"])
+
+	assert.Equal(t, "AttachmentPUT", commands[2]["Type"])
+	assert.Equal(t, float64(3), commands[2]["ContentLength"])
+	assert.Equal(t, "image/png", commands[2]["ContentType"])
+
+	// time series appends are written on Close
+	assert.Equal(t, "TimeSeriesBulkInsert", commands[3]["Type"])
+	timeSeries := commands[3]["TimeSeries"].(map[string]interface{})
+	assert.Equal(t, "HeartRate", timeSeries["Name"])
+	appends := timeSeries["Appends"].([]interface{})
+	assert.Equal(t, []interface{}{float64(1600000000000), float64(1), float64(60), "watch"}, appends[0])
+	assert.Equal(t, []interface{}{float64(1600000001000), float64(2), float64(61), float64(62)}, appends[1])
+}
@@ -0,0 +1,12 @@
+package ravendb
+
+// BulkOperationResult is a result of set-based operations like
+// DeleteByQueryOperation and PatchByQueryOperation
+type BulkOperationResult struct {
+	Total                int64  `json:"Total"`
+	DocumentsProcessed   int64  `json:"DocumentsProcessed"`
+	AttachmentsProcessed int64  `json:"AttachmentsProcessed"`
+	CountersProcessed    int64  `json:"CountersProcessed"`
+	TimeSeriesProcessed  int64  `json:"TimeSeriesProcessed"`
+	Query                string `json:"Query"`
+}
@@ -0,0 +1,111 @@
+package ravendb
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// LoadCertificateFromPEM parses a client certificate together with its
+// private key from PEM encoded data. This is the format of .pem files
+// in a certificate package generated by RavenDB server.
+// The result can be used as DocumentStore.Certificate
+func LoadCertificateFromPEM(data []byte) (*tls.Certificate, error) {
+	var cert tls.Certificate
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			cert.Certificate = append(cert.Certificate, block.Bytes)
+		} else {
+			key, err := parsePrivateKey(block.Bytes)
+			if err != nil {
+				return nil, newIllegalArgumentError("Failed to read private key: %s", err)
+			}
+			cert.PrivateKey = key
+		}
+		data = rest
+	}
+
+	if len(cert.Certificate) == 0 {
+		return nil, newIllegalArgumentError("No certificate found in PEM data")
+	}
+	if cert.PrivateKey == nil {
+		return nil, newIllegalArgumentError("No private key found in PEM data")
+	}
+	return &cert, nil
+}
+
+// LoadCertificateFromPEMFile is like LoadCertificateFromPEM but reads the data from a file
+func LoadCertificateFromPEMFile(path string) (*tls.Certificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadCertificateFromPEM(data)
+}
+
+// LoadCertificateFromPFX parses a client certificate together with its
+// private key from PKCS#12 (.pfx) data protected with password
+func LoadCertificateFromPFX(data []byte, password string) (*tls.Certificate, error) {
+	blocks, err := pkcs12.ToPEM(data, password)
+	if err != nil {
+		return nil, newIllegalArgumentError("Failed to read PFX certificate: %s", err)
+	}
+	var pemData []byte
+	for _, block := range blocks {
+		pemData = append(pemData, pem.EncodeToMemory(block)...)
+	}
+	return LoadCertificateFromPEM(pemData)
+}
+
+// LoadCertificateFromPFXFile is like LoadCertificateFromPFX but reads the data from a file
+func LoadCertificateFromPFXFile(path string, password string) (*tls.Certificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadCertificateFromPFX(data, password)
+}
+
+// LoadTrustStoreFromPEM parses a PEM encoded certificate of the authority
+// that signed server's certificate.
+// The result can be used as DocumentStore.TrustStore
+func LoadTrustStoreFromPEM(data []byte) (*x509.Certificate, error) {
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			return nil, newIllegalArgumentError("No certificate found in PEM data")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+		data = rest
+	}
+}
+
+func parsePrivateKey(der []byte) (crypto.PrivateKey, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		switch key := key.(type) {
+		case *rsa.PrivateKey, *ecdsa.PrivateKey:
+			return key, nil
+		default:
+			return nil, newIllegalArgumentError("Found unknown private key type in PKCS#8 wrapping")
+		}
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	return nil, newIllegalArgumentError("Failed to parse private key")
+}
@@ -0,0 +1,183 @@
+package ravendb
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newTestCertificate(t *testing.T, name string, isCA bool, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		DNSNames:              []string{name},
+	}
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
+
+func TestLoadCertificateFromPEM(t *testing.T) {
+	c := newTestCertificate(t, "client", false, nil)
+
+	cert, err := LoadCertificateFromPEM(append(c.certPEM, c.keyPEM...))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(cert.Certificate))
+	assert.Equal(t, c.cert.Raw, cert.Certificate[0])
+	assert.NotNil(t, cert.PrivateKey)
+
+	_, err = LoadCertificateFromPEM(c.certPEM)
+	assert.Error(t, err)
+	_, err = LoadCertificateFromPEM(c.keyPEM)
+	assert.Error(t, err)
+
+	trustStore, err := LoadTrustStoreFromPEM(append(c.keyPEM, c.certPEM...))
+	assert.NoError(t, err)
+	assert.Equal(t, c.cert.Raw, trustStore.Raw)
+
+	_, err = LoadCertificateFromPFX([]byte("not a pfx"), "secret")
+	assert.Error(t, err)
+}
+
+func TestNewTLSConfigVerifiesServerWithTrustStore(t *testing.T) {
+	ca := newTestCertificate(t, "ca", true, nil)
+	otherCA := newTestCertificate(t, "other-ca", true, nil)
+	// the server is accessed by ip address, which doesn't match the name
+	serverCert := newTestCertificate(t, "server.example.com", false, ca)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{serverCert.cert.Raw},
+			PrivateKey:  serverCert.key,
+		}},
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	get := func(trustStore *x509.Certificate) error {
+		config, err := newTLSConfig(nil, trustStore)
+		assert.NoError(t, err)
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+		rsp, err := client.Get(server.URL)
+		if err == nil {
+			rsp.Body.Close()
+		}
+		return err
+	}
+	assert.NoError(t, get(ca.cert))
+	assert.Error(t, get(otherCA.cert))
+
+	_, err := newTLSConfig(nil, nil)
+	assert.Error(t, err)
+}
+
+func TestRequestExecutorValidateUrls(t *testing.T) {
+	cert := &tls.Certificate{}
+
+	assert.NoError(t, requestExecutorValidateUrls([]string{"http://a:8080", "http://b:8080"}, nil))
+	assert.NoError(t, requestExecutorValidateUrls([]string{"https://a:8080"}, cert))
+	assert.Error(t, requestExecutorValidateUrls([]string{"http://a:8080"}, cert))
+	assert.Error(t, requestExecutorValidateUrls([]string{"https://a:8080", "http://b:8080"}, nil))
+	assert.Error(t, requestExecutorValidateUrls([]string{"a:8080"}, nil))
+}
+
+func TestNewForbiddenAccessError(t *testing.T) {
+	node := &ServerNode{URL: "https://a:8080", Database: "db"}
+
+	err := newForbiddenAccessError(node, nil, "GET /docs")
+	assert.Contains(t, err.Error(), "db@https://a:8080")
+	assert.Contains(t, err.Error(), "a certificate is required")
+
+	err = newForbiddenAccessError(node, &tls.Certificate{}, "GET /docs")
+	assert.Contains(t, err.Error(), "certificate does not have permission")
+}
+
+func TestTCPConnectWithoutServerCertificate(t *testing.T) {
+	ca := newTestCertificate(t, "ca", true, nil)
+	otherCA := newTestCertificate(t, "other-ca", true, nil)
+	serverCert := newTestCertificate(t, "server.example.com", false, ca)
+	client := newTestCertificate(t, "client", false, nil)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{serverCert.cert.Raw},
+			PrivateKey:  serverCert.key,
+		}},
+	})
+	assert.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	connect := func(serverCertificate *x509.Certificate) error {
+		var serverCertificateBase64 []byte
+		if serverCertificate != nil {
+			serverCertificateBase64 = []byte(base64.StdEncoding.EncodeToString(serverCertificate.Raw))
+		}
+		clientCertificate := &tls.Certificate{
+			Certificate: [][]byte{client.cert.Raw},
+			PrivateKey:  client.key,
+		}
+		conn, err := tcpConnect("tcp://"+listener.Addr().String(), serverCertificateBase64, clientCertificate)
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}
+	// the server's certificate isn't signed by system roots
+	// but without a trust store it's not verified
+	assert.NoError(t, connect(nil))
+	assert.NoError(t, connect(ca.cert))
+	assert.Error(t, connect(otherCA.cert))
+}
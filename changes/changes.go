@@ -0,0 +1,56 @@
+/*
+Package changes exposes notifications about changes in a database,
+obtained with DocumentStore.Changes().
+
+This package is the curated surface for change notifications: DatabaseChanges,
+the change types delivered to callbacks and their constants. Changes are
+only obtained from a store, so there are no constructors.
+
+The types are aliases of the types in package ravendb, so values can be
+used with either package:
+
+	cancel, err := store.Changes("").ForDocument("users/1", func(change *changes.DocumentChange) {
+		if change.Type == changes.DocumentChangePut {
+			// ...
+		}
+	})
+*/
+package changes
+
+import (
+	ravendb "github.com/ravendb/ravendb-go-client"
+)
+
+type (
+	CancelFunc            = ravendb.CancelFunc
+	DatabaseChanges       = ravendb.DatabaseChanges
+	DocumentChange        = ravendb.DocumentChange
+	DocumentChangeTypes   = ravendb.DocumentChangeTypes
+	IndexChange           = ravendb.IndexChange
+	IndexChangeTypes      = ravendb.IndexChangeTypes
+	OperationStatusChange = ravendb.OperationStatusChange
+)
+
+const (
+	DocumentChangeNone     = ravendb.DocumentChangeNone
+	DocumentChangePut      = ravendb.DocumentChangePut
+	DocumentChangeDelete   = ravendb.DocumentChangeDelete
+	DocumentChangeConflict = ravendb.DocumentChangeConflict
+	DocumentChangeCommon   = ravendb.DocumentChangeCommon
+)
+
+const (
+	IndexChangeNone                   = ravendb.IndexChangeNone
+	IndexChangeBatchCompleted         = ravendb.IndexChangeBatchCompleted
+	IndexChangeIndexAdded             = ravendb.IndexChangeIndexAdded
+	IndexChangeIndexRemoved           = ravendb.IndexChangeIndexRemoved
+	IndexChangeIndexDemotedToIdle     = ravendb.IndexChangeIndexDemotedToIdle
+	IndexChangeIndexPromotedFromIdle  = ravendb.IndexChangeIndexPromotedFromIdle
+	IndexChangeIndexDemotedToDisabled = ravendb.IndexChangeIndexDemotedToDisabled
+	IndexChangeIndexMarkedAsErrored   = ravendb.IndexChangeIndexMarkedAsErrored
+	IndexChangeSideBySideReplace      = ravendb.IndexChangeSideBySideReplace
+	IndexChangeRenamed                = ravendb.IndexChangeRenamed
+	IndexChangeIndexPaused            = ravendb.IndexChangeIndexPaused
+	IndexChangeLockModeChanged        = ravendb.IndexChangeLockModeChanged
+	IndexChangePriorityChanged        = ravendb.IndexChangePriorityChanged
+)
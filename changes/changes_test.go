@@ -0,0 +1,18 @@
+package changes
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasesAreInterchangeable(t *testing.T) {
+	var onChange func(*ravendb.DocumentChange) = func(change *DocumentChange) {}
+	onChange(&DocumentChange{Type: DocumentChangePut})
+
+	var typ DocumentChangeTypes = ravendb.DocumentChangeDelete
+	assert.Equal(t, DocumentChangeDelete, typ)
+	var indexChange IndexChangeTypes = ravendb.IndexChangeIndexAdded
+	assert.Equal(t, IndexChangeIndexAdded, indexChange)
+}
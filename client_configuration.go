@@ -7,4 +7,6 @@ type ClientConfiguration struct {
 	// TODO: should this be *int ?
 	MaxNumberOfRequestsPerSession int                 `json:"MaxNumberOfRequestsPerSession"`
 	ReadBalanceBehavior           ReadBalanceBehavior `json:"ReadBalanceBehavior"`
+	LoadBalanceBehavior           LoadBalanceBehavior `json:"LoadBalanceBehavior"`
+	LoadBalancerContextSeed       int                 `json:"LoadBalancerContextSeed"`
 }
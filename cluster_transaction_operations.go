@@ -0,0 +1,430 @@
+package ravendb
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// compareExchangeSessionValue is a compare exchange value tracked by a session
+type compareExchangeSessionValue struct {
+	// nil if we know the value doesn't exist on the server
+	value *CompareExchangeValue
+	// JSON of value and metadata when it was loaded or last saved,
+	// used to detect changes
+	original []byte
+	created  bool
+	deleted  bool
+	// set by UpdateCompareExchangeValue, forces a PUT on SaveChanges
+	modified bool
+}
+
+// ClusterTransactionOperations reads and modifies compare exchange values
+// as part of a cluster-wide session. Modifications are sent together with
+// document changes on SaveChanges
+type ClusterTransactionOperations struct {
+	session *DocumentSession
+	// keyed by lower-case key
+	state map[string]*compareExchangeSessionValue
+}
+
+func newClusterTransactionOperations(session *DocumentSession) *ClusterTransactionOperations {
+	return &ClusterTransactionOperations{
+		session: session,
+		state:   map[string]*compareExchangeSessionValue{},
+	}
+}
+
+// GetCompareExchangeValue returns compare exchange value with a given key
+// or nil if it doesn't exist. The value is converted to clazz.
+// Values already tracked by the session are returned without a server call
+func (o *ClusterTransactionOperations) GetCompareExchangeValue(clazz reflect.Type, key string) (*CompareExchangeValue, error) {
+	values, err := o.GetCompareExchangeValues(clazz, []string{key})
+	if err != nil {
+		return nil, err
+	}
+	return values[key], nil
+}
+
+// GetCompareExchangeValues returns compare exchange values with given keys.
+// Keys that don't exist are not in the result
+func (o *ClusterTransactionOperations) GetCompareExchangeValues(clazz reflect.Type, keys []string) (map[string]*CompareExchangeValue, error) {
+	if err := checkCompareExchangeKeys(clazz, keys); err != nil {
+		return nil, err
+	}
+	if err := o.trackIncluded(clazz, keys); err != nil {
+		return nil, err
+	}
+	if toFetch := o.keysNotTracked(keys); len(toFetch) > 0 {
+		if err := o.session.incrementRequestCount(); err != nil {
+			return nil, err
+		}
+		operation, err := NewGetCompareExchangeValuesOperationWithKeys(clazz, toFetch)
+		if err != nil {
+			return nil, err
+		}
+		command, err := NewGetCompareExchangeValuesCommand(operation, o.session.GetConventions())
+		if err != nil {
+			return nil, err
+		}
+		if err = o.session.GetRequestExecutor().ExecuteCommand(command, o.session.sessionInfo); err != nil {
+			return nil, err
+		}
+		if err = o.trackFetched(toFetch, command.Result); err != nil {
+			return nil, err
+		}
+	}
+	return o.trackedValues(keys), nil
+}
+
+// LazyGetCompareExchangeValue returns a lazy compare exchange value with
+// a given key. Call GetValue with **CompareExchangeValue to get it.
+// All pending lazy operations are sent to the server in a single request
+func (o *ClusterTransactionOperations) LazyGetCompareExchangeValue(clazz reflect.Type, key string) (*Lazy, error) {
+	return o.LazyGetCompareExchangeValues(clazz, []string{key})
+}
+
+// LazyGetCompareExchangeValues returns lazy compare exchange values with
+// given keys. Call GetValue with map[string]*CompareExchangeValue to get them.
+// All pending lazy operations are sent to the server in a single request
+func (o *ClusterTransactionOperations) LazyGetCompareExchangeValues(clazz reflect.Type, keys []string) (*Lazy, error) {
+	if err := checkCompareExchangeKeys(clazz, keys); err != nil {
+		return nil, err
+	}
+	op := newLazyCompareExchangeValuesOperation(o, clazz, keys)
+	return o.session.addLazyOperation(op, nil, nil), nil
+}
+
+// CreateCompareExchangeValue creates a new compare exchange value which is
+// saved on SaveChanges. Saving fails if the key already exists on the server
+func (o *ClusterTransactionOperations) CreateCompareExchangeValue(key string, value interface{}) (*CompareExchangeValue, error) {
+	if key == "" {
+		return nil, newIllegalArgumentError("key cannot be empty")
+	}
+	lowerKey := strings.ToLower(key)
+	if tracked := o.state[lowerKey]; tracked != nil && tracked.value != nil {
+		return nil, newIllegalStateError("The compare exchange value with key '%s' is already tracked", key)
+	}
+	v := NewCompareExchangeValue(key, 0, value)
+	o.state[lowerKey] = &compareExchangeSessionValue{
+		value:   v,
+		created: true,
+	}
+	return v, nil
+}
+
+// UpdateCompareExchangeValue marks item to be saved on SaveChanges.
+// Changes to values returned by GetCompareExchangeValue are detected
+// automatically, this is only needed for values obtained elsewhere.
+// Saving fails if item.Index is not the current index on the server
+func (o *ClusterTransactionOperations) UpdateCompareExchangeValue(item *CompareExchangeValue) error {
+	if item == nil {
+		return newIllegalArgumentError("item cannot be nil")
+	}
+	if item.Key == "" {
+		return newIllegalArgumentError("item.Key cannot be empty")
+	}
+	lowerKey := strings.ToLower(item.Key)
+	tracked := o.state[lowerKey]
+	if tracked != nil && tracked.deleted {
+		return newIllegalStateError("The compare exchange value with key '%s' was deleted", item.Key)
+	}
+	if tracked == nil || tracked.value == nil {
+		tracked = &compareExchangeSessionValue{}
+		o.state[lowerKey] = tracked
+	}
+	tracked.value = item
+	tracked.modified = !tracked.created
+	return nil
+}
+
+// DeleteCompareExchangeValue marks item to be deleted on SaveChanges.
+// Deleting fails if item.Index is not the current index on the server
+func (o *ClusterTransactionOperations) DeleteCompareExchangeValue(item *CompareExchangeValue) error {
+	if item == nil {
+		return newIllegalArgumentError("item cannot be nil")
+	}
+	return o.DeleteCompareExchangeValueByKey(item.Key, item.Index)
+}
+
+// DeleteCompareExchangeValueByKey marks compare exchange value with a given
+// key to be deleted on SaveChanges, if its index on the server is index
+func (o *ClusterTransactionOperations) DeleteCompareExchangeValueByKey(key string, index int64) error {
+	if key == "" {
+		return newIllegalArgumentError("key cannot be empty")
+	}
+	lowerKey := strings.ToLower(key)
+	tracked := o.state[lowerKey]
+	if tracked != nil && tracked.created {
+		// never saved, nothing to delete on the server
+		delete(o.state, lowerKey)
+		return nil
+	}
+	if tracked == nil || tracked.value == nil {
+		tracked = &compareExchangeSessionValue{
+			value: NewCompareExchangeValue(key, index, nil),
+		}
+		o.state[lowerKey] = tracked
+	}
+	tracked.value.Index = index
+	tracked.deleted = true
+	return nil
+}
+
+func checkCompareExchangeKeys(clazz reflect.Type, keys []string) error {
+	if clazz == nil {
+		return newIllegalArgumentError("clazz cannot be nil")
+	}
+	if len(keys) == 0 {
+		return newIllegalArgumentError("keys cannot be empty")
+	}
+	for _, key := range keys {
+		if key == "" {
+			return newIllegalArgumentError("key cannot be empty")
+		}
+	}
+	return nil
+}
+
+// trackIncluded starts tracking values with given keys that were included
+// by queries
+func (o *ClusterTransactionOperations) trackIncluded(clazz reflect.Type, keys []string) error {
+	for _, key := range keys {
+		if _, ok := o.state[strings.ToLower(key)]; ok {
+			continue
+		}
+		included := o.session.includedCompareExchange[key]
+		if included == nil {
+			continue
+		}
+		value := getDefaultValueForType(clazz)
+		if included.Value != nil {
+			var err error
+			if value, err = convertValue(included.Value, clazz); err != nil {
+				return err
+			}
+		}
+		v := NewCompareExchangeValue(included.Key, included.Index, value)
+		v.Metadata = included.Metadata
+		if err := o.track(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *ClusterTransactionOperations) keysNotTracked(keys []string) []string {
+	var res []string
+	for _, key := range keys {
+		if _, ok := o.state[strings.ToLower(key)]; !ok {
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
+// trackFetched tracks values fetched from the server. Requested keys
+// without a value are remembered as missing. Values that are already
+// tracked are not replaced so that their modifications are not lost
+func (o *ClusterTransactionOperations) trackFetched(keys []string, values map[string]*CompareExchangeValue) error {
+	for _, v := range values {
+		if _, ok := o.state[strings.ToLower(v.Key)]; ok {
+			continue
+		}
+		if err := o.track(v); err != nil {
+			return err
+		}
+	}
+	for _, key := range keys {
+		lowerKey := strings.ToLower(key)
+		if _, ok := o.state[lowerKey]; !ok {
+			o.state[lowerKey] = &compareExchangeSessionValue{}
+		}
+	}
+	return nil
+}
+
+func (o *ClusterTransactionOperations) track(v *CompareExchangeValue) error {
+	original, err := compareExchangeValueSnapshot(v)
+	if err != nil {
+		return err
+	}
+	o.state[strings.ToLower(v.Key)] = &compareExchangeSessionValue{
+		value:    v,
+		original: original,
+	}
+	return nil
+}
+
+// trackedValues returns existing, not deleted values for given keys
+func (o *ClusterTransactionOperations) trackedValues(keys []string) map[string]*CompareExchangeValue {
+	res := map[string]*CompareExchangeValue{}
+	for _, key := range keys {
+		tracked := o.state[strings.ToLower(key)]
+		if tracked == nil || tracked.value == nil || tracked.deleted {
+			continue
+		}
+		res[key] = tracked.value
+	}
+	return res
+}
+
+func compareExchangeValueSnapshot(v *CompareExchangeValue) ([]byte, error) {
+	m := map[string]interface{}{
+		"Object": v.Value,
+	}
+	if len(v.Metadata) > 0 {
+		m[MetadataKey] = v.Metadata
+	}
+	return jsonMarshal(m)
+}
+
+// prepareCommands returns batch commands for created, changed and deleted values
+func (o *ClusterTransactionOperations) prepareCommands() ([]ICommandData, error) {
+	var lowerKeys []string
+	for lowerKey := range o.state {
+		lowerKeys = append(lowerKeys, lowerKey)
+	}
+	// deterministic order of commands
+	sort.Strings(lowerKeys)
+
+	var res []ICommandData
+	for _, lowerKey := range lowerKeys {
+		tracked := o.state[lowerKey]
+		v := tracked.value
+		if v == nil {
+			continue
+		}
+		if tracked.deleted {
+			res = append(res, NewDeleteCompareExchangeCommandData(v.Key, v.Index))
+			continue
+		}
+		if !tracked.created && !tracked.modified {
+			current, err := compareExchangeValueSnapshot(v)
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(current, tracked.original) {
+				continue
+			}
+		}
+		cmd := NewPutCompareExchangeCommandData(v.Key, v.Value, v.Index)
+		cmd.metadata = v.Metadata
+		res = append(res, cmd)
+	}
+	return res, nil
+}
+
+// updateState updates tracked values with results of a batch
+func (o *ClusterTransactionOperations) updateState(results []map[string]interface{}) error {
+	for _, result := range results {
+		typ, _ := jsonGetAsText(result, "Type")
+		if typ != CommandCompareExchangePut && typ != CommandCompareExchangeDelete {
+			continue
+		}
+		key, _ := jsonGetAsText(result, "Key")
+		lowerKey := strings.ToLower(key)
+		if typ == CommandCompareExchangeDelete {
+			o.state[lowerKey] = &compareExchangeSessionValue{}
+			continue
+		}
+		tracked := o.state[lowerKey]
+		if tracked == nil || tracked.value == nil {
+			continue
+		}
+		if index, ok := jsonGetAsInt64(result, "Index"); ok {
+			tracked.value.Index = index
+		}
+		original, err := compareExchangeValueSnapshot(tracked.value)
+		if err != nil {
+			return err
+		}
+		tracked.original = original
+		tracked.created = false
+		tracked.modified = false
+	}
+	return nil
+}
+
+var _ ILazyOperation = &lazyCompareExchangeValuesOperation{}
+
+// lazyCompareExchangeValuesOperation gets compare exchange values as part
+// of a multi-get request
+type lazyCompareExchangeValuesOperation struct {
+	clusterSession *ClusterTransactionOperations
+	clazz          reflect.Type
+	keys           []string
+	toFetch        []string
+}
+
+func newLazyCompareExchangeValuesOperation(clusterSession *ClusterTransactionOperations, clazz reflect.Type, keys []string) *lazyCompareExchangeValuesOperation {
+	return &lazyCompareExchangeValuesOperation{
+		clusterSession: clusterSession,
+		clazz:          clazz,
+		keys:           keys,
+	}
+}
+
+func (o *lazyCompareExchangeValuesOperation) createRequest() *getRequest {
+	// errors are reported by getResult
+	_ = o.clusterSession.trackIncluded(o.clazz, o.keys)
+	o.toFetch = o.clusterSession.keysNotTracked(o.keys)
+	if len(o.toFetch) == 0 {
+		// no need to hit the server
+		return nil
+	}
+	query := ""
+	for _, key := range o.toFetch {
+		if query == "" {
+			query += "?key="
+		} else {
+			query += "&key="
+		}
+		query += urlUtilsEscapeDataString(key)
+	}
+	return &getRequest{
+		url:   "/cmpxchg",
+		query: query,
+	}
+}
+
+func (o *lazyCompareExchangeValuesOperation) getQueryResult() *QueryResult {
+	return nil
+}
+
+func (o *lazyCompareExchangeValuesOperation) isRequiresRetry() bool {
+	return false
+}
+
+func (o *lazyCompareExchangeValuesOperation) handleResponse(response *GetResponse) error {
+	values := map[string]*CompareExchangeValue{}
+	if len(response.Result) > 0 {
+		var err error
+		values, err = compareExchangeValueResultParserGetValues(o.clazz, response.Result, o.clusterSession.session.GetConventions())
+		if err != nil {
+			return err
+		}
+	}
+	return o.clusterSession.trackFetched(o.toFetch, values)
+}
+
+func (o *lazyCompareExchangeValuesOperation) getResult(results interface{}) error {
+	if err := o.clusterSession.trackIncluded(o.clazz, o.keys); err != nil {
+		return err
+	}
+	values := o.clusterSession.trackedValues(o.keys)
+	switch res := results.(type) {
+	case **CompareExchangeValue:
+		*res = values[o.keys[0]]
+	case map[string]*CompareExchangeValue:
+		for key, v := range values {
+			res[key] = v
+		}
+	case *map[string]*CompareExchangeValue:
+		*res = values
+	default:
+		return newIllegalArgumentError("results should be **CompareExchangeValue or map[string]*CompareExchangeValue, is %T", results)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterTransactionRequiresClusterWideSession(t *testing.T) {
+	session := &DocumentSession{InMemoryDocumentSessionOperations: &InMemoryDocumentSessionOperations{}}
+	_, err := session.ClusterTransaction()
+	assert.Error(t, err)
+
+	session.transactionMode = TransactionModeClusterWide
+	ct, err := session.ClusterTransaction()
+	assert.NoError(t, err)
+	ct2, err := session.ClusterTransaction()
+	assert.NoError(t, err)
+	assert.True(t, ct == ct2)
+}
+
+func TestClusterTransactionPrepareCommands(t *testing.T) {
+	session := &DocumentSession{InMemoryDocumentSessionOperations: &InMemoryDocumentSessionOperations{}}
+	ct := newClusterTransactionOperations(session)
+
+	unchanged := NewCompareExchangeValue("unchanged", 3, "a")
+	assert.NoError(t, ct.track(unchanged))
+	changed := NewCompareExchangeValue("Changed", 4, "a")
+	assert.NoError(t, ct.track(changed))
+	changed.Value = "b"
+	deleted := NewCompareExchangeValue("deleted", 5, "a")
+	assert.NoError(t, ct.track(deleted))
+	assert.NoError(t, ct.DeleteCompareExchangeValue(deleted))
+	_, err := ct.CreateCompareExchangeValue("new", "c")
+	assert.NoError(t, err)
+	_, err = ct.CreateCompareExchangeValue("NEW", "c")
+	assert.Error(t, err)
+	// deleting a value that was never saved just forgets it
+	_, err = ct.CreateCompareExchangeValue("forgotten", "d")
+	assert.NoError(t, err)
+	assert.NoError(t, ct.DeleteCompareExchangeValueByKey("forgotten", 0))
+
+	commands, err := ct.prepareCommands()
+	assert.NoError(t, err)
+	var got []interface{}
+	for _, cmd := range commands {
+		js, err := cmd.serialize(nil)
+		assert.NoError(t, err)
+		got = append(got, js)
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"Key":      "Changed",
+			"Index":    int64(4),
+			"Document": map[string]interface{}{"Object": "b"},
+			"Type":     CommandCompareExchangePut,
+		},
+		map[string]interface{}{
+			"Key":   "deleted",
+			"Index": int64(5),
+			"Type":  CommandCompareExchangeDelete,
+		},
+		map[string]interface{}{
+			"Key":      "new",
+			"Index":    int64(0),
+			"Document": map[string]interface{}{"Object": "c"},
+			"Type":     CommandCompareExchangePut,
+		},
+	}
+	assert.Equal(t, expected, got)
+
+	results := []map[string]interface{}{
+		{"Type": CommandCompareExchangePut, "Key": "Changed", "Index": float64(10)},
+		{"Type": CommandCompareExchangeDelete, "Key": "deleted", "Index": float64(11)},
+		{"Type": CommandCompareExchangePut, "Key": "new", "Index": float64(12)},
+	}
+	assert.NoError(t, ct.updateState(results))
+	assert.Equal(t, int64(10), changed.Index)
+	values := ct.trackedValues([]string{"changed", "deleted", "new"})
+	assert.Equal(t, 2, len(values))
+	assert.Equal(t, int64(12), values["new"].Index)
+
+	commands, err = ct.prepareCommands()
+	assert.NoError(t, err)
+	assert.Empty(t, commands)
+}
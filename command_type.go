@@ -7,11 +7,16 @@ type CommandType = string
 // making them strings is better in Go
 const (
 	//CommandNone                = "NONE"
-	CommandPut                 = "PUT"
-	CommandPatch               = "PATCH"
-	CommandDelete              = "DELETE"
-	CommandAttachmentPut       = "ATTACHMENT_PUT"
-	CommandAttachmentDelete    = "ATTACHMENT_DELETE"
-	CommandClientAnyCommand    = "CLIENT_ANY_COMMAND"
-	CommandClientNotAttachment = "CLIENT_NOT_ATTACHMENT"
+	CommandPut                   = "PUT"
+	CommandPatch                 = "PATCH"
+	CommandDelete                = "DELETE"
+	CommandAttachmentPut         = "ATTACHMENT_PUT"
+	CommandAttachmentDelete      = "ATTACHMENT_DELETE"
+	CommandClientAnyCommand      = "CLIENT_ANY_COMMAND"
+	CommandClientNotAttachment   = "CLIENT_NOT_ATTACHMENT"
+	CommandCompareExchangePut    = "CompareExchangePUT"
+	CommandCompareExchangeDelete = "CompareExchangeDELETE"
+	CommandCounters              = "Counters"
+	CommandTimeSeries            = "TimeSeries"
+	CommandForceRevisionCreation = "ForceRevisionCreation"
 )
@@ -16,6 +16,8 @@ type CompactDatabaseOperation struct {
 }
 
 // NewCompactDatabaseOperation returns new CompactDatabaseOperation
+//
+// Deprecated: use operations.NewCompactDatabaseOperation.
 func NewCompactDatabaseOperation(compactSettings *CompactSettings) *CompactDatabaseOperation {
 	return &CompactDatabaseOperation{
 		compactSettings: compactSettings,
@@ -51,6 +53,12 @@ func NewCompactDatabaseCommand(conventions *DocumentConventions, compactSettings
 	if compactSettings == nil {
 		return nil, newIllegalArgumentError("CompactSettings cannot be null")
 	}
+	if compactSettings.DatabaseName == "" {
+		return nil, newIllegalArgumentError("DatabaseName is required for compaction")
+	}
+	if !compactSettings.Documents && len(compactSettings.Indexes) == 0 {
+		return nil, newIllegalArgumentError("Either Documents or Indexes must be specified for compaction")
+	}
 
 	d, err := jsonMarshal(compactSettings)
 	if err != nil {
@@ -0,0 +1,30 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactDatabaseCommand(t *testing.T) {
+	conventions := NewDocumentConventions()
+	_, err := NewCompactDatabaseCommand(conventions, nil)
+	assert.Error(t, err)
+	_, err = NewCompactDatabaseCommand(conventions, &CompactSettings{Documents: true})
+	assert.Error(t, err)
+	_, err = NewCompactDatabaseCommand(conventions, &CompactSettings{DatabaseName: "db"})
+	assert.Error(t, err)
+
+	settings := &CompactSettings{
+		DatabaseName:        "db",
+		Indexes:             []string{"Users/ByName"},
+		SkipOptimizeIndexes: true,
+	}
+	cmd, err := NewCompactDatabaseCommand(conventions, settings)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080"})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/admin/compact", req.URL.String())
+	exp := `{"DatabaseName":"db","Documents":false,"Indexes":["Users/ByName"],"SkipOptimizeIndexes":true}`
+	assert.Equal(t, exp, string(cmd.compactSettings))
+}
@@ -2,7 +2,12 @@ package ravendb
 
 // CompactSettings is an argument to CompactDatabaseOperation
 type CompactSettings struct {
-	DatabaseName string   `json:"DatabaseName"`
-	Documents    bool     `json:"Documents"`
-	Indexes      []string `json:"Indexes,omitempty"`
+	DatabaseName string `json:"DatabaseName"`
+	// Documents compacts document storage
+	Documents bool `json:"Documents"`
+	// Indexes is a list of names of indexes to compact
+	Indexes []string `json:"Indexes,omitempty"`
+	// SkipOptimizeIndexes skips optimization of Lucene indexes
+	// after compacting them, which makes compaction faster
+	SkipOptimizeIndexes bool `json:"SkipOptimizeIndexes"`
 }
@@ -0,0 +1,72 @@
+package ravendb
+
+var (
+	_ ICommandData = &PutCompareExchangeCommandData{}
+	_ ICommandData = &DeleteCompareExchangeCommandData{}
+)
+
+// PutCompareExchangeCommandData is a batch command that stores a compare
+// exchange value. Only valid in cluster-wide transactions
+type PutCompareExchangeCommandData struct {
+	CommandData
+	index    int64
+	value    interface{}
+	metadata map[string]interface{}
+}
+
+// NewPutCompareExchangeCommandData returns a command that stores value under key
+// if its current index is index (0 means the key must not exist)
+func NewPutCompareExchangeCommandData(key string, value interface{}, index int64) *PutCompareExchangeCommandData {
+	return &PutCompareExchangeCommandData{
+		CommandData: CommandData{
+			ID:   key,
+			Type: CommandCompareExchangePut,
+		},
+		index: index,
+		value: value,
+	}
+}
+
+func (d *PutCompareExchangeCommandData) serialize(conventions *DocumentConventions) (interface{}, error) {
+	document := map[string]interface{}{
+		"Object": d.value,
+	}
+	if len(d.metadata) > 0 {
+		document[MetadataKey] = d.metadata
+	}
+	res := map[string]interface{}{
+		"Key":      d.ID,
+		"Index":    d.index,
+		"Document": document,
+		"Type":     d.Type,
+	}
+	return res, nil
+}
+
+// DeleteCompareExchangeCommandData is a batch command that deletes a compare
+// exchange value. Only valid in cluster-wide transactions
+type DeleteCompareExchangeCommandData struct {
+	CommandData
+	index int64
+}
+
+// NewDeleteCompareExchangeCommandData returns a command that deletes key
+// if its current index is index
+func NewDeleteCompareExchangeCommandData(key string, index int64) *DeleteCompareExchangeCommandData {
+	return &DeleteCompareExchangeCommandData{
+		CommandData: CommandData{
+			ID:   key,
+			Type: CommandCompareExchangeDelete,
+		},
+		index: index,
+	}
+}
+
+func (d *DeleteCompareExchangeCommandData) serialize(conventions *DocumentConventions) (interface{}, error) {
+	res := map[string]interface{}{
+		"Key":   d.ID,
+		"Index": d.index,
+		"Type":  d.Type,
+	}
+	return res, nil
+}
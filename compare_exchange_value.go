@@ -5,6 +5,8 @@ type CompareExchangeValue struct {
 	Key   string
 	Index int64
 	Value interface{}
+	// Metadata is nil if the value has no metadata
+	Metadata map[string]interface{}
 }
 
 // NewCompareExchangeValue returns new CompareExchangeValue
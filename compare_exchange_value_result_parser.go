@@ -49,6 +49,7 @@ func compareExchangeValueResultParserGetValues(clazz reflect.Type, response []by
 			return nil, newIllegalStateError("Response is invalid. Value is missing.")
 		}
 
+		var v *CompareExchangeValue
 		if isTypePrimitive(clazz) {
 			var value interface{}
 			rawValue := rawMap["Object"]
@@ -56,22 +57,23 @@ func compareExchangeValueResultParserGetValues(clazz reflect.Type, response []by
 			if err != nil {
 				return nil, err
 			}
-			cmpValue := NewCompareExchangeValue(key, index, value)
-			results[key] = cmpValue
+			v = NewCompareExchangeValue(key, index, value)
 		} else {
 			object, ok := rawMap["Object"]
 			if !ok || object == nil {
-				v := NewCompareExchangeValue(key, index, getDefaultValueForType(clazz))
-				results[key] = v
+				v = NewCompareExchangeValue(key, index, getDefaultValueForType(clazz))
 			} else {
 				converted, err := convertValue(object, clazz)
 				if err != nil {
 					return nil, err
 				}
-				v := NewCompareExchangeValue(key, index, converted)
-				results[key] = v
+				v = NewCompareExchangeValue(key, index, converted)
 			}
 		}
+		if metadata, ok := rawMap[MetadataKey].(map[string]interface{}); ok {
+			v.Metadata = metadata
+		}
+		results[key] = v
 	}
 
 	return results, nil
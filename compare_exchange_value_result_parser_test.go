@@ -0,0 +1,34 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareExchangeValueResultParser(t *testing.T) {
+	response := []byte(`{"Results":[{"Key":"k1","Index":5,"Value":{"Object":"v1","@metadata":{"Source":"test"}}},{"Key":"k2","Index":6,"Value":{"Object":"v2"}}]}`)
+	values, err := compareExchangeValueResultParserGetValues(reflect.TypeOf(""), response, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(values))
+	assert.Equal(t, "v1", values["k1"].Value)
+	assert.Equal(t, int64(5), values["k1"].Index)
+	assert.Equal(t, "test", values["k1"].Metadata["Source"])
+	assert.Nil(t, values["k2"].Metadata)
+}
+
+func TestPutCompareExchangeValueRequest(t *testing.T) {
+	op, err := NewPutCompareExchangeValueOperationWithMetadata("a&b c", "v", 3, map[string]interface{}{"Source": "test"})
+	assert.NoError(t, err)
+	cmd, err := op.GetCommand(nil, NewDocumentConventions(), nil)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a&b c", req.URL.Query().Get("key"))
+	assert.Equal(t, "3", req.URL.Query().Get("index"))
+	d, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"@metadata":{"Source":"test"},"Object":"v"}`, string(d))
+}
@@ -1,12 +1,11 @@
 package ravendb
 
 import (
+	"context"
 	"sync"
 	"time"
 )
 
-// TODO: write tests
-
 /*
 Note:
 
@@ -31,8 +30,8 @@ type completableFuture struct {
 	mu sync.Mutex
 
 	completed bool
-	// used to wait for Future to finish
-	signalCompletion chan bool
+	// closed when Future finishes so that any number of waiters are released
+	signalCompletion chan struct{}
 
 	// result generated by the Future, only valid if completed
 	result interface{}
@@ -41,9 +40,7 @@ type completableFuture struct {
 
 func newCompletableFuture() *completableFuture {
 	return &completableFuture{
-		// channel with capacity 1 so that complete() can finish the goroutine
-		// without waiting for someone to call Get()
-		signalCompletion: make(chan bool, 1),
+		signalCompletion: make(chan struct{}),
 	}
 }
 
@@ -64,7 +61,7 @@ func (f *completableFuture) markCompleted(result interface{}, err error) {
 	f.completed = true
 	f.result = result
 	f.err = err
-	f.signalCompletion <- true
+	close(f.signalCompletion)
 }
 
 // complete marks the future as completed with a given result (which can be nil)
@@ -107,7 +104,7 @@ func (f *completableFuture) IsCompletedExceptionally() bool {
 // isCancelled returns true if future was cancelled by calling cancel()
 func (f *completableFuture) isCancelled() bool {
 	var isCancelled bool
-	_, err, _ := f.getState()
+	_, _, err := f.getState()
 	if err != nil {
 		_, isCancelled = err.(*CancellationError)
 	}
@@ -144,3 +141,23 @@ func (f *completableFuture) GetWithTimeout(dur time.Duration) (interface{}, erro
 	_, res, err = f.getState()
 	return res, err
 }
+
+// GetWithContext waits for completion and returns resulting value or error.
+// Returns ctx.Err() if ctx is cancelled or its deadline expires before
+// the Future completes.
+func (f *completableFuture) GetWithContext(ctx context.Context) (interface{}, error) {
+	done, res, err := f.getState()
+	if done {
+		return res, err
+	}
+
+	select {
+	case <-f.signalCompletion:
+		// completed, will return the result
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	_, res, err = f.getState()
+	return res, err
+}
@@ -0,0 +1,51 @@
+package ravendb
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompletableFutureGetWithContext(t *testing.T) {
+	{
+		f := newCompletableFuture()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+		res, err := f.GetWithContext(ctx)
+		assert.Nil(t, res)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.False(t, f.IsDone())
+	}
+
+	{
+		// all waiters must be released on completion
+		f := newCompletableFuture()
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := f.GetWithContext(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, 5, res)
+			}()
+		}
+		f.complete(5)
+		wg.Wait()
+
+		res, err := f.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, 5, res)
+	}
+
+	{
+		f := newCompletableFuture()
+		f.cancel(false)
+		_, err := f.GetWithContext(context.Background())
+		_, ok := err.(*CancellationError)
+		assert.True(t, ok)
+	}
+}
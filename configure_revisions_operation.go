@@ -15,6 +15,8 @@ type ConfigureRevisionsOperation struct {
 }
 
 // NewConfigureRevisionsOperation returns new ConfigureRevisionsOperation
+//
+// Deprecated: use operations.NewConfigureRevisionsOperation.
 func NewConfigureRevisionsOperation(configuration *RevisionsConfiguration) *ConfigureRevisionsOperation {
 	return &ConfigureRevisionsOperation{
 		configuration: configuration,
@@ -23,6 +25,9 @@ func NewConfigureRevisionsOperation(configuration *RevisionsConfiguration) *Conf
 
 // GetCommand returns new RavenCommand for this operation
 func (o *ConfigureRevisionsOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	if o.configuration == nil {
+		return nil, newIllegalArgumentError("Configuration cannot be null")
+	}
 	o.Command = NewConfigureRevisionsCommand(o.configuration)
 	return o.Command, nil
 }
@@ -59,6 +64,9 @@ func (c *ConfigureRevisionsCommand) CreateRequest(node *ServerNode) (*http.Reque
 }
 
 func (c *ConfigureRevisionsCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
 	return jsonUnmarshal(response, &c.Result)
 }
 
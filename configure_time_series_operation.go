@@ -0,0 +1,79 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &ConfigureTimeSeriesOperation{}
+)
+
+// ConfigureTimeSeriesOperation sets rollup and retention policies of time series
+type ConfigureTimeSeriesOperation struct {
+	configuration *TimeSeriesConfiguration
+	Command       *ConfigureTimeSeriesCommand
+}
+
+// NewConfigureTimeSeriesOperation returns new ConfigureTimeSeriesOperation
+//
+// Deprecated: use operations.NewConfigureTimeSeriesOperation.
+func NewConfigureTimeSeriesOperation(configuration *TimeSeriesConfiguration) *ConfigureTimeSeriesOperation {
+	return &ConfigureTimeSeriesOperation{
+		configuration: configuration,
+	}
+}
+
+// GetCommand returns new RavenCommand for this operation
+func (o *ConfigureTimeSeriesOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	if o.configuration == nil {
+		return nil, newIllegalArgumentError("Configuration cannot be null")
+	}
+	if err := o.configuration.validate(); err != nil {
+		return nil, err
+	}
+	o.Command = NewConfigureTimeSeriesCommand(o.configuration)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &ConfigureTimeSeriesCommand{}
+
+// ConfigureTimeSeriesCommand is a command for ConfigureTimeSeriesOperation
+type ConfigureTimeSeriesCommand struct {
+	RavenCommandBase
+
+	configuration *TimeSeriesConfiguration
+
+	Result *ConfigureTimeSeriesOperationResult
+}
+
+// NewConfigureTimeSeriesCommand returns new ConfigureTimeSeriesCommand
+func NewConfigureTimeSeriesCommand(configuration *TimeSeriesConfiguration) *ConfigureTimeSeriesCommand {
+	cmd := &ConfigureTimeSeriesCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		configuration: configuration,
+	}
+	return cmd
+}
+
+func (c *ConfigureTimeSeriesCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/timeseries/config"
+
+	d, err := jsonMarshal(c.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *ConfigureTimeSeriesCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
+
+// ConfigureTimeSeriesOperationResult is a result of ConfigureTimeSeriesOperation
+type ConfigureTimeSeriesOperationResult struct {
+	RaftCommandIndex int64 `json:"RaftCommandIndex"`
+}
@@ -1,10 +1,11 @@
 package ravendb
 
-// TODO: only used in ConnectionString which is unused
+// ConnectionStringType describes the kind of destination a connection string points to
 type ConnectionStringType = string
 
 const (
 	ConnectionStringTypeNone  = "None"
 	ConnectionStringTypeRaven = "Raven"
 	ConnectionStringTypeSQL   = "Sql"
+	ConnectionStringTypeOlap  = "Olap"
 )
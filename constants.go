@@ -12,6 +12,8 @@ const (
 	MetadataIDProperty             = "Id"
 	MetadataFlags                  = "@flags"
 	MetadataAttachments            = "@attachments"
+	MetadataCounters               = "@counters"
+	MetadataTimeSeries             = "@timeseries"
 	MetadataInddexScore            = "@index-score"
 	MetadataLastModified           = "@last-modified"
 	MetadataRavenGoType            = "Raven-Go-Type"
@@ -36,4 +38,5 @@ const (
 	headersClientVersion              = "Raven-Client-Version"
 	headersEtag                       = "ETag"
 	headersIfNoneMatch                = "If-None-Match"
+	headersRetryAfter                 = "Retry-After"
 )
@@ -0,0 +1,97 @@
+package ravendb
+
+// CounterOperationType describes an operation on a counter
+type CounterOperationType string
+
+const (
+	CounterOperationTypeNone      CounterOperationType = "None"
+	CounterOperationTypeIncrement CounterOperationType = "Increment"
+	CounterOperationTypeDelete    CounterOperationType = "Delete"
+	CounterOperationTypeGet       CounterOperationType = "Get"
+	CounterOperationTypePut       CounterOperationType = "Put"
+)
+
+var counterOperationTypeValues = []string{
+	string(CounterOperationTypeNone),
+	string(CounterOperationTypeIncrement),
+	string(CounterOperationTypeDelete),
+	string(CounterOperationTypeGet),
+	string(CounterOperationTypePut),
+}
+
+// MarshalJSON returns an error if CounterOperationType is not one of the defined values
+func (c CounterOperationType) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(c), "CounterOperationType", counterOperationTypeValues)
+}
+
+// CounterOperation describes an operation on a single counter
+type CounterOperation struct {
+	Type        CounterOperationType `json:"Type"`
+	CounterName string               `json:"CounterName"`
+	Delta       int64                `json:"Delta"`
+}
+
+// NewCounterOperationIncrement returns an operation that increments counter name by delta
+//
+// Deprecated: use operations.NewCounterOperationIncrement.
+func NewCounterOperationIncrement(name string, delta int64) *CounterOperation {
+	return &CounterOperation{
+		Type:        CounterOperationTypeIncrement,
+		CounterName: name,
+		Delta:       delta,
+	}
+}
+
+// NewCounterOperationDelete returns an operation that deletes counter name
+//
+// Deprecated: use operations.NewCounterOperationDelete.
+func NewCounterOperationDelete(name string) *CounterOperation {
+	return &CounterOperation{
+		Type:        CounterOperationTypeDelete,
+		CounterName: name,
+	}
+}
+
+// NewCounterOperationGet returns an operation that gets value of counter name
+//
+// Deprecated: use operations.NewCounterOperationGet.
+func NewCounterOperationGet(name string) *CounterOperation {
+	return &CounterOperation{
+		Type:        CounterOperationTypeGet,
+		CounterName: name,
+	}
+}
+
+// DocumentCountersOperation describes operations on counters of a single document
+type DocumentCountersOperation struct {
+	DocumentID string              `json:"DocumentId"`
+	Operations []*CounterOperation `json:"Operations"`
+}
+
+// CounterBatch describes operations on counters of multiple documents
+type CounterBatch struct {
+	// if true, values on each node are returned in CounterDetail.CounterValues
+	ReplyWithAllNodesValues bool                         `json:"ReplyWithAllNodesValues"`
+	Documents               []*DocumentCountersOperation `json:"Documents"`
+	FromEtl                 bool                         `json:"FromEtl"`
+}
+
+func (b *CounterBatch) validate() error {
+	if len(b.Documents) == 0 {
+		return newIllegalArgumentError("Documents cannot be empty")
+	}
+	for _, doc := range b.Documents {
+		if doc == nil || stringIsBlank(doc.DocumentID) {
+			return newIllegalArgumentError("DocumentId cannot be empty")
+		}
+		if len(doc.Operations) == 0 {
+			return newIllegalArgumentError("Operations for document '%s' cannot be empty", doc.DocumentID)
+		}
+		for _, op := range doc.Operations {
+			if op == nil || stringIsBlank(op.CounterName) {
+				return newIllegalArgumentError("CounterName of operations for document '%s' cannot be empty", doc.DocumentID)
+			}
+		}
+	}
+	return nil
+}
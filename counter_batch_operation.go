@@ -0,0 +1,79 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IOperation = &CounterBatchOperation{}
+)
+
+// CounterBatchOperation increments, deletes and gets counters
+// of multiple documents in a single request
+type CounterBatchOperation struct {
+	Command *CounterBatchCommand
+
+	counterBatch *CounterBatch
+}
+
+// NewCounterBatchOperation returns new CounterBatchOperation
+//
+// Deprecated: use operations.NewCounterBatchOperation.
+func NewCounterBatchOperation(counterBatch *CounterBatch) *CounterBatchOperation {
+	return &CounterBatchOperation{
+		counterBatch: counterBatch,
+	}
+}
+
+func (o *CounterBatchOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewCounterBatchCommand(o.counterBatch)
+	return o.Command, err
+}
+
+var _ RavenCommand = &CounterBatchCommand{}
+
+// CounterBatchCommand is a command for CounterBatchOperation
+type CounterBatchCommand struct {
+	RavenCommandBase
+
+	counterBatch *CounterBatch
+
+	// values of counters after the operations, including the ones
+	// requested with Get operations
+	Result *CountersDetail
+}
+
+// NewCounterBatchCommand returns new CounterBatchCommand
+func NewCounterBatchCommand(counterBatch *CounterBatch) (*CounterBatchCommand, error) {
+	if counterBatch == nil {
+		return nil, newIllegalArgumentError("counterBatch cannot be nil")
+	}
+	if err := counterBatch.validate(); err != nil {
+		return nil, err
+	}
+
+	cmd := &CounterBatchCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		counterBatch: counterBatch,
+	}
+	return cmd, nil
+}
+
+func (c *CounterBatchCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/counters"
+
+	d, err := jsonMarshal(c.counterBatch)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *CounterBatchCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
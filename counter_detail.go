@@ -0,0 +1,19 @@
+package ravendb
+
+// CounterDetail describes a value of a counter
+type CounterDetail struct {
+	DocumentID  string `json:"DocumentId"`
+	CounterName string `json:"CounterName"`
+	TotalValue  int64  `json:"TotalValue"`
+	Etag        int64  `json:"Etag"`
+	// values of the counter on each node, keyed by database id.
+	// Only returned when requested
+	CounterValues map[string]int64 `json:"CounterValues"`
+	ChangeVector  string           `json:"ChangeVector"`
+}
+
+// CountersDetail is a result of counter operations
+type CountersDetail struct {
+	// nil entries are counters that don't exist
+	Counters []*CounterDetail `json:"Counters"`
+}
@@ -0,0 +1,102 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterBatchCommandValidates(t *testing.T) {
+	_, err := NewCounterBatchCommand(nil)
+	assert.Error(t, err)
+	_, err = NewCounterBatchCommand(&CounterBatch{})
+	assert.Error(t, err)
+	_, err = NewCounterBatchCommand(&CounterBatch{
+		Documents: []*DocumentCountersOperation{{DocumentID: "users/1"}},
+	})
+	assert.Error(t, err)
+
+	cmd, err := NewCounterBatchCommand(&CounterBatch{
+		Documents: []*DocumentCountersOperation{{
+			DocumentID: "users/1",
+			Operations: []*CounterOperation{NewCounterOperationIncrement("likes", 2)},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, cmd.SetResponse([]byte(`{"Counters":[{"DocumentId":"users/1","CounterName":"likes","TotalValue":5}]}`), false))
+	assert.Equal(t, int64(5), cmd.Result.Counters[0].TotalValue)
+}
+
+func TestGetCountersCommandCreateRequest(t *testing.T) {
+	node := &ServerNode{URL: "http://127.0.0.1:8080", Database: "db"}
+	conventions := NewDocumentConventions()
+
+	cmd, err := NewGetCountersCommand("users/1", []string{"likes", "dislikes"}, true, conventions)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodGet, req.Method)
+	assert.Equal(t, "docId=users%2F1&full=true&counter=likes&counter=dislikes", req.URL.RawQuery)
+
+	// counters that don't fit in the url are sent in the body
+	var counters []string
+	for i := 0; i < 200; i++ {
+		counters = append(counters, "counter-with-a-long-name-"+strconv.Itoa(i))
+	}
+	cmd, err = NewGetCountersCommand("users/1", counters, false, conventions)
+	assert.NoError(t, err)
+	req, err = cmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.False(t, cmd.CanCache)
+	d, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(d), `{"Type":"Get","CounterName":"counter-with-a-long-name-199","Delta":0}`))
+
+	_, err = NewGetCountersCommand("", nil, false, conventions)
+	assert.Error(t, err)
+}
+
+func TestCountersCache(t *testing.T) {
+	cache := &countersCache{values: map[string]*int64{}}
+	one := int64(1)
+	cache.set("Likes", &one)
+	cache.set("dislikes", nil)
+
+	v, ok := cache.get("likes")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), *v)
+	v, ok = cache.get("Dislikes")
+	assert.True(t, ok)
+	assert.Nil(t, v)
+	_, ok = cache.get("other")
+	assert.False(t, ok)
+
+	two := int64(2)
+	cache.set("LIKES", &two)
+	assert.Equal(t, map[string]int64{"LIKES": 2}, cache.getAll())
+
+	var empty *countersCache
+	assert.Equal(t, map[string]int64{}, empty.getAll())
+}
+
+func TestCountersBatchCommandData(t *testing.T) {
+	_, err := NewCountersBatchCommandData("users/1")
+	assert.Error(t, err)
+
+	cmd, err := NewCountersBatchCommandData("users/1", NewCounterOperationIncrement("likes", 1))
+	assert.NoError(t, err)
+	assert.True(t, cmd.hasOperation(CounterOperationTypeIncrement, "likes"))
+	assert.False(t, cmd.hasOperation(CounterOperationTypeDelete, "likes"))
+
+	v, err := cmd.serialize(nil)
+	assert.NoError(t, err)
+	m := v.(map[string]interface{})
+	assert.Equal(t, CommandCounters, m["Type"])
+	assert.Equal(t, "users/1", m["Id"])
+	assert.Equal(t, "users/1", m["Counters"].(*DocumentCountersOperation).DocumentID)
+}
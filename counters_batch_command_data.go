@@ -0,0 +1,50 @@
+package ravendb
+
+var _ ICommandData = &CountersBatchCommandData{}
+
+// CountersBatchCommandData is a command for SaveChanges that modifies
+// counters of a document
+type CountersBatchCommandData struct {
+	*CommandData
+	fromEtl  bool
+	counters *DocumentCountersOperation
+}
+
+// NewCountersBatchCommandData returns a command that executes operations
+// on counters of a document
+func NewCountersBatchCommandData(documentID string, operations ...*CounterOperation) (*CountersBatchCommandData, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be null or empty")
+	}
+	if len(operations) == 0 {
+		return nil, newIllegalArgumentError("operations cannot be empty")
+	}
+
+	res := &CountersBatchCommandData{
+		CommandData: &CommandData{
+			Type: CommandCounters,
+			ID:   documentID,
+		},
+		counters: &DocumentCountersOperation{
+			DocumentID: documentID,
+			Operations: operations,
+		},
+	}
+	return res, nil
+}
+
+func (d *CountersBatchCommandData) hasOperation(typ CounterOperationType, name string) bool {
+	for _, op := range d.counters.Operations {
+		if op.Type == typ && op.CounterName == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *CountersBatchCommandData) serialize(conventions *DocumentConventions) (interface{}, error) {
+	res := d.baseJSON()
+	res["Counters"] = d.counters
+	res["FromEtl"] = d.fromEtl
+	return res, nil
+}
@@ -15,19 +15,34 @@ type CreateDatabaseOperation struct {
 }
 
 // NewCreateDatabaseOperation returns CreateDatabaseOperation
+// replicationFactor is ignored if databaseRecord.DatabaseTopology specifies
+// ReplicationFactor or Members
+//
+// Deprecated: use operations.NewCreateDatabaseOperation.
 func NewCreateDatabaseOperation(databaseRecord *DatabaseRecord, replicationFactor int) *CreateDatabaseOperation {
-	if databaseRecord.DatabaseTopology != nil && databaseRecord.DatabaseTopology.ReplicationFactor > 0 {
-		replicationFactor = databaseRecord.DatabaseTopology.ReplicationFactor
-	} else if replicationFactor <= 0 {
-		replicationFactor = 1
-	}
-
 	return &CreateDatabaseOperation{
 		databaseRecord:    databaseRecord,
 		replicationFactor: replicationFactor,
 	}
 }
 
+// databaseReplicationFactor returns replication factor of a database
+// created with a given record
+func databaseReplicationFactor(databaseRecord *DatabaseRecord, replicationFactor int) int {
+	if topology := databaseRecord.DatabaseTopology; topology != nil {
+		if topology.ReplicationFactor > 0 {
+			return topology.ReplicationFactor
+		}
+		if len(topology.Members) > 0 {
+			return len(topology.Members)
+		}
+	}
+	if replicationFactor <= 0 {
+		return 1
+	}
+	return replicationFactor
+}
+
 // GetCommand returns command for this operation
 func (o *CreateDatabaseOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
 	return NewCreateDatabaseCommand(conventions, o.databaseRecord, o.replicationFactor)
@@ -51,13 +66,15 @@ type CreateDatabaseCommand struct {
 
 // NewCreateDatabaseCommand returns new CreateDatabaseCommand
 func NewCreateDatabaseCommand(conventions *DocumentConventions, databaseRecord *DatabaseRecord, replicationFactor int) (*CreateDatabaseCommand, error) {
+	if databaseRecord == nil {
+		return nil, newIllegalArgumentError("databaseRecord cannot be nil")
+	}
 	if databaseRecord.DatabaseName == "" {
 		return nil, newIllegalArgumentError("databaseRecord.DatabaseName cannot be empty")
 	}
-	if databaseRecord.DatabaseTopology != nil && databaseRecord.DatabaseTopology.ReplicationFactor > 0 {
-		replicationFactor = databaseRecord.DatabaseTopology.ReplicationFactor
-	} else if replicationFactor <= 0 {
-		replicationFactor = 1
+	replicationFactor = databaseReplicationFactor(databaseRecord, replicationFactor)
+	if topology := databaseRecord.DatabaseTopology; topology != nil && len(topology.Members) > 0 && len(topology.Members) != replicationFactor {
+		return nil, newIllegalArgumentError("replication factor %d doesn't match the number of topology members (%d)", replicationFactor, len(topology.Members))
 	}
 
 	cmd := &CreateDatabaseCommand{
@@ -0,0 +1,29 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDatabaseReplicationFactor(t *testing.T) {
+	record := NewDatabaseRecord()
+	record.DatabaseName = "db"
+	conventions := NewDocumentConventions()
+
+	cmd, err := NewCreateDatabaseCommand(conventions, record, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cmd.replicationFactor)
+
+	record.DatabaseTopology = &DatabaseTopology{Members: []string{"A", "B"}}
+	cmd, err = NewCreateDatabaseCommand(conventions, record, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cmd.replicationFactor)
+
+	record.DatabaseTopology.ReplicationFactor = 3
+	_, err = NewCreateDatabaseCommand(conventions, record, 0)
+	assert.Error(t, err)
+
+	_, err = NewCreateDatabaseCommand(conventions, nil, 1)
+	assert.Error(t, err)
+}
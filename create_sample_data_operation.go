@@ -17,6 +17,8 @@ type CreateSampleDataOperation struct {
 }
 
 // NewCreateSampleDataOperation
+//
+// Deprecated: use operations.NewCreateSampleDataOperation.
 func NewCreateSampleDataOperation() *CreateSampleDataOperation {
 	return &CreateSampleDataOperation{}
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strings"
 	"sync"
@@ -41,6 +42,28 @@ type changeSubscribers struct {
 	onOperationStatusChange sync.Map // int -> func(*OperationStatusChange)
 
 	nextID int32 // atomic
+
+	lastError atomic.Value // error
+}
+
+func (s *changeSubscribers) getLastError() error {
+	if v := s.lastError.Load(); v != nil {
+		return v.(error)
+	}
+	return nil
+}
+
+// handlersCount returns number of registered callbacks
+func (s *changeSubscribers) handlersCount() int {
+	n := 0
+	fn := func(k, v interface{}) bool {
+		n++
+		return true
+	}
+	s.onDocumentChange.Range(fn)
+	s.onIndexChange.Range(fn)
+	s.onOperationStatusChange.Range(fn)
+	return n
 }
 
 func (s *changeSubscribers) getNextID() int {
@@ -152,11 +175,11 @@ func (c *databaseChangesCommand) confirm(wasCancelled bool) {
 	c.ch <- true
 }
 
-func (c *databaseChangesCommand) waitForConfirmation(timeout time.Duration) bool {
+func (c *databaseChangesCommand) waitForConfirmation(ctx context.Context) bool {
 	select {
 	case <-c.ch:
 		return true
-	case <-time.After(timeout):
+	case <-ctx.Done():
 		return false
 	}
 }
@@ -177,7 +200,13 @@ type DatabaseChanges struct {
 
 	// will be notified if we connect or fail to connect
 	// allows waiting for connection being established
-	chIsConnected chan error
+	chIsConnected     chan error
+	isConnectedClosed sync.Once
+
+	// for DebugInfo
+	connected      int32 // atomic, 1 if connected
+	connectCount   int32 // atomic
+	reconnectCount int32 // atomic
 
 	chCommands      chan *databaseChangesCommand
 	chWorkCompleted chan error
@@ -243,7 +272,16 @@ func newDatabaseChanges(requestExecutor *RequestExecutor, databaseName string, o
 	return res
 }
 
+// EnsureConnectedNow waits up to 15 seconds for the connection to be established
 func (c *DatabaseChanges) EnsureConnectedNow() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+	return c.EnsureConnectedNowWithContext(ctx)
+}
+
+// EnsureConnectedNowWithContext waits for the connection to be established
+// or until ctx is done
+func (c *DatabaseChanges) EnsureConnectedNowWithContext(ctx context.Context) error {
 	select {
 	case <-c.ctxCancel.Done():
 		dcdbg("DatabaseChanges(): EnsureConnectedNow(): is closed\n")
@@ -254,9 +292,12 @@ func (c *DatabaseChanges) EnsureConnectedNow() error {
 	case err := <-c.chIsConnected:
 		dcdbg("DatabaseChanges(): EnsureConnectedNow(): chanIsConnected notified\n")
 		return err
-	case <-time.After(time.Second * 15):
+	case <-ctx.Done():
 		dcdbg("DatabaseChanges(): EnsureConnectedNow(): timed out waiting for connection\n")
-		return errors.New("timed out waiting for connection")
+		if ctx.Err() == context.DeadlineExceeded {
+			return NewTimeoutError("timed out waiting for connection")
+		}
+		return ctx.Err()
 	}
 }
 
@@ -564,12 +605,28 @@ func (c *DatabaseChanges) disconnectSubscribers(subscribers *changeSubscribers)
 }
 
 func (c *DatabaseChanges) connectSubscribers(subscribers *changeSubscribers) error {
-	return c.send(subscribers.watchCommand, subscribers.commandValue, true)
+	confirmed, err := c.sendCommand(subscribers.watchCommand, subscribers.commandValue, true)
+	if err != nil {
+		subscribers.lastError.Store(err)
+		return err
+	}
+	if !confirmed && !c.isClosed() {
+		cmd := fmtDCCommand(subscribers.watchCommand, subscribers.commandValue)
+		subscribers.lastError.Store(NewTimeoutError("command '%s' was not confirmed by the server", cmd))
+	}
+	return nil
 }
 
 func (c *DatabaseChanges) send(command, value string, waitForConfirmation bool) error {
+	_, err := c.sendCommand(command, value, waitForConfirmation)
+	return err
+}
+
+// sendCommand sends a command and, if waitForConfirmation is true,
+// returns true if the server confirmed it
+func (c *DatabaseChanges) sendCommand(command, value string, waitForConfirmation bool) (bool, error) {
 	if c.isClosed() {
-		return errors.New("Send() called after Close()")
+		return false, errors.New("Send() called after Close()")
 	}
 
 	id := c.nextCommandID()
@@ -582,12 +639,22 @@ func (c *DatabaseChanges) send(command, value string, waitForConfirmation bool)
 	c.mu.Lock()
 	chCommands := c.chCommands
 	c.mu.Unlock()
-	chCommands <- cmd
+	// the send worker might be gone, don't block forever if we're closed
+	select {
+	case chCommands <- cmd:
+	case <-c.ctxCancel.Done():
+		c.outstandingCommands.Delete(id)
+		return false, errors.New("Send() called after Close()")
+	}
 
-	if waitForConfirmation {
-		cmd.waitForConfirmation(time.Second * 15)
+	if !waitForConfirmation {
+		return false, nil
 	}
-	return nil
+	ctx, cancel := context.WithTimeout(c.ctxCancel, time.Second*15)
+	// wasCancelled is safe to read after receiving the confirmation
+	confirmed := cmd.waitForConfirmation(ctx) && !cmd.wasCancelled
+	cancel()
+	return confirmed, nil
 }
 
 func startSendWorker(conn *websocket.Conn, chCommands chan *databaseChangesCommand) chan error {
@@ -636,6 +703,9 @@ func (c *DatabaseChanges) doWorkInner(ctx context.Context) (error, bool) {
 	dialer.HandshakeTimeout = time.Second * 2
 
 	re := c.requestExecutor
+	if proxy, ok := re.getProxy(); ok {
+		dialer.Proxy = proxy
+	}
 	if re.Certificate != nil || re.TrustStore != nil {
 		dialer.TLSClientConfig, err = newTLSConfig(re.Certificate, re.TrustStore)
 		if err != nil {
@@ -643,20 +713,27 @@ func (c *DatabaseChanges) doWorkInner(ctx context.Context) (error, bool) {
 		}
 	}
 
-	urlString, err := c.requestExecutor.GetURL()
+	nodeURL, err := c.requestExecutor.GetURL()
 	if err != nil {
 		return err, false
 	}
-	urlString += "/databases/" + c.database + "/changes"
-	urlString = toWebSocketPath(urlString)
+	urlString := toWebSocketPath(nodeURL + "/databases/" + c.database + "/changes")
 
 	ctxDial, cancel := context.WithTimeout(ctx, time.Second*2)
 	var client *websocket.Conn
-	client, _, err = dialer.DialContext(ctxDial, urlString, nil)
+	var rsp *http.Response
+	client, rsp, err = dialer.DialContext(ctxDial, urlString, nil)
 	cancel()
 
 	if err != nil {
 		dcdbg("DatabaseChanges: dialer.DialContext failed with '%s'\n", err)
+		if rsp != nil && rsp.StatusCode == http.StatusForbidden {
+			node := &ServerNode{
+				URL:      nodeURL,
+				Database: c.database,
+			}
+			return newForbiddenAccessError(node, re.Certificate, "GET "+urlString), false
+		}
 		return err, false
 	}
 
@@ -672,11 +749,15 @@ func (c *DatabaseChanges) doWorkInner(ctx context.Context) (error, bool) {
 	}
 	c.subscribers.Range(connectFn)
 
+	atomic.StoreInt32(&c.connected, 1)
+	atomic.AddInt32(&c.connectCount, 1)
 	c.invokeConnectionStatusChanged()
 
-	c.chIsConnected <- nil
-	// close so that subsequent channel reads also return immediately
-	close(c.chIsConnected)
+	c.isConnectedClosed.Do(func() {
+		c.chIsConnected <- nil
+		// close so that subsequent channel reads also return immediately
+		close(c.chIsConnected)
+	})
 
 	shouldReconnect := true
 	err = nil
@@ -701,6 +782,7 @@ func (c *DatabaseChanges) doWorkInner(ctx context.Context) (error, bool) {
 	close(chCommands)
 	_ = client.Close()
 
+	atomic.StoreInt32(&c.connected, 0)
 	c.invokeConnectionStatusChanged()
 	return err, shouldReconnect
 }
@@ -717,6 +799,7 @@ func (c *DatabaseChanges) doWork(ctx context.Context) error {
 		}
 		// wait before next retry
 		time.Sleep(time.Second)
+		atomic.AddInt32(&c.reconnectCount, 1)
 	}
 }
 
@@ -828,6 +911,7 @@ func (c *DatabaseChanges) startProcessMessagesWorker(ctx context.Context, conn *
 					if ok {
 						v, ok := c.outstandingCommands.Load(commandID)
 						if ok {
+							c.outstandingCommands.Delete(commandID)
 							cmd := v.(*databaseChangesCommand)
 							cmd.confirm(false)
 							dcdbg("DatabaseChanges: confirmed command id %d, command '%s'\n", cmd.id, fmtDCCommand(cmd.command, cmd.value))
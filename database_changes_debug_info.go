@@ -0,0 +1,90 @@
+package ravendb
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// DatabaseChangesDebugInfo is a snapshot of the state of DatabaseChanges,
+// meant for diagnosing missing notifications
+type DatabaseChangesDebugInfo struct {
+	Database string
+	// Connected is true if the web socket connection is currently open
+	Connected bool
+	// ConnectCount is how many times connection has been established
+	ConnectCount int
+	// ReconnectCount is how many times we tried to reconnect after
+	// the connection was lost
+	ReconnectCount int
+	// LastError is the last error reported to OnError handlers
+	LastError error
+
+	// Subscriptions are the watched keys, sorted by name
+	Subscriptions []*DatabaseChangesSubscriptionDebugInfo
+	// PendingCommands are commands sent to the server and not yet confirmed,
+	// sorted by id
+	PendingCommands []*DatabaseChangesCommandDebugInfo
+}
+
+// DatabaseChangesSubscriptionDebugInfo describes a watched key
+type DatabaseChangesSubscriptionDebugInfo struct {
+	// Name is e.g. "docs/users/1", "all-docs" or "indexes/Users/ByName"
+	Name string
+	// Command is the watch command sent to the server, e.g. "watch-doc users/1"
+	Command string
+	// HandlersCount is the number of registered callbacks
+	HandlersCount int
+	// LastError is the last error of sending the watch command,
+	// including the server not confirming it
+	LastError error
+}
+
+// DatabaseChangesCommandDebugInfo describes a command waiting for confirmation
+type DatabaseChangesCommandDebugInfo struct {
+	ID      int
+	Command string
+	SentAt  time.Time
+}
+
+// DebugInfo returns a snapshot of active subscriptions, commands waiting for
+// confirmation from the server, errors and connection statistics
+func (c *DatabaseChanges) DebugInfo() *DatabaseChangesDebugInfo {
+	res := &DatabaseChangesDebugInfo{
+		Database:       c.database,
+		Connected:      atomic.LoadInt32(&c.connected) == 1,
+		ConnectCount:   int(atomic.LoadInt32(&c.connectCount)),
+		ReconnectCount: int(atomic.LoadInt32(&c.reconnectCount)),
+		LastError:      c.getLastConnectionStateError(),
+	}
+
+	c.subscribers.Range(func(k, v interface{}) bool {
+		s := v.(*changeSubscribers)
+		info := &DatabaseChangesSubscriptionDebugInfo{
+			Name:          s.name,
+			Command:       fmtDCCommand(s.watchCommand, s.commandValue),
+			HandlersCount: s.handlersCount(),
+			LastError:     s.getLastError(),
+		}
+		res.Subscriptions = append(res.Subscriptions, info)
+		return true
+	})
+	sort.Slice(res.Subscriptions, func(i, j int) bool {
+		return res.Subscriptions[i].Name < res.Subscriptions[j].Name
+	})
+
+	c.outstandingCommands.Range(func(k, v interface{}) bool {
+		cmd := v.(*databaseChangesCommand)
+		info := &DatabaseChangesCommandDebugInfo{
+			ID:      cmd.id,
+			Command: fmtDCCommand(cmd.command, cmd.value),
+			SentAt:  cmd.timeStart,
+		}
+		res.PendingCommands = append(res.PendingCommands, info)
+		return true
+	})
+	sort.Slice(res.PendingCommands, func(i, j int) bool {
+		return res.PendingCommands[i].ID < res.PendingCommands[j].ID
+	})
+	return res
+}
@@ -0,0 +1,50 @@
+package ravendb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseChangesDebugInfo(t *testing.T) {
+	c := &DatabaseChanges{
+		database:       "db",
+		connected:      1,
+		connectCount:   2,
+		reconnectCount: 1,
+	}
+	c.lastError.Store(errors.New("connection lost"))
+
+	docs := &changeSubscribers{name: "docs/users/1", watchCommand: "watch-doc", commandValue: "users/1"}
+	docs.registerOnDocumentChange(func(*DocumentChange) {})
+	docs.registerOnDocumentChange(func(*DocumentChange) {})
+	docs.lastError.Store(NewTimeoutError("not confirmed"))
+	c.subscribers.Store(docs.name, docs)
+	indexes := &changeSubscribers{name: "all-indexes", watchCommand: "watch-indexes"}
+	indexes.registerOnIndexChange(func(*IndexChange) {})
+	c.subscribers.Store(indexes.name, indexes)
+
+	c.outstandingCommands.Store(7, newDatabaseChangesCommand(7, "watch-doc", "users/1"))
+	c.outstandingCommands.Store(3, newDatabaseChangesCommand(3, "watch-indexes", ""))
+
+	info := c.DebugInfo()
+	assert.Equal(t, "db", info.Database)
+	assert.True(t, info.Connected)
+	assert.Equal(t, 2, info.ConnectCount)
+	assert.Equal(t, 1, info.ReconnectCount)
+	assert.EqualError(t, info.LastError, "connection lost")
+
+	assert.Equal(t, 2, len(info.Subscriptions))
+	assert.Equal(t, "all-indexes", info.Subscriptions[0].Name)
+	assert.Equal(t, "watch-indexes", info.Subscriptions[0].Command)
+	assert.Equal(t, 1, info.Subscriptions[0].HandlersCount)
+	assert.Nil(t, info.Subscriptions[0].LastError)
+	assert.Equal(t, "watch-doc users/1", info.Subscriptions[1].Command)
+	assert.Equal(t, 2, info.Subscriptions[1].HandlersCount)
+	assert.Error(t, info.Subscriptions[1].LastError)
+
+	assert.Equal(t, 2, len(info.PendingCommands))
+	assert.Equal(t, 3, info.PendingCommands[0].ID)
+	assert.Equal(t, "watch-doc users/1", info.PendingCommands[1].Command)
+}
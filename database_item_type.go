@@ -0,0 +1,51 @@
+package ravendb
+
+// DatabaseItemType describes a kind of items exported or imported by DatabaseSmuggler
+type DatabaseItemType string
+
+const (
+	DatabaseItemTypeNone                       DatabaseItemType = "None"
+	DatabaseItemTypeDocuments                  DatabaseItemType = "Documents"
+	DatabaseItemTypeRevisionDocuments          DatabaseItemType = "RevisionDocuments"
+	DatabaseItemTypeIndexes                    DatabaseItemType = "Indexes"
+	DatabaseItemTypeIdentities                 DatabaseItemType = "Identities"
+	DatabaseItemTypeTombstones                 DatabaseItemType = "Tombstones"
+	DatabaseItemTypeLegacyAttachments          DatabaseItemType = "LegacyAttachments"
+	DatabaseItemTypeConflicts                  DatabaseItemType = "Conflicts"
+	DatabaseItemTypeCompareExchange            DatabaseItemType = "CompareExchange"
+	DatabaseItemTypeLegacyDocumentDeletions    DatabaseItemType = "LegacyDocumentDeletions"
+	DatabaseItemTypeLegacyAttachmentDeletions  DatabaseItemType = "LegacyAttachmentDeletions"
+	DatabaseItemTypeCounterGroups              DatabaseItemType = "CounterGroups"
+	DatabaseItemTypeAttachments                DatabaseItemType = "Attachments"
+	DatabaseItemTypeDatabaseRecord             DatabaseItemType = "DatabaseRecord"
+	DatabaseItemTypeSubscriptions              DatabaseItemType = "Subscriptions"
+	DatabaseItemTypeCompareExchangeTombstones  DatabaseItemType = "CompareExchangeTombstones"
+	DatabaseItemTypeTimeSeries                 DatabaseItemType = "TimeSeries"
+	DatabaseItemTypeReplicationHubCertificates DatabaseItemType = "ReplicationHubCertificates"
+)
+
+var databaseItemTypeValues = []string{
+	string(DatabaseItemTypeNone),
+	string(DatabaseItemTypeDocuments),
+	string(DatabaseItemTypeRevisionDocuments),
+	string(DatabaseItemTypeIndexes),
+	string(DatabaseItemTypeIdentities),
+	string(DatabaseItemTypeTombstones),
+	string(DatabaseItemTypeLegacyAttachments),
+	string(DatabaseItemTypeConflicts),
+	string(DatabaseItemTypeCompareExchange),
+	string(DatabaseItemTypeLegacyDocumentDeletions),
+	string(DatabaseItemTypeLegacyAttachmentDeletions),
+	string(DatabaseItemTypeCounterGroups),
+	string(DatabaseItemTypeAttachments),
+	string(DatabaseItemTypeDatabaseRecord),
+	string(DatabaseItemTypeSubscriptions),
+	string(DatabaseItemTypeCompareExchangeTombstones),
+	string(DatabaseItemTypeTimeSeries),
+	string(DatabaseItemTypeReplicationHubCertificates),
+}
+
+// MarshalJSON returns an error if DatabaseItemType is not one of the defined values
+func (d DatabaseItemType) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(d), "DatabaseItemType", databaseItemTypeValues)
+}
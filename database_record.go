@@ -7,8 +7,28 @@ type DatabaseRecord struct {
 	DataDirectory        string            `json:"DataDirectory,omitempty"`
 	Settings             map[string]string `json:"Settings"`
 	ConflictSolverConfig *ConflictSolver   `json:"ConflictSolverConfig"`
-	Encrypted            bool              `json:"Encrypted"`
-	DatabaseTopology     *DatabaseTopology `json:"DatabaseTopology"`
+	// Encrypted databases can only be created on servers using https.
+	// The encryption key must be distributed to nodes before creating the database
+	Encrypted bool `json:"Encrypted"`
+	// DatabaseTopology can specify Members, the tags of nodes the database
+	// is created on
+	DatabaseTopology *DatabaseTopology `json:"DatabaseTopology"`
+
+	Indexes                map[string]*IndexDefinition       `json:"Indexes,omitempty"`
+	Sorters                map[string]*SorterDefinition      `json:"Sorters,omitempty"`
+	Revisions              *RevisionsConfiguration           `json:"Revisions,omitempty"`
+	TimeSeries             *TimeSeriesConfiguration          `json:"TimeSeries,omitempty"`
+	Client                 *ClientConfiguration              `json:"Client,omitempty"`
+	PeriodicBackups        []*PeriodicBackupConfiguration    `json:"PeriodicBackups,omitempty"`
+	ExternalReplications   []*ExternalReplication            `json:"ExternalReplications,omitempty"`
+	HubPullReplications    []*PullReplicationDefinition      `json:"HubPullReplications,omitempty"`
+	SinkPullReplications   []*PullReplicationAsSink          `json:"SinkPullReplications,omitempty"`
+	RavenConnectionStrings map[string]*RavenConnectionString `json:"RavenConnectionStrings,omitempty"`
+	SqlConnectionStrings   map[string]*SqlConnectionString   `json:"SqlConnectionStrings,omitempty"`
+	OlapConnectionStrings  map[string]*OlapConnectionString  `json:"OlapConnectionStrings,omitempty"`
+	RavenEtls              []*RavenEtlConfiguration          `json:"RavenEtls,omitempty"`
+	SqlEtls                []*SqlEtlConfiguration            `json:"SqlEtls,omitempty"`
+	OlapEtls               []*OlapEtlConfiguration           `json:"OlapEtls,omitempty"`
 }
 
 // NewDatabaseRecord returns new database record
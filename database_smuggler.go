@@ -0,0 +1,207 @@
+package ravendb
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// DatabaseSmuggler exports databases to .ravendbdump format and imports them back
+type DatabaseSmuggler struct {
+	store           *DocumentStore
+	databaseName    string
+	requestExecutor *RequestExecutor
+}
+
+// NewDatabaseSmuggler returns new DatabaseSmuggler for a given database.
+// If databaseName is empty, store's default database is used
+func NewDatabaseSmuggler(store *DocumentStore, databaseName string) *DatabaseSmuggler {
+	if databaseName == "" {
+		databaseName = store.GetDatabase()
+	}
+	res := &DatabaseSmuggler{
+		store:        store,
+		databaseName: databaseName,
+	}
+	if databaseName != "" {
+		res.requestExecutor = store.GetRequestExecutor(databaseName)
+	}
+	return res
+}
+
+// ForDatabase returns DatabaseSmuggler for a given database
+func (s *DatabaseSmuggler) ForDatabase(databaseName string) *DatabaseSmuggler {
+	if strings.EqualFold(s.databaseName, databaseName) {
+		return s
+	}
+	return NewDatabaseSmuggler(s.store, databaseName)
+}
+
+// ExportAsync starts exporting the database and writes the dump to w.
+// Use WaitForCompletion on returned Operation to wait until the dump
+// is fully written. w must not be used until then
+func (s *DatabaseSmuggler) ExportAsync(options *DatabaseSmugglerExportOptions, w io.Writer) (*Operation, error) {
+	if options == nil {
+		return nil, newIllegalArgumentError("options cannot be nil")
+	}
+	if w == nil {
+		return nil, newIllegalArgumentError("w cannot be nil")
+	}
+	return s.startOperation(func(operationID int64) RavenCommand {
+		return newExportCommand(&options.DatabaseSmugglerOptions, operationID, w)
+	})
+}
+
+// ImportAsync starts importing a dump read from r into the database.
+// Use WaitForCompletion on returned Operation to wait until the import
+// is finished. r must not be used until then
+func (s *DatabaseSmuggler) ImportAsync(options *DatabaseSmugglerImportOptions, r io.Reader) (*Operation, error) {
+	if options == nil {
+		return nil, newIllegalArgumentError("options cannot be nil")
+	}
+	if r == nil {
+		return nil, newIllegalArgumentError("r cannot be nil")
+	}
+	return s.startOperation(func(operationID int64) RavenCommand {
+		return newImportCommand(&options.DatabaseSmugglerOptions, operationID, r)
+	})
+}
+
+func (s *DatabaseSmuggler) startOperation(newCommand func(operationID int64) RavenCommand) (*Operation, error) {
+	if s.requestExecutor == nil {
+		return nil, newIllegalStateError("Cannot use smuggler without a database defined, did you forget to call ForDatabase?")
+	}
+	// operation ids are per node and the dump can't be re-sent to another
+	// node, so both requests and the status polling go to the same node
+	// without failover
+	node, err := s.requestExecutor.GetPreferredNode()
+	if err != nil {
+		return nil, err
+	}
+	getOperationID := NewGetNextOperationIDCommand()
+	if err = s.requestExecutor.Execute(node, -1, getOperationID, true, nil); err != nil {
+		return nil, err
+	}
+	operationID := getOperationID.Result
+
+	changes := func() *DatabaseChanges {
+		return s.store.Changes(s.databaseName)
+	}
+	operation := NewOperation(s.requestExecutor, changes, s.requestExecutor.GetConventions(), operationID)
+	operation.nodeTag = node.ClusterTag
+	operation.chRequestDone = make(chan error, 1)
+	command := newCommand(operationID)
+	go func() {
+		operation.chRequestDone <- s.requestExecutor.Execute(node, -1, command, false, nil)
+	}()
+	return operation, nil
+}
+
+var _ RavenCommand = &exportCommand{}
+
+type exportCommand struct {
+	RavenCommandBase
+
+	options     map[string]interface{}
+	operationID int64
+	w           io.Writer
+	sent        bool
+}
+
+func newExportCommand(options *DatabaseSmugglerOptions, operationID int64, w io.Writer) *exportCommand {
+	cmd := &exportCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		options:     options.toJSON(),
+		operationID: operationID,
+		w:           w,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeRaw
+	return cmd
+}
+
+func (c *exportCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	// a retried request would write the dump to w again
+	if c.sent {
+		return nil, newIllegalStateError("Export request can't be retried")
+	}
+	c.sent = true
+	url := node.URL + "/databases/" + node.Database + "/smuggler/export?operationId=" + i64toa(c.operationID)
+
+	d, err := jsonMarshal(c.options)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *exportCommand) SetResponseRaw(response *http.Response, stream io.Reader) error {
+	_, err := io.Copy(c.w, stream)
+	return err
+}
+
+var _ RavenCommand = &importCommand{}
+
+type importCommand struct {
+	RavenCommandBase
+
+	options     map[string]interface{}
+	operationID int64
+	r           io.Reader
+	sent        bool
+}
+
+func newImportCommand(options *DatabaseSmugglerOptions, operationID int64, r io.Reader) *importCommand {
+	cmd := &importCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		options:     options.toJSON(),
+		operationID: operationID,
+		r:           r,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd
+}
+
+func (c *importCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	// r was consumed by the previous request
+	if c.sent {
+		return nil, newIllegalStateError("Import request can't be retried")
+	}
+	c.sent = true
+	url := node.URL + "/databases/" + node.Database + "/smuggler/import?operationId=" + i64toa(c.operationID)
+
+	d, err := jsonMarshal(c.options)
+	if err != nil {
+		return nil, err
+	}
+
+	// stream the dump instead of buffering it in memory
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := mw.WriteField("importOptions", string(d))
+		if err == nil {
+			var part io.Writer
+			part, err = mw.CreateFormFile("file", "name")
+			if err == nil {
+				_, err = io.Copy(part, c.r)
+			}
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	// if the request fails before the body is read, the transport closes
+	// pr, which stops the goroutine
+	request, err := newHttpPostReader(url, pr)
+	if err != nil {
+		_ = pr.CloseWithError(err)
+		return nil, err
+	}
+	request.Header.Set("Content-Type", mw.FormDataContentType())
+	return request, nil
+}
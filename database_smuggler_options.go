@@ -0,0 +1,96 @@
+package ravendb
+
+import (
+	"strings"
+)
+
+// DatabaseSmugglerOptions are options common to export and import
+// done with DatabaseSmuggler
+type DatabaseSmugglerOptions struct {
+	// OperateOnTypes limits which items are exported or imported.
+	// If empty, documents, indexes, identities, attachments and other
+	// commonly used items are processed
+	OperateOnTypes []DatabaseItemType
+	// Collections limits documents to given collections. If empty,
+	// all collections are processed
+	Collections     []string
+	IncludeExpired  bool
+	RemoveAnalyzers bool
+	// TransformScript is a JavaScript snippet applied to each document.
+	// It can modify this or call throw 'skip' to skip the document
+	TransformScript            string
+	MaxStepsForTransformScript int
+}
+
+// DatabaseSmugglerExportOptions describes options for DatabaseSmuggler.ExportAsync
+type DatabaseSmugglerExportOptions struct {
+	DatabaseSmugglerOptions
+}
+
+// DatabaseSmugglerImportOptions describes options for DatabaseSmuggler.ImportAsync
+type DatabaseSmugglerImportOptions struct {
+	DatabaseSmugglerOptions
+}
+
+// NewDatabaseSmugglerExportOptions returns options for exporting commonly used items
+func NewDatabaseSmugglerExportOptions() *DatabaseSmugglerExportOptions {
+	return &DatabaseSmugglerExportOptions{
+		DatabaseSmugglerOptions: newDatabaseSmugglerOptions(),
+	}
+}
+
+// NewDatabaseSmugglerImportOptions returns options for importing commonly used items
+func NewDatabaseSmugglerImportOptions() *DatabaseSmugglerImportOptions {
+	return &DatabaseSmugglerImportOptions{
+		DatabaseSmugglerOptions: newDatabaseSmugglerOptions(),
+	}
+}
+
+func newDatabaseSmugglerOptions() DatabaseSmugglerOptions {
+	return DatabaseSmugglerOptions{
+		OperateOnTypes:             databaseSmugglerDefaultOperateOnTypes(),
+		MaxStepsForTransformScript: 10 * 1000,
+	}
+}
+
+func databaseSmugglerDefaultOperateOnTypes() []DatabaseItemType {
+	return []DatabaseItemType{
+		DatabaseItemTypeIndexes,
+		DatabaseItemTypeDocuments,
+		DatabaseItemTypeRevisionDocuments,
+		DatabaseItemTypeConflicts,
+		DatabaseItemTypeDatabaseRecord,
+		DatabaseItemTypeIdentities,
+		DatabaseItemTypeCompareExchange,
+		DatabaseItemTypeAttachments,
+		DatabaseItemTypeCounterGroups,
+		DatabaseItemTypeSubscriptions,
+		DatabaseItemTypeTimeSeries,
+	}
+}
+
+// toJSON returns options in a format expected by the server, where
+// OperateOnTypes is a flags enum serialized as comma-separated names
+func (o *DatabaseSmugglerOptions) toJSON() map[string]interface{} {
+	operateOnTypes := o.OperateOnTypes
+	if len(operateOnTypes) == 0 {
+		operateOnTypes = databaseSmugglerDefaultOperateOnTypes()
+	}
+	names := make([]string, len(operateOnTypes))
+	for i, t := range operateOnTypes {
+		names[i] = string(t)
+	}
+	res := map[string]interface{}{
+		"OperateOnTypes":             strings.Join(names, ", "),
+		"IncludeExpired":             o.IncludeExpired,
+		"RemoveAnalyzers":            o.RemoveAnalyzers,
+		"MaxStepsForTransformScript": o.MaxStepsForTransformScript,
+	}
+	if o.TransformScript != "" {
+		res["TransformScript"] = o.TransformScript
+	}
+	if len(o.Collections) > 0 {
+		res["Collections"] = o.Collections
+	}
+	return res
+}
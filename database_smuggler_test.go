@@ -0,0 +1,99 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseSmugglerOptionsToJSON(t *testing.T) {
+	options := NewDatabaseSmugglerExportOptions()
+	options.OperateOnTypes = []DatabaseItemType{DatabaseItemTypeDocuments, DatabaseItemTypeIndexes}
+	options.Collections = []string{"Users"}
+	options.TransformScript = "this.Name = this.Name.toUpperCase();"
+	m := options.toJSON()
+	assert.Equal(t, "Documents, Indexes", m["OperateOnTypes"])
+	assert.Equal(t, []string{"Users"}, m["Collections"])
+	assert.Equal(t, options.TransformScript, m["TransformScript"])
+	assert.Equal(t, 10000, m["MaxStepsForTransformScript"])
+
+	m = (&DatabaseSmugglerOptions{}).toJSON()
+	assert.True(t, strings.HasPrefix(m["OperateOnTypes"].(string), "Indexes, Documents"))
+	assert.Nil(t, m["Collections"])
+	assert.Nil(t, m["TransformScript"])
+}
+
+func TestDatabaseSmugglerImportRequest(t *testing.T) {
+	options := NewDatabaseSmugglerImportOptions()
+	cmd := newImportCommand(&options.DatabaseSmugglerOptions, 5, strings.NewReader("dump"))
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/smuggler/import?operationId=5", req.URL.String())
+
+	mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+	mr := multipart.NewReader(req.Body, params["boundary"])
+
+	part, err := mr.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "importOptions", part.FormName())
+	d, err := ioutil.ReadAll(part)
+	assert.NoError(t, err)
+	assert.Contains(t, string(d), `"OperateOnTypes":"Indexes, Documents`)
+
+	part, err = mr.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "file", part.FormName())
+	d, err = ioutil.ReadAll(part)
+	assert.NoError(t, err)
+	assert.Equal(t, "dump", string(d))
+}
+
+func TestDatabaseSmugglerImportIsNotRetried(t *testing.T) {
+	var imports int32
+	nodeA := &fakeNode{}
+	nodeA.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/operations/next-operation-id"):
+			w.Write([]byte(`{"Id": 7}`))
+		case strings.HasSuffix(r.URL.Path, "/smuggler/import"):
+			atomic.AddInt32(&imports, 1)
+			_, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	nodeB := newFakeNode()
+	defer nodeA.server.Close()
+	defer nodeB.server.Close()
+	re := newFailoverTestExecutor(nodeA, nodeB)
+	defer re.Close()
+
+	smuggler := &DatabaseSmuggler{
+		databaseName:    "db",
+		requestExecutor: re,
+	}
+	op, err := smuggler.ImportAsync(NewDatabaseSmugglerImportOptions(), strings.NewReader("dump"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), op.GetID())
+	assert.Error(t, <-op.chRequestDone)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&imports))
+	// the dump was not sent to another node
+	assert.Equal(t, int32(0), atomic.LoadInt32(&nodeB.requests))
+
+	cmd := newImportCommand(&NewDatabaseSmugglerImportOptions().DatabaseSmugglerOptions, 5, strings.NewReader("dump"))
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.NoError(t, err)
+	_ = req.Body.Close()
+	_, err = cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import "time"
 // DatabaseStatistics describes a result of GetStatisticsCommand
 type DatabaseStatistics struct {
 	LastDocEtag               int64 `json:"LastDocEtag"`
+	LastDatabaseEtag          int64 `json:"LastDatabaseEtag"`
 	CountOfIndexes            int   `json:"CountOfIndexes"`
 	CountOfDocuments          int64 `json:"CountOfDocuments"`
 	CountOfRevisionDocuments  int64 `json:"CountOfRevisionDocuments"` // TODO: present in Java, not seen in JSON
@@ -13,6 +14,8 @@ type DatabaseStatistics struct {
 	CountOfConflicts          int64 `json:"CountOfConflicts"`
 	CountOfAttachments        int64 `json:"CountOfAttachments"`
 	CountOfUniqueAttachments  int64 `json:"CountOfUniqueAttachments"`
+	CountOfCounterEntries     int64 `json:"CountOfCounterEntries"`
+	CountOfTimeSeriesSegments int64 `json:"CountOfTimeSeriesSegments"`
 
 	Indexes []*IndexInformation `json:"Indexes"`
 
@@ -31,10 +34,13 @@ func (s *DatabaseStatistics) GetLastIndexingTime() *time.Time {
 	return s.LastIndexingTime.toTimePtr()
 }
 
-/*
-public IndexInformation[] getStaleIndexes() {
-	return Arrays.stream(indexes)
-		.filter(x -> x.isStale())
-		.toArray(IndexInformation[]::new);
+// GetStaleIndexes returns indexes that are stale
+func (s *DatabaseStatistics) GetStaleIndexes() []*IndexInformation {
+	var res []*IndexInformation
+	for _, index := range s.Indexes {
+		if index.IsStale {
+			res = append(res, index)
+		}
+	}
+	return res
 }
-*/
@@ -8,6 +8,7 @@ type declareToken struct {
 	name       string
 	parameters string
 	body       string
+	timeSeries bool
 }
 
 /*
@@ -21,10 +22,23 @@ func newDeclareToken(name string, body string, parameters string) *declareToken
 }
 */
 
+func newDeclareTokenTimeSeries(name string, body string, parameters string) *declareToken {
+	return &declareToken{
+		name:       name,
+		body:       body,
+		parameters: parameters,
+		timeSeries: true,
+	}
+}
+
 func (t *declareToken) writeTo(writer *strings.Builder) error {
 
 	writer.WriteString("declare ")
-	writer.WriteString("function ")
+	if t.timeSeries {
+		writer.WriteString("timeseries ")
+	} else {
+		writer.WriteString("function ")
+	}
 	writer.WriteString(t.name)
 	writer.WriteString("(")
 	writer.WriteString(t.parameters)
@@ -16,6 +16,7 @@ type DeleteAttachmentOperation struct {
 	_changeVector *string
 }
 
+// Deprecated: use operations.NewDeleteAttachmentOperation.
 func NewDeleteAttachmentOperation(documentID string, name string, changeVector *string) *DeleteAttachmentOperation {
 	return &DeleteAttachmentOperation{
 		_documentID:   documentID,
@@ -17,6 +17,7 @@ type DeleteByQueryOperation struct {
 	options       *QueryOperationOptions
 }
 
+// Deprecated: use operations.NewDeleteByQueryOperation.
 func NewDeleteByQueryOperation(queryToDelete *IndexQuery, options *QueryOperationOptions) (*DeleteByQueryOperation, error) {
 	if queryToDelete == nil {
 		return nil, newIllegalArgumentError("QueryToDelete cannot be null")
@@ -17,6 +17,7 @@ type DeleteCompareExchangeValueOperation struct {
 	_index int64
 }
 
+// Deprecated: use operations.NewDeleteCompareExchangeValueOperation.
 func NewDeleteCompareExchangeValueOperation(clazz reflect.Type, key string, index int64) (*DeleteCompareExchangeValueOperation, error) {
 	if stringIsEmpty(key) {
 		return nil, newIllegalArgumentError("The kye argument must have value")
@@ -60,12 +61,11 @@ func NewRemoveCompareExchangeValueCommand(clazz reflect.Type, key string, index
 		_index:       index,
 		_conventions: conventions,
 	}
-	cmd.IsReadRequest = true
 	return cmd, nil
 }
 
 func (c *RemoveCompareExchangeValueCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
-	url := node.URL + "/databases/" + node.Database + "/cmpxchg?key=" + c._key + "&index=" + i64toa(c._index)
+	url := node.URL + "/databases/" + node.Database + "/cmpxchg?key=" + urlUtilsEscapeDataString(c._key) + "&index=" + i64toa(c._index)
 
 	return newHttpDelete(url, nil)
 }
@@ -9,30 +9,38 @@ var (
 	_ IServerOperation = &DeleteDatabasesOperation{}
 )
 
+// DeleteDatabasesOperation deletes databases from the whole cluster
+// or, if FromNodes is given, only from given nodes
 type DeleteDatabasesOperation struct {
 	parameters *DeleteDatabaseParameters
 
 	Command *DeleteDatabaseCommand
 }
 
+// DeleteDatabaseParameters describes databases to delete
 type DeleteDatabaseParameters struct {
-	DatabaseNames             []string       `json:"DatabaseNames"`
-	HardDelete                bool           `json:"HardDelete"`
-	FromNodes                 []string       `json:"FromNodes"`
-	TimeToWaitForConfirmation *time.Duration `json:"TimeToWaitForConfirmation"`
+	DatabaseNames []string `json:"DatabaseNames"`
+	// if true, database files are deleted as well
+	HardDelete bool `json:"HardDelete"`
+	// tags of nodes to delete databases from, all nodes if empty
+	FromNodes                 []string  `json:"FromNodes"`
+	TimeToWaitForConfirmation *Duration `json:"TimeToWaitForConfirmation"`
 }
 
+// Deprecated: use operations.NewDeleteDatabasesOperation.
 func NewDeleteDatabasesOperation(databaseName string, hardDelete bool) *DeleteDatabasesOperation {
 	return NewDeleteDatabasesOperation2(databaseName, hardDelete, "", 0)
 }
 
+// Deprecated: use operations.NewDeleteDatabasesOperation2.
 func NewDeleteDatabasesOperation2(databaseName string, hardDelete bool, fromNode string, timeToWaitForConfirmation time.Duration) *DeleteDatabasesOperation {
 	parameters := &DeleteDatabaseParameters{
 		DatabaseNames: []string{databaseName},
 		HardDelete:    hardDelete,
 	}
 	if timeToWaitForConfirmation != 0 {
-		parameters.TimeToWaitForConfirmation = &timeToWaitForConfirmation
+		d := Duration(timeToWaitForConfirmation)
+		parameters.TimeToWaitForConfirmation = &d
 	}
 	if fromNode != "" {
 		parameters.FromNodes = []string{fromNode}
@@ -40,6 +48,7 @@ func NewDeleteDatabasesOperation2(databaseName string, hardDelete bool, fromNode
 	return NewDeleteDatabasesOperationWithParameters(parameters)
 }
 
+// Deprecated: use operations.NewDeleteDatabasesOperationWithParameters.
 func NewDeleteDatabasesOperationWithParameters(parameters *DeleteDatabaseParameters) *DeleteDatabasesOperation {
 	return &DeleteDatabasesOperation{
 		parameters: parameters,
@@ -66,6 +75,9 @@ type DeleteDatabaseCommand struct {
 }
 
 func NewDeleteDatabaseCommand(conventions *DocumentConventions, parameters *DeleteDatabaseParameters) (*DeleteDatabaseCommand, error) {
+	if parameters == nil || len(parameters.DatabaseNames) == 0 {
+		return nil, newIllegalArgumentError("DatabaseNames cannot be empty")
+	}
 	d, err := jsonMarshal(parameters)
 	if err != nil {
 		return nil, err
@@ -85,5 +97,8 @@ func (c *DeleteDatabaseCommand) CreateRequest(node *ServerNode) (*http.Request,
 }
 
 func (c *DeleteDatabaseCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
 	return jsonUnmarshal(response, &c.Result)
 }
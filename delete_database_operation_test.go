@@ -0,0 +1,24 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteDatabasesOperation(t *testing.T) {
+	op := NewDeleteDatabasesOperation2("db", true, "B", time.Second*30)
+	cmd, err := op.GetCommand(NewDocumentConventions())
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080"})
+	assert.NoError(t, err)
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"DatabaseNames":["db"],"HardDelete":true,"FromNodes":["B"],"TimeToWaitForConfirmation":"00:00:30"}`, string(body))
+
+	op = NewDeleteDatabasesOperationWithParameters(&DeleteDatabaseParameters{})
+	_, err = op.GetCommand(NewDocumentConventions())
+	assert.Error(t, err)
+}
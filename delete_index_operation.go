@@ -6,15 +6,17 @@ import (
 
 var _ IVoidMaintenanceOperation = &DeleteIndexOperation{}
 
+// DeleteIndexOperation deletes an index
 type DeleteIndexOperation struct {
 	_indexName string
 
 	Command *DeleteIndexCommand
 }
 
+// NewDeleteIndexOperation returns DeleteIndexOperation
+//
+// Deprecated: use operations.NewDeleteIndexOperation.
 func NewDeleteIndexOperation(indexName string) *DeleteIndexOperation {
-	panicIf(indexName == "", "indexName cannot be empty")
-
 	return &DeleteIndexOperation{
 		_indexName: indexName,
 	}
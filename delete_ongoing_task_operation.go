@@ -0,0 +1,76 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var (
+	_ IMaintenanceOperation = &DeleteOngoingTaskOperation{}
+)
+
+// DeleteOngoingTaskOperation deletes a background task like ETL,
+// replication or backup
+type DeleteOngoingTaskOperation struct {
+	taskID   int64
+	taskType OngoingTaskType
+
+	Command *DeleteOngoingTaskCommand
+}
+
+// NewDeleteOngoingTaskOperation returns new DeleteOngoingTaskOperation
+//
+// Deprecated: use operations.NewDeleteOngoingTaskOperation.
+func NewDeleteOngoingTaskOperation(taskID int64, taskType OngoingTaskType) (*DeleteOngoingTaskOperation, error) {
+	if taskID <= 0 {
+		return nil, newIllegalArgumentError("taskID must be positive")
+	}
+	if taskType == "" {
+		return nil, newIllegalArgumentError("taskType cannot be empty")
+	}
+	return &DeleteOngoingTaskOperation{
+		taskID:   taskID,
+		taskType: taskType,
+	}, nil
+}
+
+func (o *DeleteOngoingTaskOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewDeleteOngoingTaskCommand(o.taskID, o.taskType)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &DeleteOngoingTaskCommand{}
+
+// DeleteOngoingTaskCommand describes "delete ongoing task" command
+type DeleteOngoingTaskCommand struct {
+	RavenCommandBase
+
+	taskID   int64
+	taskType OngoingTaskType
+
+	Result *ModifyOngoingTaskResult
+}
+
+// NewDeleteOngoingTaskCommand returns new DeleteOngoingTaskCommand
+func NewDeleteOngoingTaskCommand(taskID int64, taskType OngoingTaskType) *DeleteOngoingTaskCommand {
+	return &DeleteOngoingTaskCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID:   taskID,
+		taskType: taskType,
+	}
+}
+
+func (c *DeleteOngoingTaskCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/tasks?id=" + strconv.FormatInt(c.taskID, 10) + "&type=" + string(c.taskType)
+
+	return newHttpDelete(url, nil)
+}
+
+func (c *DeleteOngoingTaskCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
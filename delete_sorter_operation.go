@@ -0,0 +1,67 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IVoidMaintenanceOperation = &DeleteSorterOperation{}
+
+// DeleteSorterOperation removes a custom sorter from a database
+type DeleteSorterOperation struct {
+	sorterName string
+
+	Command *DeleteSorterCommand
+}
+
+// NewDeleteSorterOperation returns new DeleteSorterOperation
+//
+// Deprecated: use operations.NewDeleteSorterOperation.
+func NewDeleteSorterOperation(sorterName string) (*DeleteSorterOperation, error) {
+	if sorterName == "" {
+		return nil, newIllegalArgumentError("sorterName cannot be empty")
+	}
+	return &DeleteSorterOperation{
+		sorterName: sorterName,
+	}, nil
+}
+
+// GetCommand returns a command for this operation
+func (o *DeleteSorterOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewDeleteSorterCommand(o.sorterName)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var (
+	_ RavenCommand = &DeleteSorterCommand{}
+)
+
+// DeleteSorterCommand represents a command for removing a custom sorter
+type DeleteSorterCommand struct {
+	RavenCommandBase
+
+	sorterName string
+}
+
+// NewDeleteSorterCommand returns new DeleteSorterCommand
+func NewDeleteSorterCommand(sorterName string) (*DeleteSorterCommand, error) {
+	if sorterName == "" {
+		return nil, newIllegalArgumentError("sorterName cannot be empty")
+	}
+	cmd := &DeleteSorterCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		sorterName: sorterName,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+// CreateRequest creates http request for the command
+func (c *DeleteSorterCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/sorters?name=" + urlUtilsEscapeDataString(c.sorterName)
+	return newHttpDelete(url, nil)
+}
@@ -0,0 +1,19 @@
+package ravendb
+
+// CollectionDetails describes size and document count of a collection
+type CollectionDetails struct {
+	Name             string `json:"Name"`
+	CountOfDocuments int64  `json:"CountOfDocuments"`
+	Size             *Size  `json:"Size"`
+	DocumentsSize    *Size  `json:"DocumentsSize"`
+	TombstonesSize   *Size  `json:"TombstonesSize"`
+	RevisionsSize    *Size  `json:"RevisionsSize"`
+}
+
+// DetailedCollectionStatistics describes collection statistics
+// including sizes of collections
+type DetailedCollectionStatistics struct {
+	CountOfDocuments int64                         `json:"CountOfDocuments"`
+	CountOfConflicts int64                         `json:"CountOfConflicts"`
+	Collections      map[string]*CollectionDetails `json:"Collections"`
+}
@@ -6,27 +6,49 @@ import (
 
 var _ IVoidMaintenanceOperation = &DisableIndexOperation{}
 
+// DisableIndexOperation disables an index.
+// By default it only affects the node that receives the request,
+// use NewDisableIndexOperationClusterWide to change it on all nodes
 type DisableIndexOperation struct {
-	_indexName string
+	_indexName  string
+	clusterWide bool
 
 	Command *DisableIndexCommand
 }
 
+// NewDisableIndexOperation returns DisableIndexOperation
+//
+// Deprecated: use operations.NewDisableIndexOperation.
 func NewDisableIndexOperation(indexName string) (*DisableIndexOperation, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 	return &DisableIndexOperation{
 		_indexName: indexName,
 	}, nil
 }
 
+// NewDisableIndexOperationClusterWide returns DisableIndexOperation that disables
+// an index on all nodes of the cluster. The change is persisted in
+// the database record
+//
+// Deprecated: use operations.NewDisableIndexOperationClusterWide.
+func NewDisableIndexOperationClusterWide(indexName string) (*DisableIndexOperation, error) {
+	res, err := NewDisableIndexOperation(indexName)
+	if err != nil {
+		return nil, err
+	}
+	res.clusterWide = true
+	return res, nil
+}
+
 func (o *DisableIndexOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
 	var err error
 	o.Command, err = NewDisableIndexCommand(o._indexName)
 	if err != nil {
 		return nil, err
 	}
+	o.Command.clusterWide = o.clusterWide
 	return o.Command, nil
 }
 
@@ -37,12 +59,13 @@ var (
 type DisableIndexCommand struct {
 	RavenCommandBase
 
-	_indexName string
+	_indexName  string
+	clusterWide bool
 }
 
 func NewDisableIndexCommand(indexName string) (*DisableIndexCommand, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 
 	cmd := &DisableIndexCommand{
@@ -56,6 +79,9 @@ func NewDisableIndexCommand(indexName string) (*DisableIndexCommand, error) {
 
 func (c *DisableIndexCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	url := node.URL + "/databases/" + node.Database + "/admin/indexes/disable?name=" + urlUtilsEscapeDataString(c._indexName)
+	if c.clusterWide {
+		url += "&clusterWide=true"
+	}
 
 	return NewHttpPost(url, nil)
 }
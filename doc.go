@@ -1,6 +1,22 @@
 /*
 Package ravendb implements a driver for RavenDB NOSQL document database.
 
+Most of the API lives in this package. Additional functionality is in sub-packages:
+
+	operations               operations sent with DocumentStore.Operations() and Maintenance()
+	changes                  notifications returned by DocumentStore.Changes()
+	subscriptions            data subscriptions and subscription workers
+	serverwide/operations    cluster and database topology operations
+	serverwide/certificates  certificate management operations
+	embedded                 starting a local RavenDB server for development and tests
+	rql                      reusable parameterized RQL query templates
+	ravendbtest              helpers for integration tests, like WaitForIndexing
+
+Types in operations, changes and subscriptions are aliases of the types in
+this package, which are kept for compatibility. They can be used interchangeably.
+Constructors of operations and subscription workers are deprecated in this
+package in favor of the functions in operations and subscriptions.
+
 For more documentation see https://github.com/ravendb/ravendb-go-client/blob/master/readme.md
 */
 package ravendb
@@ -0,0 +1,80 @@
+package ravendb
+
+import (
+	"sync"
+	"time"
+)
+
+// documentChangeCoalescer delays delivery of DocumentChange notifications
+// and delivers only the latest change for a given document id per window
+type documentChangeCoalescer struct {
+	window time.Duration
+	cb     func(*DocumentChange)
+
+	mu      sync.Mutex
+	pending map[string]*DocumentChange
+	timers  map[string]*time.Timer
+	closed  bool
+
+	// serializes calls to cb, like changes delivered without coalescing
+	muDeliver sync.Mutex
+}
+
+// CoalesceDocumentChanges wraps cb so that, for each document id, it's called
+// at most once per window with the latest change (and its change vector)
+// received within that window. Useful for very hot documents.
+// The returned function should be passed to DatabaseChanges.ForDocument,
+// ForAllDocuments etc. Calling returned CancelFunc discards pending changes
+// and stops further delivery.
+func CoalesceDocumentChanges(window time.Duration, cb func(*DocumentChange)) (func(*DocumentChange), CancelFunc) {
+	c := &documentChangeCoalescer{
+		window:  window,
+		cb:      cb,
+		pending: map[string]*DocumentChange{},
+		timers:  map[string]*time.Timer{},
+	}
+	return c.add, c.close
+}
+
+func (c *documentChangeCoalescer) add(change *DocumentChange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	id := change.ID
+	_, isPending := c.pending[id]
+	c.pending[id] = change
+	if !isPending {
+		c.timers[id] = time.AfterFunc(c.window, func() {
+			c.deliver(id)
+		})
+	}
+}
+
+func (c *documentChangeCoalescer) deliver(id string) {
+	c.muDeliver.Lock()
+	defer c.muDeliver.Unlock()
+
+	c.mu.Lock()
+	change := c.pending[id]
+	delete(c.pending, id)
+	delete(c.timers, id)
+	closed := c.closed
+	c.mu.Unlock()
+
+	if change != nil && !closed {
+		c.cb(change)
+	}
+}
+
+func (c *documentChangeCoalescer) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	for _, timer := range c.timers {
+		timer.Stop()
+	}
+	c.pending = map[string]*DocumentChange{}
+	c.timers = map[string]*time.Timer{}
+}
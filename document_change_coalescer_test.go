@@ -0,0 +1,60 @@
+package ravendb
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalesceDocumentChanges(t *testing.T) {
+	var mu sync.Mutex
+	var got []*DocumentChange
+	chDelivered := make(chan bool, 10)
+	cb, cancel := CoalesceDocumentChanges(time.Millisecond*50, func(change *DocumentChange) {
+		mu.Lock()
+		got = append(got, change)
+		mu.Unlock()
+		chDelivered <- true
+	})
+
+	newChange := func(id string, changeVector string) *DocumentChange {
+		return &DocumentChange{
+			Type:         DocumentChangePut,
+			ID:           id,
+			ChangeVector: &changeVector,
+		}
+	}
+	cb(newChange("users/1", "A:1"))
+	cb(newChange("users/2", "A:2"))
+	cb(newChange("users/1", "A:3"))
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-chDelivered:
+		case <-time.After(time.Second * 5):
+			t.Fatal("timed out waiting for changes")
+		}
+	}
+
+	mu.Lock()
+	assert.Equal(t, 2, len(got))
+	byID := map[string]string{}
+	for _, change := range got {
+		byID[change.ID] = *change.ChangeVector
+	}
+	mu.Unlock()
+	assert.Equal(t, "A:3", byID["users/1"])
+	assert.Equal(t, "A:2", byID["users/2"])
+
+	// pending changes are discarded after cancel
+	cb(newChange("users/1", "A:4"))
+	cancel()
+	cb(newChange("users/1", "A:5"))
+	select {
+	case <-chDelivered:
+		t.Fatal("change delivered after cancel")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"net/http"
 	"reflect"
 	"strings"
 	"sync"
@@ -35,16 +36,85 @@ type DocumentConventions struct {
 
 	documentIDGenerator DocumentIDGeneratorFunc
 
+	// maps collection name to a function generating ids
+	// for entities in that collection
+	idGenerators map[string]DocumentIDGeneratorFunc
+
 	// allows overriding entity -> collection name logic
 	FindCollectionName func(interface{}) string
 
 	ReadBalanceBehavior                            ReadBalanceBehavior
 	transformClassCollectionNameToDocumentIDPrefix func(string) string
 
+	// LoadBalanceBehavior decides if sessions are pinned to nodes based on their context.
+	// With LoadBalanceBehaviorUseSessionContext it takes precedence over
+	// ReadBalanceBehavior for sessions that have a context
+	LoadBalanceBehavior LoadBalanceBehavior
+	// LoadBalancerPerSessionContextSelector returns the context of a new session
+	// for a given database. Returning empty string leaves the session without context
+	LoadBalancerPerSessionContextSelector func(databaseName string) string
+	// LoadBalancerContextSeed changes which node is chosen for a given context
+	LoadBalancerContextSeed int
+
 	// if true, will return error if page size is not set
 	ErrorIfQueryPageSizeIsNotSet bool
 
-	maxHttpCacheSize int
+	// MaxHttpCacheSize is the size in bytes of responses cached by RequestExecutor.
+	// When exceeded, least recently used responses are evicted. 0 disables caching.
+	// Must be set before the store is initialized
+	MaxHttpCacheSize int
+
+	// HTTPClient, if set, is used by request executors to send requests to the
+	// server instead of a client they create. It must present DocumentStore.Certificate
+	// and trust DocumentStore.TrustStore on its own. HTTPClientPostProcessor
+	// is not applied to it
+	HTTPClient *http.Client
+	// HTTPTransport, if set, is used instead of http.DefaultTransport by the
+	// clients request executors create, e.g. to send requests through a proxy.
+	// If it's *http.Transport, a copy that presents DocumentStore.Certificate and
+	// trusts DocumentStore.TrustStore (unless its TLSClientConfig already
+	// sets certificates or root CAs) is used.
+	// Proxy of *http.Transport is also used by Changes()
+	HTTPTransport http.RoundTripper
+
+	// MaxRetryAfterDelay is the longest delay suggested by server's Retry-After
+	// header (on 429 and 503 responses) that we'll wait before retrying a request.
+	// If server asks for a longer delay, ServerBusyError is returned.
+	// A 429 response without Retry-After is retried after a short delay.
+	// 0 disables retrying.
+	MaxRetryAfterDelay time.Duration
+
+	// LargeDocumentSize is the size in bytes of serialized document above which
+	// SaveChanges calls OnLargeDocument. 0 disables the check.
+	LargeDocumentSize int
+	// OnLargeDocument is called by SaveChanges for every document larger than
+	// LargeDocumentSize. Returning nil lets the document be saved (e.g. after
+	// logging a warning), returning an error aborts SaveChanges.
+	// If not set, SaveChanges fails with LargeDocumentError.
+	OnLargeDocument func(info *LargeDocumentInfo) error
+
+	// PanicOnConcurrentSessionUsage makes session methods panic instead of
+	// returning ConcurrentSessionUsageError when a session is used from
+	// multiple goroutines at the same time. Meant for development, to find
+	// such bugs quickly
+	PanicOnConcurrentSessionUsage bool
+
+	// UseCompression enables gzip compression of documents sent by
+	// SaveChanges and bulk insert, which reduces bandwidth at the cost of CPU
+	UseCompression bool
+
+	// HiLoInitialCapacity is the number of ids requested in the first HiLo
+	// range of a collection. The server doesn't hand out less than 32 ids.
+	// If none of the HiLo settings is set, the server decides range sizes.
+	// The HiLo settings are best-effort hints: the server has no way to
+	// request a range of a given size, so the sizes it hands out can differ
+	HiLoInitialCapacity int64
+	// HiLoGrowthFactor multiplies the size of the next HiLo range when the
+	// previous one was used up in less than 30 seconds
+	HiLoGrowthFactor float64
+	// HiLoMaxRangeSize caps the size of HiLo ranges we ask for. 0 means
+	// no cap. Larger ranges handed out by the server are used in full
+	HiLoMaxRangeSize int64
 
 	// a pointer to silence go vet when copying DocumentConventions wholesale
 	mu *sync.Mutex
@@ -62,21 +132,19 @@ func getDefaultConventions() *DocumentConventions {
 func NewDocumentConventions() *DocumentConventions {
 	return &DocumentConventions{
 		ReadBalanceBehavior:                            ReadBalanceBehaviorNone,
+		LoadBalanceBehavior:                            LoadBalanceBehaviorNone,
 		MaxLengthOfQueryUsingGetURL:                    1024 + 512,
 		IdentityPartsSeparator:                         "/",
 		disableTopologyUpdates:                         false,
 		RaiseIfQueryPageSizeIsNotSet:                   false,
 		transformClassCollectionNameToDocumentIDPrefix: getDefaultTransformCollectionNameToDocumentIdPrefix,
 		MaxNumberOfRequestsPerSession:                  32,
-		maxHttpCacheSize:                               128 * 1024 * 1024,
+		MaxHttpCacheSize:                               128 * 1024 * 1024,
+		MaxRetryAfterDelay:                             time.Second * 5,
 		mu:                                             &sync.Mutex{},
 	}
 }
 
-func (c *DocumentConventions) getMaxHttpCacheSize() int {
-	return c.maxHttpCacheSize
-}
-
 func (c *DocumentConventions) Freeze() {
 	c.frozen = true
 }
@@ -116,6 +184,8 @@ func (c *DocumentConventions) UpdateFrom(configuration *ClientConfiguration) {
 		// need to revert to original values
 		c.MaxNumberOfRequestsPerSession = c.originalConfiguration.MaxNumberOfRequestsPerSession
 		c.ReadBalanceBehavior = c.originalConfiguration.ReadBalanceBehavior
+		c.LoadBalanceBehavior = c.originalConfiguration.LoadBalanceBehavior
+		c.LoadBalancerContextSeed = c.originalConfiguration.LoadBalancerContextSeed
 
 		c.originalConfiguration = nil
 		return
@@ -126,11 +196,15 @@ func (c *DocumentConventions) UpdateFrom(configuration *ClientConfiguration) {
 		c.originalConfiguration.Etag = -1
 		c.originalConfiguration.MaxNumberOfRequestsPerSession = c.MaxNumberOfRequestsPerSession
 		c.originalConfiguration.ReadBalanceBehavior = c.ReadBalanceBehavior
+		c.originalConfiguration.LoadBalanceBehavior = c.LoadBalanceBehavior
+		c.originalConfiguration.LoadBalancerContextSeed = c.LoadBalancerContextSeed
 	}
 
 	c.MaxNumberOfRequestsPerSession = firstNonZero(configuration.MaxNumberOfRequestsPerSession, c.originalConfiguration.MaxNumberOfRequestsPerSession)
 
 	c.ReadBalanceBehavior = firstNonEmptyString(configuration.ReadBalanceBehavior, c.originalConfiguration.ReadBalanceBehavior)
+	c.LoadBalanceBehavior = LoadBalanceBehavior(firstNonEmptyString(string(configuration.LoadBalanceBehavior), string(c.originalConfiguration.LoadBalanceBehavior)))
+	c.LoadBalancerContextSeed = firstNonZero(configuration.LoadBalancerContextSeed, c.originalConfiguration.LoadBalancerContextSeed)
 }
 
 func getDefaultTransformCollectionNameToDocumentIdPrefix(collectionName string) string {
@@ -156,6 +230,10 @@ func (c *DocumentConventions) Clone() *DocumentConventions {
 	res := *c
 	// mutex carries its locking state so we need to re-initialize it
 	res.mu = &sync.Mutex{}
+	res.idGenerators = map[string]DocumentIDGeneratorFunc{}
+	for k, v := range c.idGenerators {
+		res.idGenerators[k] = v
+	}
 	return &res
 }
 
@@ -176,9 +254,74 @@ func (c *DocumentConventions) SetDocumentIDGenerator(documentIDGenerator Documen
 	c.documentIDGenerator = documentIDGenerator
 }
 
+// RegisterIdConvention registers a function that generates document ids for
+// entities belonging to a given collection (e.g. "Orders").
+// It can return a full id (semantic ids like "users/john"), an id ending
+// with "|" (server-side identity like "orders|") or "/" (server-side
+// generated id). Returning empty string falls back to the default generator.
+func (c *DocumentConventions) RegisterIdConvention(collectionName string, fn func(entity interface{}) string) {
+	if fn == nil {
+		c.RegisterIdGenerator(collectionName, nil)
+		return
+	}
+	c.RegisterIdGenerator(collectionName, func(dbName string, entity interface{}) (string, error) {
+		return fn(entity), nil
+	})
+}
+
+// RegisterIdGenerator registers a strategy generating document ids for
+// entities belonging to a given collection (e.g. "Orders"), replacing
+// a generator or convention registered for it before.
+// It's used by session's Store and by bulk insert.
+// Returned ids follow the same rules as in RegisterIdConvention, which
+// allows e.g. semantic, identity or client generated (GUID, ULID) ids per
+// collection while other collections keep using HiLo.
+// Passing nil fn unregisters the generator.
+func (c *DocumentConventions) RegisterIdGenerator(collectionName string, fn DocumentIDGeneratorFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.idGenerators == nil {
+		c.idGenerators = map[string]DocumentIDGeneratorFunc{}
+	}
+	if fn == nil {
+		delete(c.idGenerators, collectionName)
+		return
+	}
+	c.idGenerators[collectionName] = fn
+}
+
 // Generates the document id.
+// Collection-specific generators registered with RegisterIdGenerator or
+// RegisterIdConvention take precedence over document id generator
+// (HiLo by default). If neither produces an id, a random GUID is used.
 func (c *DocumentConventions) GenerateDocumentID(databaseName string, entity interface{}) (string, error) {
-	return c.documentIDGenerator(databaseName, entity)
+	collectionName := c.getCollectionName(entity)
+	c.mu.Lock()
+	idGenerator := c.idGenerators[collectionName]
+	c.mu.Unlock()
+
+	if idGenerator != nil {
+		id, err := idGenerator(databaseName, entity)
+		if err != nil {
+			return "", err
+		}
+		if id != "" {
+			return id, nil
+		}
+	}
+
+	var id string
+	if c.documentIDGenerator != nil {
+		var err error
+		id, err = c.documentIDGenerator(databaseName, entity)
+		if err != nil {
+			return "", err
+		}
+	}
+	if id == "" {
+		id = NewUUID().String()
+	}
+	return id, nil
 }
 
 func (c *DocumentConventions) IsDisableTopologyUpdates() bool {
@@ -212,3 +355,7 @@ func (c *DocumentConventions) TryConvertValueForQuery(fieldName string, value in
 	*stringValue = ""
 	return false
 }
+
+func (c *DocumentConventions) hasHiLoRangePolicy() bool {
+	return c.HiLoInitialCapacity > 0 || c.HiLoGrowthFactor > 0 || c.HiLoMaxRangeSize > 0
+}
@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -18,3 +19,81 @@ func TestDefaultGetCollectionName(t *testing.T) {
 	name = getCollectionNameForTypeOrEntity(reflect.TypeOf(&User{}))
 	assert.Equal(t, "Users", name)
 }
+
+func TestGenerateDocumentIDUsesIdConvention(t *testing.T) {
+	c := NewDocumentConventions()
+	c.SetDocumentIDGenerator(func(dbName string, entity interface{}) (string, error) {
+		return "users/1-A", nil
+	})
+
+	id, err := c.GenerateDocumentID("db", &User{Name: "John"})
+	assert.NoError(t, err)
+	assert.Equal(t, "users/1-A", id)
+
+	c.RegisterIdConvention("Users", func(entity interface{}) string {
+		name := entity.(*User).Name
+		if name == "" {
+			return ""
+		}
+		return "users/" + name
+	})
+	id, err = c.GenerateDocumentID("db", &User{Name: "John"})
+	assert.NoError(t, err)
+	assert.Equal(t, "users/John", id)
+
+	// empty id from convention falls back to default generator
+	id, err = c.GenerateDocumentID("db", &User{})
+	assert.NoError(t, err)
+	assert.Equal(t, "users/1-A", id)
+
+	c.RegisterIdConvention("Users", nil)
+	c.SetDocumentIDGenerator(func(dbName string, entity interface{}) (string, error) {
+		return "", nil
+	})
+	id, err = c.GenerateDocumentID("db", &User{})
+	assert.NoError(t, err)
+	assert.Equal(t, 36, len(id))
+}
+
+func TestGenerateDocumentIDUsesRegisteredIdGenerator(t *testing.T) {
+	c := NewDocumentConventions()
+	c.SetDocumentIDGenerator(func(dbName string, entity interface{}) (string, error) {
+		return "hilo/1-A", nil
+	})
+	c.RegisterIdGenerator("Users", func(dbName string, entity interface{}) (string, error) {
+		if entity.(*User).Name == "" {
+			return "", errors.New("name is required")
+		}
+		return dbName + "/users|", nil
+	})
+
+	id, err := c.GenerateDocumentID("db", &User{Name: "John"})
+	assert.NoError(t, err)
+	assert.Equal(t, "db/users|", id)
+
+	_, err = c.GenerateDocumentID("db", &User{})
+	assert.Error(t, err)
+
+	// other collections use the default generator
+	id, err = c.GenerateDocumentID("db", map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.Equal(t, "hilo/1-A", id)
+
+	// id convention replaces the generator registered for the same collection
+	c.RegisterIdConvention("Users", func(entity interface{}) string {
+		return "users/" + entity.(*User).Name
+	})
+	id, err = c.GenerateDocumentID("db", &User{})
+	assert.NoError(t, err)
+	assert.Equal(t, "users/", id)
+
+	clone := c.Clone()
+	c.RegisterIdGenerator("Users", nil)
+	id, err = c.GenerateDocumentID("db", &User{})
+	assert.NoError(t, err)
+	assert.Equal(t, "hilo/1-A", id)
+
+	id, err = clone.GenerateDocumentID("db", &User{Name: "John"})
+	assert.NoError(t, err)
+	assert.Equal(t, "users/John", id)
+}
@@ -102,6 +102,29 @@ func (q *DocumentQuery) SelectFieldsWithQueryData(projectionType reflect.Type, q
 	return res
 }
 
+// SelectTimeSeries projects every result of the query to the result of
+// a time series query configured by builder. projectionType should be
+// *TimeSeriesAggregationResult for queries with GroupBy and
+// *TimeSeriesRawResult otherwise
+func (q *DocumentQuery) SelectTimeSeries(projectionType reflect.Type, builder func(*TimeSeriesQueryBuilder)) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	b := &TimeSeriesQueryBuilder{}
+	builder(b)
+	queryData, err := b.queryData()
+	if err != nil {
+		q.err = err
+		return q
+	}
+	res, err := q.createDocumentQueryInternal(projectionType, queryData)
+	if err != nil {
+		q.err = err
+		return q
+	}
+	return res
+}
+
 // Distinct marks query as distinct
 func (q *DocumentQuery) Distinct() *DocumentQuery {
 	if q.err != nil {
@@ -290,6 +313,33 @@ func (q *DocumentQuery) Include(path string) *DocumentQuery {
 
 //TBD expr IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.Include(Expression<Func<T, object>> path)
 
+// Transform adds a client-side transformation of query results.
+// When transformers are present, GetResults runs the query for the type
+// expected by the first transformer and returns the output of the last one
+func (q *DocumentQuery) Transform(transformer *ResultTransformer) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.transform(transformer)
+	return q
+}
+
+// IncludeWithBuilder includes documents, counters, time series, compare exchange
+// values and revisions described by the builder in query results
+func (q *DocumentQuery) IncludeWithBuilder(builder func(*QueryIncludeBuilder)) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	if builder == nil {
+		q.err = newIllegalArgumentError("builder cannot be nil")
+		return q
+	}
+	b := &QueryIncludeBuilder{}
+	builder(b)
+	q.err = q.includeWithBuilder(b)
+	return q
+}
+
 func (q *DocumentQuery) Not() *DocumentQuery {
 	q.negateNext()
 	return q
@@ -313,6 +363,9 @@ func (q *DocumentQuery) Where(fieldName string, op string, value interface{}) *D
 	return q
 }
 
+// WhereLucene filters by a raw Lucene clause, for conditions that can't be
+// expressed with other Where methods. whereClause is sent as a query parameter
+// and is never embedded in the query text
 func (q *DocumentQuery) WhereLucene(fieldName string, whereClause string) *DocumentQuery {
 	if q.err != nil {
 		return q
@@ -472,6 +525,9 @@ func (q *DocumentQuery) WhereExists(fieldName string) *DocumentQuery {
 
 //TBD expr IDocumentQuery<T> IFilterDocumentQueryBase<T, IDocumentQuery<T>>.WhereRegex<TValue>(Expression<Func<T, TValue>> propertySelector, string pattern)
 
+// WhereRegex filters documents whose field matches a regular expression.
+// The pattern uses .NET syntax, as it's evaluated by the server, and is sent
+// as a query parameter
 func (q *DocumentQuery) WhereRegex(fieldName string, pattern string) *DocumentQuery {
 	if q.err != nil {
 		return q
@@ -592,6 +648,26 @@ func (q *DocumentQuery) OrderByDescendingWithOrdering(field string, ordering Ord
 
 //TBD expr  IDocumentQuery<T> OrderByDescending<TValue>(params Expression<Func<T, TValue>>[] propertySelectors)
 
+// OrderByWithSorter orders query results by a field using a custom sorter
+// registered on the server with PutSortersOperation
+func (q *DocumentQuery) OrderByWithSorter(field string, sorterName string) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.orderByWithSorter(field, sorterName, false)
+	return q
+}
+
+// OrderByDescendingWithSorter orders query results by a field in descending order
+// using a custom sorter registered on the server with PutSortersOperation
+func (q *DocumentQuery) OrderByDescendingWithSorter(field string, sorterName string) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.orderByWithSorter(field, sorterName, true)
+	return q
+}
+
 // AddBeforeQueryExecutedListener adds a listener that will be called before query
 // is executed
 func (q *DocumentQuery) AddBeforeQueryExecutedListener(action func(*IndexQuery)) int {
@@ -672,6 +748,10 @@ func (q *abstractDocumentQuery) createDocumentQueryInternal(resultClass reflect.
 	query.negate = q.negate
 	//noinspection unchecked
 	query.includes = stringArrayCopy(q.includes)
+	query.queryIncludes = q.queryIncludes
+	// copied so that Transform on one derived query doesn't overwrite
+	// transformers of another
+	query.resultTransformers = append([]*ResultTransformer(nil), q.resultTransformers...)
 	// TODO: should this be deep copy so that adding/removing in one
 	// doesn't affect the other?
 	query.beforeQueryExecutedCallback = q.beforeQueryExecutedCallback
@@ -679,6 +759,10 @@ func (q *abstractDocumentQuery) createDocumentQueryInternal(resultClass reflect.
 	query.afterStreamExecutedCallback = q.afterStreamExecutedCallback
 	query.disableEntitiesTracking = q.disableEntitiesTracking
 	query.disableCaching = q.disableCaching
+	query.projectionBehavior = q.projectionBehavior
+	if queryData != nil && queryData.ProjectionBehavior != "" {
+		query.projectionBehavior = queryData.ProjectionBehavior
+	}
 	//TBD 4.1 ShowQueryTimings = ShowQueryTimings,
 	//TBD 4.1 query.shouldExplainScores = shouldExplainScores;
 	query.isIntersect = q.isIntersect
@@ -698,6 +782,23 @@ func (q *DocumentQuery) AggregateByFacet(facet FacetBase) *AggregationDocumentQu
 	return res
 }
 
+// AggregateBy aggregates the query by a facet configured by builder e.g.
+// q.AggregateBy(func(f *FacetBuilder) { f.ByField("Manufacturer").SumOn("Cost") })
+func (q *DocumentQuery) AggregateBy(builder func(*FacetBuilder)) *AggregationDocumentQuery {
+	res := newAggregationDocumentQuery(q)
+	if q.err != nil {
+		return res
+	}
+
+	facet, err := buildFacet(builder)
+	if err != nil {
+		res.err = err
+		return res
+	}
+	res.err = q.aggregateBy(facet)
+	return res
+}
+
 // AggregateByFacets aggregates the query by facets
 func (q *DocumentQuery) AggregateByFacets(facets ...*Facet) *AggregationDocumentQuery {
 	res := newAggregationDocumentQuery(q)
@@ -873,10 +974,15 @@ func (q *DocumentQuery) OrderByDistanceDescendingWkt(fieldName string, shapeWkt
 	return q
 }
 
+// MoreLikeThis adds a "more like this" clause to the query
 func (q *DocumentQuery) MoreLikeThis(moreLikeThis MoreLikeThisBase) *DocumentQuery {
 	if q.err != nil {
 		return q
 	}
+	if moreLikeThis == nil {
+		q.err = newIllegalArgumentError("moreLikeThis cannot be nil")
+		return q
+	}
 	mlt, err := q.moreLikeThis()
 	if err != nil {
 		q.err = err
@@ -888,35 +994,33 @@ func (q *DocumentQuery) MoreLikeThis(moreLikeThis MoreLikeThisBase) *DocumentQue
 
 	if mltud, ok := moreLikeThis.(*MoreLikeThisUsingDocument); ok {
 		mlt.withDocument(mltud.documentJSON)
+	} else if mltdq, ok := moreLikeThis.(*MoreLikeThisUsingDocumentForDocumentQuery); ok {
+		if fn := mltdq.GetForDocumentQuery(); fn != nil {
+			fn(q)
+		}
 	}
 
 	return q
 }
 
+// MoreLikeThisWithBuilder adds a "more like this" clause configured by builder.
+// The builder must pick the source document with one of the Using* methods
 func (q *DocumentQuery) MoreLikeThisWithBuilder(builder func(IMoreLikeThisBuilderForDocumentQuery)) *DocumentQuery {
 	if q.err != nil {
 		return q
 	}
+	if builder == nil {
+		q.err = newIllegalArgumentError("builder cannot be nil")
+		return q
+	}
 	f := NewMoreLikeThisBuilder()
 	builder(f)
-
-	moreLikeThis, err := q.moreLikeThis()
-	if err != nil {
-		q.err = err
+	if f.GetMoreLikeThis() == nil {
+		q.err = newIllegalStateError("builder must call UsingAnyDocument(), UsingDocument() or UsingDocumentWithBuilder()")
 		return q
 	}
 
-	moreLikeThis.withOptions(f.GetMoreLikeThis().GetOptions())
-
-	tmp := f.GetMoreLikeThis()
-	if mlt, ok := tmp.(*MoreLikeThisUsingDocument); ok {
-		moreLikeThis.withDocument(mlt.documentJSON)
-	} else if mlt, ok := tmp.(*MoreLikeThisUsingDocumentForDocumentQuery); ok {
-		mlt.GetForDocumentQuery()(q)
-	}
-	moreLikeThis.Close()
-
-	return q
+	return q.MoreLikeThis(f.GetMoreLikeThis())
 }
 
 func (q *DocumentQuery) SuggestUsing(suggestion SuggestionBase) *SuggestionDocumentQuery {
@@ -932,3 +1036,13 @@ func (q *DocumentQuery) SuggestUsing(suggestion SuggestionBase) *SuggestionDocum
 	}
 	return res
 }
+
+// SuggestUsingBuilder adds a suggestion configured by builder e.g.
+// q.SuggestUsingBuilder(func(b *SuggestionBuilder) { b.ByField("Name", "johne") })
+func (q *DocumentQuery) SuggestUsingBuilder(builder func(*SuggestionBuilder)) *SuggestionDocumentQuery {
+	suggestion, err := buildSuggestion(builder)
+	if err != nil && q.err == nil {
+		q.err = err
+	}
+	return q.SuggestUsing(suggestion)
+}
@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -65,6 +66,18 @@ func (s *DocumentSession) Revisions() *RevisionsSessionOperations {
 	return s.revisions
 }
 
+// ClusterTransaction returns operations on compare exchange values.
+// The session must be opened with TransactionModeClusterWide
+func (s *DocumentSession) ClusterTransaction() (*ClusterTransactionOperations, error) {
+	if s.transactionMode != TransactionModeClusterWide {
+		return nil, newIllegalStateError("This function is part of cluster transaction session, in order to use it you have to open the Session with TransactionMode set to %s", TransactionModeClusterWide)
+	}
+	if s.clusterSession == nil {
+		s.clusterSession = newClusterTransactionOperations(s)
+	}
+	return s.clusterSession, nil
+}
+
 // NewDocumentSession creates a new DocumentSession
 func NewDocumentSession(dbName string, documentStore *DocumentStore, id string, re *RequestExecutor) *DocumentSession {
 	res := &DocumentSession{
@@ -82,21 +95,44 @@ func NewDocumentSession(dbName string, documentStore *DocumentStore, id string,
 
 // SaveChanges saves changes queued in memory to the database
 func (s *DocumentSession) SaveChanges() error {
+	_, err := s.SaveChangesWithResult()
+	return err
+}
+
+// SaveChangesWithContext is like SaveChanges but gives up when ctx is cancelled.
+// If that happens, changes might or might not have been saved on the server
+func (s *DocumentSession) SaveChangesWithContext(ctx context.Context) error {
+	_, err := s.saveChanges(ctx)
+	return err
+}
+
+// SaveChangesWithResult is like SaveChanges but also returns ids, change vectors
+// and last modified times the server assigned to stored documents
+func (s *DocumentSession) SaveChangesWithResult() (*SaveChangesResult, error) {
+	return s.saveChanges(context.Background())
+}
+
+func (s *DocumentSession) saveChanges(ctx context.Context) (*SaveChangesResult, error) {
+	if err := s.enter("SaveChanges"); err != nil {
+		return nil, err
+	}
+	defer s.exit()
+
 	saveChangeOperation := newBatchOperation(s.InMemoryDocumentSessionOperations)
 
 	command, err := saveChangeOperation.createRequest()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if command == nil {
-		return nil
+		return &SaveChangesResult{}, nil
 	}
 	defer func() {
 		_ = command.Close()
 	}()
-	err = s.requestExecutor.ExecuteCommand(command, s.sessionInfo)
+	err = s.requestExecutor.ExecuteCommandWithContext(ctx, command, s.sessionInfo)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	result := command.Result
 	return saveChangeOperation.setResult(result.Results)
@@ -104,6 +140,11 @@ func (s *DocumentSession) SaveChanges() error {
 
 // Exists returns true if an entity with a given id exists in the database
 func (s *DocumentSession) Exists(id string) (bool, error) {
+	if err := s.enter("Exists"); err != nil {
+		return false, err
+	}
+	defer s.exit()
+
 	if id == "" {
 		return false, newIllegalArgumentError("id cannot be empty string")
 	}
@@ -127,6 +168,11 @@ func (s *DocumentSession) Exists(id string) (bool, error) {
 
 // Refresh reloads information about a given entity in the session from the database
 func (s *DocumentSession) Refresh(entity interface{}) error {
+	if err := s.enter("Refresh"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	if err := checkValidEntityIn(entity, "entity"); err != nil {
 		return err
 	}
@@ -151,6 +197,37 @@ func (s *DocumentSession) Refresh(entity interface{}) error {
 // TODO:    protected string generateID(Object entity) {
 
 func (s *DocumentSession) executeAllPendingLazyOperations() (*ResponseTimeInformation, error) {
+	sw := time.Now()
+	if err := s.enter("ExecuteAllPendingLazyOperations"); err != nil {
+		return nil, err
+	}
+	executed, responseTimeDuration, err := s.executePendingLazyRequests()
+	s.exit()
+	if err != nil || executed == nil {
+		return responseTimeDuration, err
+	}
+
+	// callbacks run after exit so that they can use the session
+	for _, pendingLazyOperation := range executed {
+		onLazyEval := s.onEvaluateLazy[pendingLazyOperation]
+		if onLazyEval != nil {
+			err := pendingLazyOperation.getResult(onLazyEval.result)
+			if err != nil {
+				return nil, err
+			}
+			onLazyEval.fn()
+		}
+	}
+
+	dur := time.Since(sw)
+
+	responseTimeDuration.totalClientDuration = dur
+	return responseTimeDuration, nil
+}
+
+// executePendingLazyRequests sends requests of pending lazy operations
+// and returns the operations that were executed
+func (s *DocumentSession) executePendingLazyRequests() ([]ILazyOperation, *ResponseTimeInformation, error) {
 	var requests []*getRequest
 	var pendingTmp []ILazyOperation
 	for _, op := range s.pendingLazyOperations {
@@ -164,12 +241,11 @@ func (s *DocumentSession) executeAllPendingLazyOperations() (*ResponseTimeInform
 	s.pendingLazyOperations = pendingTmp
 
 	if len(requests) == 0 {
-		return &ResponseTimeInformation{}, nil
+		return nil, &ResponseTimeInformation{}, nil
 	}
 
-	sw := time.Now()
 	if err := s.incrementRequestCount(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer func() { s.pendingLazyOperations = nil }()
@@ -178,7 +254,7 @@ func (s *DocumentSession) executeAllPendingLazyOperations() (*ResponseTimeInform
 	for {
 		shouldRetry, err := s.executeLazyOperationsSingleStep(responseTimeDuration, requests)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if !shouldRetry {
 			break
@@ -186,22 +262,7 @@ func (s *DocumentSession) executeAllPendingLazyOperations() (*ResponseTimeInform
 		time.Sleep(time.Millisecond * 100)
 	}
 	responseTimeDuration.computeServerTotal()
-
-	for _, pendingLazyOperation := range s.pendingLazyOperations {
-		onLazyEval := s.onEvaluateLazy[pendingLazyOperation]
-		if onLazyEval != nil {
-			err := pendingLazyOperation.getResult(onLazyEval.result)
-			if err != nil {
-				return nil, err
-			}
-			onLazyEval.fn()
-		}
-	}
-
-	dur := time.Since(sw)
-
-	responseTimeDuration.totalClientDuration = dur
-	return responseTimeDuration, nil
+	return pendingTmp, responseTimeDuration, nil
 }
 
 func (s *DocumentSession) executeLazyOperationsSingleStep(responseTimeInformation *ResponseTimeInformation, requests []*getRequest) (bool, error) {
@@ -333,6 +394,16 @@ func checkValidLoadArg(v interface{}, argName string) error {
 // Load loads an entity with a given id and sets result to it.
 // result should be of type **<struct> or *map[string]interface{}
 func (s *DocumentSession) Load(result interface{}, id string) error {
+	return s.LoadWithContext(context.Background(), result, id)
+}
+
+// LoadWithContext is like Load but gives up when ctx is cancelled
+func (s *DocumentSession) LoadWithContext(ctx context.Context, result interface{}, id string) error {
+	if err := s.enter("Load"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	if id == "" {
 		return newIllegalArgumentError("id cannot be empty string")
 	}
@@ -349,7 +420,7 @@ func (s *DocumentSession) Load(result interface{}, id string) error {
 	}
 
 	if command != nil {
-		err := s.requestExecutor.ExecuteCommand(command, s.sessionInfo)
+		err := s.requestExecutor.ExecuteCommandWithContext(ctx, command, s.sessionInfo)
 		if err != nil {
 			return err
 		}
@@ -392,6 +463,11 @@ func checkValidLoadMultiArg(v interface{}, argName string) error {
 // LoadMulti loads multiple values with given ids into results, which should
 // be a map from string (id) to pointer to struct
 func (s *DocumentSession) LoadMulti(results interface{}, ids []string) error {
+	if err := s.enter("LoadMulti"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	if len(ids) == 0 {
 		return newIllegalArgumentError("ids cannot be empty array")
 	}
@@ -457,6 +533,11 @@ func (s *DocumentSession) loadInternalMulti(results interface{}, ids []string, i
 }
 
 func (s *DocumentSession) LoadStartingWith(results interface{}, args *StartsWithArgs) error {
+	if err := s.enter("LoadStartingWith"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	// TODO: early validation of results
 	loadStartingWithOperation := NewLoadStartingWithOperation(s.InMemoryDocumentSessionOperations)
 	if args.PageSize == 0 {
@@ -470,6 +551,11 @@ func (s *DocumentSession) LoadStartingWith(results interface{}, args *StartsWith
 }
 
 func (s *DocumentSession) LoadStartingWithIntoStream(output io.Writer, args *StartsWithArgs) error {
+	if err := s.enter("LoadStartingWithIntoStream"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	if output == nil {
 		return newIllegalArgumentError("Output cannot be null")
 	}
@@ -514,6 +600,11 @@ func (s *DocumentSession) loadStartingWithInternal(idPrefix string, operation *L
 // LoadIntoStream loads entities identified by ids and writes them (in JSON form)
 // to output
 func (s *DocumentSession) LoadIntoStream(ids []string, output io.Writer) error {
+	if err := s.enter("LoadIntoStream"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	if len(ids) == 0 {
 		return newIllegalArgumentError("Ids cannot be empty")
 	}
@@ -861,6 +952,11 @@ func (s *DocumentSession) QueryIndex(indexName string) *DocumentQuery {
 // StreamQuery starts a streaming query and returns iterator for results.
 // If streamQueryStats is provided, it'll be filled with information about query statistics.
 func (s *DocumentSession) StreamQuery(query *DocumentQuery, streamQueryStats *StreamQueryStatistics) (*StreamIterator, error) {
+	if err := s.enter("StreamQuery"); err != nil {
+		return nil, err
+	}
+	defer s.exit()
+
 	streamOperation := NewStreamOperation(s.InMemoryDocumentSessionOperations, streamQueryStats)
 	q, err := query.GetIndexQuery()
 	if err != nil {
@@ -887,6 +983,11 @@ func (s *DocumentSession) StreamQuery(query *DocumentQuery, streamQueryStats *St
 // StreamRawQuery starts a raw streaming query and returns iterator for results.
 // If streamQueryStats is provided, it'll be filled with information about query statistics.
 func (s *DocumentSession) StreamRawQuery(query *RawDocumentQuery, streamQueryStats *StreamQueryStatistics) (*StreamIterator, error) {
+	if err := s.enter("StreamRawQuery"); err != nil {
+		return nil, err
+	}
+	defer s.exit()
+
 	streamOperation := NewStreamOperation(s.InMemoryDocumentSessionOperations, streamQueryStats)
 	q, err := query.GetIndexQuery()
 	if err != nil {
@@ -913,6 +1014,11 @@ func (s *DocumentSession) StreamRawQuery(query *RawDocumentQuery, streamQuerySta
 // StreamRawQueryInto starts a raw streaming query that will write the results
 // (in JSON format) to output
 func (s *DocumentSession) StreamRawQueryInto(query *RawDocumentQuery, output io.Writer) error {
+	if err := s.enter("StreamRawQueryInto"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	streamOperation := NewStreamOperation(s.InMemoryDocumentSessionOperations, nil)
 	q, err := query.GetIndexQuery()
 	if err != nil {
@@ -934,6 +1040,11 @@ func (s *DocumentSession) StreamRawQueryInto(query *RawDocumentQuery, output io.
 // StreamQueryInto starts a streaming query that will write the results
 // (in JSON format) to output
 func (s *DocumentSession) StreamQueryInto(query *DocumentQuery, output io.Writer) error {
+	if err := s.enter("StreamQueryInto"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	streamOperation := NewStreamOperation(s.InMemoryDocumentSessionOperations, nil)
 	q, err := query.GetIndexQuery()
 	if err != nil {
@@ -996,11 +1107,21 @@ func (s *DocumentSession) createStreamResult(v interface{}, document map[string]
 	return streamResult, nil
 }
 
-// Stream starts an iteration and returns StreamIterator
+// Stream starts an iteration over documents whose id starts with args.StartsWith
+// and returns StreamIterator. Documents are read from the server one by one
+// as Next() is called, without loading the whole result set into memory.
 func (s *DocumentSession) Stream(args *StartsWithArgs) (*StreamIterator, error) {
+	if err := s.enter("Stream"); err != nil {
+		return nil, err
+	}
+	defer s.exit()
+
+	if args == nil {
+		return nil, newIllegalArgumentError("args cannot be nil")
+	}
 	streamOperation := NewStreamOperation(s.InMemoryDocumentSessionOperations, nil)
 
-	command := streamOperation.createRequest(args.StartsWith, args.Matches, args.Start, args.PageSize, "", args.StartAfter)
+	command := streamOperation.createRequest(args.StartsWith, args.Matches, args.Start, args.PageSize, args.Exclude, args.StartAfter)
 	err := s.GetRequestExecutor().ExecuteCommand(command, s.sessionInfo)
 	if err != nil {
 		return nil, err
@@ -1032,6 +1153,7 @@ func newStreamIterator(session *DocumentSession, innerIterator *yieldStreamResul
 }
 
 // Next returns next result in a streaming query.
+// Returns io.EOF when there are no more results.
 func (i *StreamIterator) Next(v interface{}) (*StreamResult, error) {
 	nextValue, err := i.innerIterator.nextJSONObject()
 	if err != nil {
@@ -38,6 +38,17 @@ func (s *DocumentSessionAttachments) GetByID(documentID string, name string) (*A
 	return res, nil
 }
 
+// GetByIDIfModified gets an attachment unless its change vector is changeVector.
+// If it wasn't modified, the result has NotModified set and no data
+func (s *DocumentSessionAttachments) GetByIDIfModified(documentID string, name string, changeVector string) (*AttachmentResult, error) {
+	operation := NewGetAttachmentIfModifiedOperation(documentID, name, changeVector)
+	err := s.session.GetOperations().Send(operation, s.sessionInfo)
+	if err != nil {
+		return nil, err
+	}
+	return operation.Command.Result, nil
+}
+
 func (s *DocumentSessionAttachments) Get(entity interface{}, name string) (*AttachmentResult, error) {
 	document := getDocumentInfoByEntity(s.documents, entity)
 	if document == nil {
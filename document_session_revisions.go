@@ -42,6 +42,47 @@ func (r *DocumentSessionRevisions) GetForPaged(results interface{}, id string, s
 	return operation.GetRevisionsFor(results)
 }
 
+// ForceRevisionCreationFor makes SaveChanges create a revision of a document
+// with its current content, even if revisions are not configured.
+// entityOrID is either a document id or an entity tracked by the session.
+// If the document is modified by SaveChanges, the revision has
+// the content from before the modification
+func (r *DocumentSessionRevisions) ForceRevisionCreationFor(entityOrID interface{}) error {
+	return r.ForceRevisionCreationForWithStrategy(entityOrID, ForceRevisionStrategyBefore)
+}
+
+// ForceRevisionCreationForWithStrategy is like ForceRevisionCreationFor
+// but with an explicit strategy
+func (r *DocumentSessionRevisions) ForceRevisionCreationForWithStrategy(entityOrID interface{}, strategy ForceRevisionStrategy) error {
+	id, ok := entityOrID.(string)
+	if !ok {
+		if err := checkValidEntityIn(entityOrID, "entityOrID"); err != nil {
+			return err
+		}
+		document := getDocumentInfoByEntity(r.session.documentsByEntity, entityOrID)
+		if document == nil {
+			return newIllegalStateError("Cannot create a revision for the requested entity because it is Not tracked by the session")
+		}
+		id = document.id
+	}
+	return r.session.forceRevisionCreationFor(id, strategy)
+}
+
+// GetCountFor returns the number of revisions of document id
+func (r *DocumentSessionRevisions) GetCountFor(id string) (int64, error) {
+	command, err := NewGetRevisionsCountCommand(id)
+	if err != nil {
+		return 0, err
+	}
+	if err = r.session.incrementRequestCount(); err != nil {
+		return 0, err
+	}
+	if err = r.requestExecutor.ExecuteCommand(command, r.sessionInfo); err != nil {
+		return 0, err
+	}
+	return command.Result, nil
+}
+
 func (r *DocumentSessionRevisions) GetMetadataFor(id string) ([]*MetadataAsDictionary, error) {
 	return r.GetMetadataForPaged(id, 0, 25)
 }
@@ -82,16 +123,16 @@ func (r *DocumentSessionRevisions) Get(result interface{}, changeVector string)
 }
 
 func (r *DocumentSessionRevisions) GetRevisions(results interface{}, changeVectors []string) error {
-	operation := NewGetRevisionOperationWithChangeVectors(r.session, changeVectors);
+	operation := NewGetRevisionOperationWithChangeVectors(r.session, changeVectors)
 
-	command, err := operation.createRequest();
+	command, err := operation.createRequest()
 	if err != nil {
 		return err
 	}
-	err = r.requestExecutor.ExecuteCommand(command, r.sessionInfo);
+	err = r.requestExecutor.ExecuteCommand(command, r.sessionInfo)
 	if err != nil {
 		return err
 	}
-	operation.setResult(command.Result);
-	return operation.GetRevisions(results);
+	operation.setResult(command.Result)
+	return operation.GetRevisions(results)
 }
@@ -211,6 +211,15 @@ func (s *DocumentStore) GetDatabase() string {
 	return s.database
 }
 
+// GetHiLoMetrics returns metrics of the default HiLo id generator, summed
+// over all databases and collections
+func (s *DocumentStore) GetHiLoMetrics() HiLoMetrics {
+	if s.multiDbHiLo == nil {
+		return HiLoMetrics{}
+	}
+	return s.multiDbHiLo.GetMetrics()
+}
+
 func (s *DocumentStore) SetDatabase(database string) {
 	s.assertNotInitialized("database")
 	s.database = database
@@ -285,7 +294,8 @@ func (s *DocumentStore) Close() {
 	}
 
 	if s.multiDbHiLo != nil {
-		s.multiDbHiLo.ReturnUnusedRange()
+		// best effort, the ranges expire on the server anyway
+		_ = s.multiDbHiLo.ReturnUnusedRange()
 	}
 
 	if s.Subscriptions() != nil {
@@ -330,24 +340,43 @@ func (s *DocumentStore) OpenSessionWithOptions(options *SessionOptions) (*Docume
 	if requestExecutor == nil {
 		requestExecutor = s.GetRequestExecutor(databaseName)
 	}
+	switch options.TransactionMode {
+	case "", TransactionModeSingleNode, TransactionModeClusterWide:
+	default:
+		return nil, newIllegalArgumentError("invalid TransactionMode '%s'", options.TransactionMode)
+	}
 	session := NewDocumentSession(databaseName, s, sessionID, requestExecutor)
+	session.transactionMode = options.TransactionMode
 	s.registerEvents(session.InMemoryDocumentSessionOperations)
 	s.afterSessionCreated(session.InMemoryDocumentSessionOperations)
 	return session, nil
 }
 
+// ExecuteIndex creates or updates an index defined by task.
+// database is optional
 func (s *DocumentStore) ExecuteIndex(task *IndexCreationTask, database string) error {
 	if err := s.assertInitialized(); err != nil {
 		return err
 	}
+	if task == nil {
+		return newIllegalArgumentError("task cannot be nil")
+	}
 	return task.Execute(s, s.conventions, database)
 }
 
+// ExecuteIndexes creates or updates indexes defined by tasks in a single request.
+// database is optional
 func (s *DocumentStore) ExecuteIndexes(tasks []*IndexCreationTask, database string) error {
 	if err := s.assertInitialized(); err != nil {
 		return err
 	}
-	indexesToAdd := indexCreationCreateIndexesToAdd(tasks, s.conventions)
+	if len(tasks) == 0 {
+		return newIllegalArgumentError("tasks cannot be empty")
+	}
+	indexesToAdd, err := indexCreationCreateIndexesToAdd(tasks, s.conventions)
+	if err != nil {
+		return err
+	}
 
 	op := NewPutIndexesOperation(indexesToAdd...)
 	if database == "" {
@@ -414,7 +443,7 @@ func (s *DocumentStore) assertValidConfiguration() error {
 	if len(s.urls) == 0 {
 		return newIllegalArgumentError("Must provide urls to NewDocumentStore")
 	}
-	return nil
+	return requestExecutorValidateUrls(s.urls, s.Certificate)
 }
 
 type RestoreCaching struct {
@@ -582,6 +611,11 @@ func (s *DocumentStore) Operations() *OperationExecutor {
 	return s.operationExecutor
 }
 
+// Smuggler returns DatabaseSmuggler for exporting and importing store's default database
+func (s *DocumentStore) Smuggler() *DatabaseSmuggler {
+	return NewDatabaseSmuggler(s, "")
+}
+
 func (s *DocumentStore) BulkInsert(database string) *BulkInsertOperation {
 	if database == "" {
 		database = s.GetDatabase()
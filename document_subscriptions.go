@@ -54,6 +54,7 @@ func (s *DocumentSubscriptions) CreateForType(clazz reflect.Type, options *Subsc
 		Name:         options.Name,
 		ChangeVector: options.ChangeVector,
 		Query:        options.Query,
+		MentorNode:   options.MentorNode,
 	}
 
 	opts := s.ensureCriteria(creationOptions, clazz, false)
@@ -70,6 +71,7 @@ func (s *DocumentSubscriptions) CreateForRevisions(clazz reflect.Type, options *
 		Name:         options.Name,
 		ChangeVector: options.ChangeVector,
 		Query:        options.Query,
+		MentorNode:   options.MentorNode,
 	}
 
 	opts := s.ensureCriteria(creationOptions, clazz, true)
@@ -132,6 +134,14 @@ func (s *DocumentSubscriptions) GetSubscriptionWorker(clazz reflect.Type, option
 // needs to acknowledge that batch has been processed. The acknowledgment is sent
 // after all documents are processed by subscription's handlers.
 func (s *DocumentSubscriptions) GetSubscriptionWorkerForRevisions(clazz reflect.Type, options *SubscriptionWorkerOptions, database string) (*SubscriptionWorker, error) {
+	if err := s.store.assertInitialized(); err != nil {
+		return nil, err
+	}
+
+	if options == nil {
+		return nil, newIllegalStateError("Cannot open a subscription if options are null")
+	}
+
 	subscription, err := NewSubscriptionWorker(clazz, options, true, s.store, database)
 	if err != nil {
 		return nil, err
@@ -168,6 +178,9 @@ func (s *DocumentSubscriptions) GetSubscriptions(start int, take int, database s
 
 // Delete deletes a subscription.
 func (s *DocumentSubscriptions) Delete(name string, database string) error {
+	if name == "" {
+		return newIllegalArgumentError("name cannot be empty")
+	}
 	if database == "" {
 		database = s.store.GetDatabase()
 	}
@@ -197,12 +210,16 @@ func (s *DocumentSubscriptions) GetSubscriptionState(subscriptionName string, da
 
 // Close closes subscriptions
 func (s *DocumentSubscriptions) Close() error {
-	if len(s.subscriptions) == 0 {
-		return nil
+	// closing a worker removes it from s.subscriptions so we can't hold the lock
+	s.mu.Lock()
+	var subscriptions []io.Closer
+	for subscription := range s.subscriptions {
+		subscriptions = append(subscriptions, subscription)
 	}
+	s.mu.Unlock()
 
 	var err error
-	for subscription := range s.subscriptions {
+	for _, subscription := range subscriptions {
 		err2 := subscription.Close()
 		if err2 != nil {
 			err = err2
@@ -213,6 +230,9 @@ func (s *DocumentSubscriptions) Close() error {
 
 // DropConnection forces server to close current client subscription connection to the server
 func (s *DocumentSubscriptions) DropConnection(name string, database string) error {
+	if name == "" {
+		return newIllegalArgumentError("name cannot be empty")
+	}
 	if database == "" {
 		database = s.store.GetDatabase()
 	}
@@ -221,3 +241,28 @@ func (s *DocumentSubscriptions) DropConnection(name string, database string) err
 	command := newDropSubscriptionConnectionCommand(name)
 	return requestExecutor.ExecuteCommand(command, nil)
 }
+
+// Enable enables a subscription that was disabled, allowing workers to connect to it again
+func (s *DocumentSubscriptions) Enable(name string, database string) error {
+	return s.toggleState(name, database, false)
+}
+
+// Disable disables a subscription. Connected workers are dropped
+// and new connections are rejected until the subscription is enabled
+func (s *DocumentSubscriptions) Disable(name string, database string) error {
+	return s.toggleState(name, database, true)
+}
+
+func (s *DocumentSubscriptions) toggleState(name string, database string, disable bool) error {
+	if name == "" {
+		return newIllegalArgumentError("name cannot be empty")
+	}
+	if database == "" {
+		database = s.store.GetDatabase()
+	}
+	operation, err := NewToggleOngoingTaskStateOperationByName(name, OngoingTaskTypeSubscription, disable)
+	if err != nil {
+		return err
+	}
+	return s.store.Maintenance().ForDatabase(database).Send(operation)
+}
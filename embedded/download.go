@@ -0,0 +1,219 @@
+package embedded
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/bzip2"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const downloadURLFormat = "https://daily-builds.s3.amazonaws.com/RavenDB-%s-%s.%s"
+
+// serverExeName is the name of the server executable inside the package
+func serverExeName() string {
+	if runtime.GOOS == "windows" {
+		return "Raven.Server.exe"
+	}
+	return "Raven.Server"
+}
+
+// downloadURL returns url of server package for a given version and platform
+func downloadURL(version, goos, goarch string) (string, error) {
+	var platform string
+	switch goos {
+	case "windows":
+		platform = "windows"
+	case "linux":
+		platform = "linux"
+	case "darwin":
+		platform = "osx"
+	default:
+		return "", fmt.Errorf("RavenDB server is not available for %s", goos)
+	}
+	switch goarch {
+	case "amd64":
+		platform += "-x64"
+	case "arm64":
+		platform += "-arm64"
+	default:
+		return "", fmt.Errorf("RavenDB server is not available for %s/%s", goos, goarch)
+	}
+	ext := "tar.bz2"
+	if goos == "windows" {
+		ext = "zip"
+	}
+	return fmt.Sprintf(downloadURLFormat, version, platform, ext), nil
+}
+
+// ensureServerDirectory returns a directory with unpacked server,
+// downloading the server if necessary
+func ensureServerDirectory(options *ServerOptions) (string, error) {
+	dir := options.ServerDirectory
+	if dir == "" {
+		if options.Version == "" {
+			return "", errors.New("either ServerDirectory or Version must be provided")
+		}
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(cacheDir, "ravendb-go-client", "RavenDB-"+options.Version)
+	}
+	if _, err := findServerExecutable(dir); err == nil {
+		return dir, nil
+	}
+
+	uri := options.DownloadURL
+	if uri == "" {
+		if options.Version == "" {
+			return "", fmt.Errorf("server not found in '%s' and Version is not provided", dir)
+		}
+		var err error
+		uri, err = downloadURL(options.Version, runtime.GOOS, runtime.GOARCH)
+		if err != nil {
+			return "", err
+		}
+	}
+	if err := downloadAndExtract(uri, dir); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// findServerExecutable looks for the server executable in dir and its Server sub-directories
+func findServerExecutable(dir string) (string, error) {
+	name := serverExeName()
+	candidates := []string{
+		filepath.Join(dir, name),
+		filepath.Join(dir, "Server", name),
+		filepath.Join(dir, "RavenDB", "Server", name),
+	}
+	for _, path := range candidates {
+		if st, err := os.Stat(path); err == nil && !st.IsDir() {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("%s not found in '%s'", name, dir)
+}
+
+func downloadAndExtract(uri string, destDir string) error {
+	tmpFile, err := ioutil.TempFile("", "ravendb-server-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	rsp, err := http.Get(uri)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading '%s' failed with status %s", uri, rsp.Status)
+	}
+	if _, err = io.Copy(tmpFile, rsp.Body); err != nil {
+		return err
+	}
+	if _, err = tmpFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if strings.HasSuffix(uri, ".zip") {
+		st, err := tmpFile.Stat()
+		if err != nil {
+			return err
+		}
+		return extractZip(tmpFile, st.Size(), destDir)
+	}
+	return extractTar(bzip2.NewReader(tmpFile), destDir)
+}
+
+// extractPath returns path of an archive entry inside destDir,
+// rejecting entries that would escape it
+func extractPath(destDir string, name string) (string, error) {
+	path := filepath.Join(destDir, filepath.FromSlash(name))
+	rel, err := filepath.Rel(destDir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid path '%s' in archive", name)
+	}
+	return path, nil
+}
+
+func writeFile(path string, r io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+func extractZip(r io.ReaderAt, size int64, destDir string) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		path, err := extractPath(destDir, f.Name)
+		if err != nil {
+			return err
+		}
+		if f.FileInfo().IsDir() {
+			if err = os.MkdirAll(path, 0755); err != nil {
+				return err
+			}
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = writeFile(path, rc, f.Mode()|0600)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractTar(r io.Reader, destDir string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		path, err := extractPath(destDir, hdr.Name)
+		if err != nil {
+			return err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(path, 0755)
+		case tar.TypeReg:
+			err = writeFile(path, tr, os.FileMode(hdr.Mode)|0600)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
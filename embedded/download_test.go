@@ -0,0 +1,79 @@
+package embedded
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadURL(t *testing.T) {
+	uri, err := downloadURL("5.4.100", "linux", "amd64")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://daily-builds.s3.amazonaws.com/RavenDB-5.4.100-linux-x64.tar.bz2", uri)
+
+	uri, err = downloadURL("5.4.100", "windows", "amd64")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://daily-builds.s3.amazonaws.com/RavenDB-5.4.100-windows-x64.zip", uri)
+
+	_, err = downloadURL("5.4.100", "plan9", "amd64")
+	assert.Error(t, err)
+}
+
+func TestExtractArchives(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ravendb-embedded-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	exePath := filepath.Join("Server", serverExeName())
+
+	{
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		w, err := zw.Create(filepath.ToSlash(exePath))
+		assert.NoError(t, err)
+		_, err = w.Write([]byte("exe"))
+		assert.NoError(t, err)
+		assert.NoError(t, zw.Close())
+
+		zipDir := filepath.Join(dir, "zip")
+		err = extractZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), zipDir)
+		assert.NoError(t, err)
+		path, err := findServerExecutable(zipDir)
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.Join(zipDir, exePath), path)
+	}
+
+	{
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		name := "RavenDB/" + filepath.ToSlash(exePath)
+		err = tw.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: 3, Typeflag: tar.TypeReg})
+		assert.NoError(t, err)
+		_, err = tw.Write([]byte("exe"))
+		assert.NoError(t, err)
+		assert.NoError(t, tw.Close())
+
+		tarDir := filepath.Join(dir, "tar")
+		err = extractTar(&buf, tarDir)
+		assert.NoError(t, err)
+		path, err := findServerExecutable(tarDir)
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.Join(tarDir, "RavenDB", exePath), path)
+	}
+
+	{
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		err = tw.WriteHeader(&tar.Header{Name: "../outside", Mode: 0644, Size: 0, Typeflag: tar.TypeReg})
+		assert.NoError(t, err)
+		assert.NoError(t, tw.Close())
+		err = extractTar(&buf, filepath.Join(dir, "evil"))
+		assert.Error(t, err)
+	}
+}
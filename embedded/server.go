@@ -0,0 +1,251 @@
+/*
+Package embedded runs a local RavenDB server process, downloading it if needed,
+and returns DocumentStore instances connected to it.
+
+It's meant for local development and integration tests:
+
+	server, err := embedded.Start(&embedded.ServerOptions{Version: "5.4.100"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer server.Close()
+
+	store, err := server.GetDocumentStore("Demo")
+*/
+package embedded
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+)
+
+const (
+	defaultServerURL            = "http://127.0.0.1:0"
+	defaultMaxServerStartupTime = time.Minute
+
+	// the server prints this line once it accepts connections
+	serverAvailablePrefix = "Server available on: "
+)
+
+// ServerOptions describes how to obtain and start a RavenDB server
+type ServerOptions struct {
+	// ServerDirectory is a directory with unpacked RavenDB server.
+	// If it doesn't contain the server executable, the server is downloaded
+	// and unpacked into it. Defaults to a per-version directory
+	// inside os.UserCacheDir()
+	ServerDirectory string
+	// Version of the server to download, e.g. "5.4.100".
+	// Not needed if ServerDirectory already contains the server
+	Version string
+	// DownloadURL overrides the url the server package is downloaded from
+	DownloadURL string
+	// DataDirectory is where the server keeps its data.
+	// If empty, the server runs in memory
+	DataDirectory string
+	// ServerURL is the url the server listens on. Defaults to
+	// http://127.0.0.1:0 i.e. a random free port
+	ServerURL string
+	// Args are additional command-line arguments passed to the server
+	Args []string
+	// MaxServerStartupTime limits how long we wait for the server to start.
+	// Defaults to 1 minute
+	MaxServerStartupTime time.Duration
+	// if not nil, server's output is copied here
+	Output io.Writer
+}
+
+// Server represents a running RavenDB server process
+type Server struct {
+	cmd *exec.Cmd
+	url string
+
+	// closed when the server process exits
+	exited chan struct{}
+
+	mu     sync.Mutex
+	stores []*ravendb.DocumentStore
+	closed bool
+}
+
+// Start starts a RavenDB server, downloading it first if needed
+func Start(options *ServerOptions) (*Server, error) {
+	if options == nil {
+		options = &ServerOptions{}
+	}
+	dir, err := ensureServerDirectory(options)
+	if err != nil {
+		return nil, err
+	}
+	exePath, err := findServerExecutable(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(exePath, serverArgs(options)...)
+	cmd.Dir = filepath.Dir(exePath)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if options.Output != nil {
+		cmd.Stderr = options.Output
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		cmd:    cmd,
+		exited: make(chan struct{}),
+	}
+
+	chURL := make(chan string, 1)
+	go func() {
+		s.readOutput(stdout, options.Output, chURL)
+		_ = cmd.Wait()
+		close(s.exited)
+	}()
+
+	timeout := options.MaxServerStartupTime
+	if timeout == 0 {
+		timeout = defaultMaxServerStartupTime
+	}
+	select {
+	case s.url = <-chURL:
+		return s, nil
+	case <-s.exited:
+		return nil, errors.New("RavenDB server exited before it started listening")
+	case <-time.After(timeout):
+		s.kill()
+		return nil, fmt.Errorf("RavenDB server didn't start in %s", timeout)
+	}
+}
+
+func serverArgs(options *ServerOptions) []string {
+	serverURL := options.ServerURL
+	if serverURL == "" {
+		serverURL = defaultServerURL
+	}
+	args := []string{
+		"--ServerUrl=" + serverURL,
+		"--License.Eula.Accepted=true",
+		"--Setup.Mode=None",
+		fmt.Sprintf("--Embedded.ParentProcessId=%d", os.Getpid()),
+	}
+	if options.DataDirectory != "" {
+		args = append(args, "--DataDir="+options.DataDirectory)
+	} else {
+		args = append(args, "--RunInMemory=true")
+	}
+	return append(args, options.Args...)
+}
+
+// readOutput sends the server url to chURL once it shows up in the output
+// and then keeps draining the output so that the server never blocks on it
+func (s *Server) readOutput(r io.Reader, output io.Writer, chURL chan string) {
+	if output == nil {
+		output = ioutil.Discard
+	}
+	scanner := bufio.NewScanner(r)
+	found := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		fmt.Fprintln(output, line)
+		if !found && strings.HasPrefix(line, serverAvailablePrefix) {
+			found = true
+			chURL <- strings.TrimSpace(strings.TrimPrefix(line, serverAvailablePrefix))
+		}
+	}
+	// scanner stops on errors like a too long line, keep draining
+	_, _ = io.Copy(output, r)
+}
+
+// URL returns the url of the running server
+func (s *Server) URL() string {
+	return s.url
+}
+
+// GetDocumentStore returns an initialized DocumentStore for a given database,
+// creating the database if it doesn't exist.
+// The store is closed when the server is closed
+func (s *Server) GetDocumentStore(database string) (*ravendb.DocumentStore, error) {
+	if database == "" {
+		return nil, errors.New("database cannot be empty")
+	}
+	s.mu.Lock()
+	closed := s.closed
+	s.mu.Unlock()
+	if closed {
+		return nil, errors.New("server has been closed")
+	}
+
+	store := ravendb.NewDocumentStore([]string{s.url}, database)
+	if err := store.Initialize(); err != nil {
+		return nil, err
+	}
+	if err := ensureDatabaseExists(store, database); err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.stores = append(s.stores, store)
+	s.mu.Unlock()
+	return store, nil
+}
+
+func ensureDatabaseExists(store *ravendb.DocumentStore, database string) error {
+	getRecord := ravendb.NewGetDatabaseRecordOperation(database)
+	if err := store.Maintenance().Server().Send(getRecord); err != nil {
+		return err
+	}
+	if getRecord.Command.Result != nil {
+		return nil
+	}
+
+	record := ravendb.NewDatabaseRecord()
+	record.DatabaseName = database
+	return store.Maintenance().Server().Send(ravendb.NewCreateDatabaseOperation(record, 1))
+}
+
+// Close closes all stores returned by GetDocumentStore and stops the server
+func (s *Server) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	stores := s.stores
+	s.stores = nil
+	s.mu.Unlock()
+
+	for _, store := range stores {
+		store.Close()
+	}
+	return s.kill()
+}
+
+func (s *Server) kill() error {
+	select {
+	case <-s.exited:
+		return nil
+	default:
+	}
+	if err := s.cmd.Process.Kill(); err != nil {
+		return err
+	}
+	<-s.exited
+	return nil
+}
@@ -6,27 +6,49 @@ import (
 
 var _ IVoidMaintenanceOperation = &EnableIndexOperation{}
 
+// EnableIndexOperation enables an index.
+// By default it only affects the node that receives the request,
+// use NewEnableIndexOperationClusterWide to change it on all nodes
 type EnableIndexOperation struct {
-	indexName string
+	indexName   string
+	clusterWide bool
 
 	Command *EnableIndexCommand
 }
 
+// NewEnableIndexOperation returns EnableIndexOperation
+//
+// Deprecated: use operations.NewEnableIndexOperation.
 func NewEnableIndexOperation(indexName string) (*EnableIndexOperation, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 	return &EnableIndexOperation{
 		indexName: indexName,
 	}, nil
 }
 
+// NewEnableIndexOperationClusterWide returns EnableIndexOperation that enables
+// an index on all nodes of the cluster. The change is persisted in
+// the database record
+//
+// Deprecated: use operations.NewEnableIndexOperationClusterWide.
+func NewEnableIndexOperationClusterWide(indexName string) (*EnableIndexOperation, error) {
+	res, err := NewEnableIndexOperation(indexName)
+	if err != nil {
+		return nil, err
+	}
+	res.clusterWide = true
+	return res, nil
+}
+
 func (o *EnableIndexOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
 	var err error
 	o.Command, err = NewEnableIndexCommand(o.indexName)
 	if err != nil {
 		return nil, err
 	}
+	o.Command.clusterWide = o.clusterWide
 	return o.Command, nil
 }
 
@@ -37,12 +59,13 @@ var (
 type EnableIndexCommand struct {
 	RavenCommandBase
 
-	indexName string
+	indexName   string
+	clusterWide bool
 }
 
 func NewEnableIndexCommand(indexName string) (*EnableIndexCommand, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 
 	cmd := &EnableIndexCommand{
@@ -56,6 +79,9 @@ func NewEnableIndexCommand(indexName string) (*EnableIndexCommand, error) {
 
 func (c *EnableIndexCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	url := node.URL + "/databases/" + node.Database + "/admin/indexes/enable?name=" + urlUtilsEscapeDataString(c.indexName)
+	if c.clusterWide {
+		url += "&clusterWide=true"
+	}
 
 	return NewHttpPost(url, nil)
 }
@@ -0,0 +1,74 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumRoundTrip(t *testing.T) {
+	type enums struct {
+		Status   OperationStatus
+		Backup   BackupType
+		State    IndexState
+		Priority IndexPriority
+		Strategy SubscriptionOpeningStrategy
+	}
+	v := enums{
+		Status:   OperationStatusFaulted,
+		Backup:   BackupTypeSnapshot,
+		State:    IndexStateIdle,
+		Priority: IndexPriorityHigh,
+		Strategy: SubscriptionOpeningStrategyTakeOver,
+	}
+	d, err := jsonMarshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Status":"Faulted","Backup":"Snapshot","State":"Idle","Priority":"High","Strategy":"TakeOver"}`, string(d))
+
+	var got enums
+	assert.NoError(t, jsonUnmarshal(d, &got))
+	assert.Equal(t, v, got)
+
+	// not set
+	got = enums{}
+	assert.NoError(t, jsonUnmarshal([]byte(`{"Status":null,"Backup":""}`), &got))
+	assert.Equal(t, enums{}, got)
+	d, err = jsonMarshal(got)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Status":"","Backup":"","State":"","Priority":"","Strategy":""}`, string(d))
+}
+
+func TestEnumDecodesUnknownValues(t *testing.T) {
+	// values added in newer servers must not fail decoding
+	var stats struct {
+		Status   OperationStatus
+		Backup   BackupType
+		State    IndexState
+		Priority IndexPriority
+		Strategy SubscriptionOpeningStrategy
+		TaskType OngoingTaskType
+	}
+	d := `{"Status":"Paused","Backup":"Incremental","State":"Stale","Priority":"Highest","Strategy":"OpenIfFresh","TaskType":"QueueEtl"}`
+	assert.NoError(t, jsonUnmarshal([]byte(d), &stats))
+	assert.Equal(t, OperationStatus("Paused"), stats.Status)
+	assert.Equal(t, IndexPriority("Highest"), stats.Priority)
+	assert.Equal(t, OngoingTaskType("QueueEtl"), stats.TaskType)
+
+	var priority IndexPriority
+	assert.Error(t, jsonUnmarshal([]byte(`1`), &priority))
+}
+
+func TestEnumRejectsInvalidValues(t *testing.T) {
+	_, err := jsonMarshal(&IndexDefinition{Priority: "Urgent"})
+	assert.Error(t, err)
+	_, err = jsonMarshal(SubscriptionWorkerOptions{Strategy: "Any"})
+	assert.Error(t, err)
+	_, err = jsonMarshal(BackupType("backup"))
+	assert.Error(t, err)
+	_, err = jsonMarshal(&CounterOperation{Type: "Increase", CounterName: "likes"})
+	assert.Error(t, err)
+	_, err = jsonMarshal(TimeValue{Value: 1, Unit: "Day"})
+	assert.Error(t, err)
+	_, err = jsonMarshal(&BackupEncryptionSettings{EncryptionMode: "Provided"})
+	assert.Error(t, err)
+}
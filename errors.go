@@ -3,6 +3,7 @@ package ravendb
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type CancellationError struct {
@@ -27,6 +28,12 @@ func (e *errorBase) WrappedError() error {
 	return e.wrapped
 }
 
+// Unwrap returns the wrapped error so that errors.Is and errors.As
+// can see the cause
+func (e *errorBase) Unwrap() error {
+	return e.wrapped
+}
+
 type iWrappedError interface {
 	WrappedError() error
 }
@@ -114,6 +121,8 @@ func newNotImplementedError(format string, args ...interface{}) *NotImplementedE
 // AllTopologyNodesDownError represents "all topology nodes are down" error
 type AllTopologyNodesDownError struct {
 	errorBase
+	// FailedNodes maps nodes we tried to the errors they failed with
+	FailedNodes map[*ServerNode]error
 }
 
 func newAllTopologyNodesDownError(format string, args ...interface{}) *AllTopologyNodesDownError {
@@ -122,6 +131,17 @@ func newAllTopologyNodesDownError(format string, args ...interface{}) *AllTopolo
 	return res
 }
 
+// RequestedNodeUnavailableError represents "requested node is not in the topology" error
+type RequestedNodeUnavailableError struct {
+	errorBase
+}
+
+func newRequestedNodeUnavailableError(format string, args ...interface{}) *RequestedNodeUnavailableError {
+	res := &RequestedNodeUnavailableError{}
+	res.setErrorf(format, args...)
+	return res
+}
+
 // OperationCancelledError represents "operation cancelled" error
 type OperationCancelledError struct {
 	errorBase
@@ -187,6 +207,22 @@ func newConcurrencyError(format string, args ...interface{}) *ConcurrencyError {
 	return res
 }
 
+// ConcurrentSessionUsageError is returned when a session is used from
+// multiple goroutines at the same time. Sessions are not safe for concurrent use
+type ConcurrentSessionUsageError struct {
+	RavenError
+	// Operation is the session method that detected concurrent usage
+	Operation string
+}
+
+func newConcurrentSessionUsageError(operation string) *ConcurrentSessionUsageError {
+	res := &ConcurrentSessionUsageError{
+		Operation: operation,
+	}
+	res.setErrorf("%s was called while another operation is running on the same session. Session cannot be used concurrently from multiple goroutines", operation)
+	return res
+}
+
 // NonUniqueObjectError represents non unique object error
 type NonUniqueObjectError struct {
 	RavenError
@@ -223,6 +259,37 @@ func NewTimeoutError(format string, args ...interface{}) *TimeoutError {
 	return res
 }
 
+// ServerBusyError is returned when the server responded with 429 (Too Many Requests)
+// or 503 (Service Unavailable) with Retry-After header and the request
+// couldn't be retried. RetryAfter is the wait suggested by the server.
+type ServerBusyError struct {
+	RavenError
+	RetryAfter time.Duration
+}
+
+func newServerBusyError(retryAfter time.Duration, format string, args ...interface{}) *ServerBusyError {
+	res := &ServerBusyError{
+		RetryAfter: retryAfter,
+	}
+	res.setErrorf(format, args...)
+	return res
+}
+
+// LargeDocumentError is returned by SaveChanges when a document is larger than
+// DocumentConventions.LargeDocumentSize and OnLargeDocument is not set
+type LargeDocumentError struct {
+	RavenError
+	Document *LargeDocumentInfo
+}
+
+func newLargeDocumentError(info *LargeDocumentInfo) *LargeDocumentError {
+	res := &LargeDocumentError{
+		Document: info,
+	}
+	res.setErrorf("Document '%s' from collection '%s' is %d bytes which exceeds the limit of %d bytes", info.ID, info.Collection, info.Size, info.MaxSize)
+	return res
+}
+
 // IndexDoesNotExistError represents "index doesn't exist" error
 type IndexDoesNotExistError struct {
 	RavenError
@@ -273,6 +340,12 @@ type SubscriberErrorError struct {
 	SubscriptionError
 }
 
+func newSubscriberErrorError(format string, args ...interface{}) *SubscriberErrorError {
+	res := &SubscriberErrorError{}
+	res.setErrorf(format, args...)
+	return res
+}
+
 // SubscriptionChangeVectorUpdateConcurrencyError represents an error about
 // subscription change vector update concurrency
 type SubscriptionChangeVectorUpdateConcurrencyError struct {
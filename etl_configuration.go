@@ -0,0 +1,172 @@
+package ravendb
+
+// EtlType describes the kind of ETL destination
+type EtlType string
+
+const (
+	EtlTypeRaven EtlType = "Raven"
+	EtlTypeSQL   EtlType = "Sql"
+	EtlTypeOlap  EtlType = "Olap"
+)
+
+var etlTypeValues = []string{string(EtlTypeRaven), string(EtlTypeSQL), string(EtlTypeOlap)}
+
+// MarshalJSON returns an error if EtlType is not one of the defined values
+func (e EtlType) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(e), "EtlType", etlTypeValues)
+}
+
+// Transformation describes a transform script applied to documents of
+// given collections before they're loaded to the ETL destination
+type Transformation struct {
+	Name     string `json:"Name"`
+	Disabled bool   `json:"Disabled"`
+	// Collections whose documents are transformed
+	Collections []string `json:"Collections"`
+	// if true, documents of all collections are transformed.
+	// Collections must be empty in that case
+	ApplyToAllDocuments bool   `json:"ApplyToAllDocuments"`
+	Script              string `json:"Script"`
+}
+
+func (t *Transformation) validate() error {
+	if t.Name == "" {
+		return newIllegalArgumentError("Transformation Name cannot be empty")
+	}
+	if t.ApplyToAllDocuments {
+		if len(t.Collections) > 0 {
+			return newIllegalArgumentError("Collections cannot be specified when ApplyToAllDocuments is set in transformation '%s'", t.Name)
+		}
+	} else if len(t.Collections) == 0 {
+		return newIllegalArgumentError("Collections cannot be empty in transformation '%s'", t.Name)
+	}
+	return nil
+}
+
+// EtlConfiguration describes settings common to all ETL tasks
+type EtlConfiguration struct {
+	TaskID     int64  `json:"TaskId,omitempty"`
+	Name       string `json:"Name"`
+	MentorNode string `json:"MentorNode,omitempty"`
+	// ConnectionStringName is a name of the connection string
+	// describing the destination
+	ConnectionStringName          string            `json:"ConnectionStringName"`
+	Transforms                    []*Transformation `json:"Transforms"`
+	Disabled                      bool              `json:"Disabled"`
+	AllowEtlOnNonEncryptedChannel bool              `json:"AllowEtlOnNonEncryptedChannel"`
+	EtlType                       EtlType           `json:"EtlType"`
+}
+
+// IEtlConfiguration is implemented by RavenEtlConfiguration,
+// SqlEtlConfiguration and OlapEtlConfiguration
+type IEtlConfiguration interface {
+	getEtlConfiguration() *EtlConfiguration
+	getEtlType() EtlType
+}
+
+func (c *EtlConfiguration) getEtlConfiguration() *EtlConfiguration {
+	return c
+}
+
+func (c *EtlConfiguration) validate() error {
+	if c.Name == "" {
+		return newIllegalArgumentError("Name cannot be empty")
+	}
+	if c.ConnectionStringName == "" {
+		return newIllegalArgumentError("ConnectionStringName cannot be empty")
+	}
+	if len(c.Transforms) == 0 {
+		return newIllegalArgumentError("Transforms cannot be empty")
+	}
+	for _, transform := range c.Transforms {
+		if transform == nil {
+			return newIllegalArgumentError("Transforms cannot contain nil")
+		}
+		if err := transform.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateEtlConfiguration validates configuration and sets its EtlType
+func validateEtlConfiguration(configuration IEtlConfiguration) error {
+	if configuration == nil {
+		return newIllegalArgumentError("configuration cannot be nil")
+	}
+	c := configuration.getEtlConfiguration()
+	if err := c.validate(); err != nil {
+		return err
+	}
+	c.EtlType = configuration.getEtlType()
+	return nil
+}
+
+// RavenEtlConfiguration describes ETL to another RavenDB database
+type RavenEtlConfiguration struct {
+	EtlConfiguration
+	// LoadRequestTimeoutInSec is a timeout of loading a batch
+	// to the destination, server's default if nil
+	LoadRequestTimeoutInSec *int `json:"LoadRequestTimeoutInSec"`
+}
+
+func (c *RavenEtlConfiguration) getEtlType() EtlType {
+	return EtlTypeRaven
+}
+
+// SqlEtlTable describes a relational table loaded by SQL ETL
+type SqlEtlTable struct {
+	TableName        string `json:"TableName"`
+	DocumentIDColumn string `json:"DocumentIdColumn"`
+	// if true, rows are only inserted and never deleted
+	InsertOnlyMode bool `json:"InsertOnlyMode"`
+}
+
+// SqlEtlConfiguration describes ETL to a relational database
+type SqlEtlConfiguration struct {
+	EtlConfiguration
+	ParameterizeDeletes bool           `json:"ParameterizeDeletes"`
+	ForceQueryRecompile bool           `json:"ForceQueryRecompile"`
+	QuoteTables         bool           `json:"QuoteTables"`
+	CommandTimeout      *int           `json:"CommandTimeout"`
+	SqlTables           []*SqlEtlTable `json:"SqlTables"`
+}
+
+func (c *SqlEtlConfiguration) getEtlType() EtlType {
+	return EtlTypeSQL
+}
+
+// OlapEtlFileFormat describes format of files created by OLAP ETL
+type OlapEtlFileFormat string
+
+const (
+	OlapEtlFileFormatParquet OlapEtlFileFormat = "Parquet"
+)
+
+var olapEtlFileFormatValues = []string{string(OlapEtlFileFormatParquet)}
+
+// MarshalJSON returns an error if OlapEtlFileFormat is not one of the defined values
+func (o OlapEtlFileFormat) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(o), "OlapEtlFileFormat", olapEtlFileFormatValues)
+}
+
+// OlapEtlTable describes a table created by OLAP ETL
+type OlapEtlTable struct {
+	TableName        string `json:"TableName"`
+	DocumentIDColumn string `json:"DocumentIdColumn"`
+}
+
+// OlapEtlConfiguration describes ETL to files for analytics
+// (local or cloud storage)
+type OlapEtlConfiguration struct {
+	EtlConfiguration
+	// RunFrequency is a cron expression, e.g. "0 * * * *"
+	RunFrequency         string            `json:"RunFrequency"`
+	Format               OlapEtlFileFormat `json:"Format"`
+	CustomPartitionValue string            `json:"CustomPartitionValue,omitempty"`
+	OlapTables           []*OlapEtlTable   `json:"OlapTables"`
+}
+
+func (c *OlapEtlConfiguration) getEtlType() EtlType {
+	return EtlTypeOlap
+}
@@ -0,0 +1,99 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEtlConfigurationValidation(t *testing.T) {
+	_, err := NewAddEtlOperation(nil)
+	assert.Error(t, err)
+
+	configuration := &RavenEtlConfiguration{}
+	configuration.Name = "etl"
+	_, err = NewAddEtlOperation(configuration)
+	assert.Error(t, err)
+
+	configuration.ConnectionStringName = "cs"
+	_, err = NewAddEtlOperation(configuration)
+	assert.Error(t, err)
+
+	transform := &Transformation{
+		Name:   "users",
+		Script: "loadToUsers(this);",
+	}
+	configuration.Transforms = []*Transformation{transform}
+	_, err = NewAddEtlOperation(configuration)
+	assert.Error(t, err)
+
+	transform.ApplyToAllDocuments = true
+	transform.Collections = []string{"Users"}
+	_, err = NewAddEtlOperation(configuration)
+	assert.Error(t, err)
+
+	transform.ApplyToAllDocuments = false
+	_, err = NewAddEtlOperation(configuration)
+	assert.NoError(t, err)
+	assert.Equal(t, EtlTypeRaven, configuration.EtlType)
+
+	_, err = NewUpdateEtlOperation(0, configuration)
+	assert.Error(t, err)
+}
+
+func TestSqlEtlConfigurationJSON(t *testing.T) {
+	configuration := &SqlEtlConfiguration{
+		SqlTables: []*SqlEtlTable{
+			{TableName: "Orders", DocumentIDColumn: "Id"},
+		},
+	}
+	configuration.Name = "sql"
+	configuration.ConnectionStringName = "cs"
+	configuration.Transforms = []*Transformation{
+		{Name: "orders", Collections: []string{"Orders"}, Script: "loadToOrders(this);"},
+	}
+	op, err := NewUpdateEtlOperation(5, configuration)
+	assert.NoError(t, err)
+	cmd, err := op.GetCommand(NewDocumentConventions())
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/admin/etl?id=5", req.URL.String())
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	var m map[string]interface{}
+	assert.NoError(t, jsonUnmarshal(body, &m))
+	assert.Equal(t, string(EtlTypeSQL), m["EtlType"])
+	assert.Equal(t, "cs", m["ConnectionStringName"])
+	tables := m["SqlTables"].([]interface{})
+	assert.Equal(t, "Id", tables[0].(map[string]interface{})["DocumentIdColumn"])
+}
+
+func TestOlapConnectionStringJSON(t *testing.T) {
+	connectionString := NewOlapConnectionString("olap")
+	connectionString.LocalSettings = &LocalSettings{FolderPath: "/tmp/olap"}
+	op := NewPutConnectionStringOperation(connectionString)
+	cmd, err := op.GetCommand(NewDocumentConventions())
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.NoError(t, err)
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	var m map[string]interface{}
+	assert.NoError(t, jsonUnmarshal(body, &m))
+	assert.Equal(t, "olap", m["Name"])
+	assert.Equal(t, ConnectionStringTypeOlap, m["Type"])
+	assert.Equal(t, "/tmp/olap", m["LocalSettings"].(map[string]interface{})["FolderPath"])
+	_, hasS3 := m["S3Settings"]
+	assert.False(t, hasS3)
+
+	getCmd := NewGetConnectionStringsCommand("olap", ConnectionStringTypeOlap)
+	response := `{"RavenConnectionStrings": {}, "SqlConnectionStrings": {}, "OlapConnectionStrings": {"olap": {"Name": "olap", "Type": "Olap", "LocalSettings": {"FolderPath": "/tmp/olap"}}}}`
+	assert.NoError(t, getCmd.SetResponse([]byte(response), false))
+	olap := getCmd.Result.OlapConnectionStrings["olap"]
+	if assert.NotNil(t, olap) {
+		assert.Equal(t, ConnectionStringTypeOlap, olap.Type)
+		assert.Equal(t, "/tmp/olap", olap.LocalSettings.FolderPath)
+	}
+}
@@ -1,12 +1,21 @@
 package ravendb
 
+import "encoding/json"
+
 // ExternalReplication describes external replication
 type ExternalReplication struct {
 	ReplicationNode
 	TaskID               int64  `json:"TaskId"`
 	Name                 string `json:"Name"`
 	ConnectionStringName string `json:"ConnectionStringName"`
-	MentorName           string `json:"MentorName"`
+	// MentorNode is a tag of the node responsible for the task,
+	// chosen by the server if empty
+	MentorNode string `json:"MentorNode,omitempty"`
+	// Deprecated: use MentorNode. MentorName is only sent to the server
+	// when MentorNode is empty.
+	MentorName string `json:"-"`
+	// DelayReplicationFor delays replicating changes to the destination
+	DelayReplicationFor Duration `json:"DelayReplicationFor"`
 }
 
 // NewExternalReplication creates ExternalReplication
@@ -18,3 +27,25 @@ func NewExternalReplication(database string, connectionStringName string) *Exter
 		ConnectionStringName: connectionStringName,
 	}
 }
+
+// MarshalJSON sends deprecated MentorName as MentorNode if MentorNode is not set
+func (r ExternalReplication) MarshalJSON() ([]byte, error) {
+	type externalReplication ExternalReplication
+	v := externalReplication(r)
+	if v.MentorNode == "" {
+		v.MentorNode = v.MentorName
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON keeps deprecated MentorName in sync with MentorNode
+func (r *ExternalReplication) UnmarshalJSON(data []byte) error {
+	type externalReplication ExternalReplication
+	var v externalReplication
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*r = ExternalReplication(v)
+	r.MentorName = r.MentorNode
+	return nil
+}
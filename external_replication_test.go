@@ -0,0 +1,34 @@
+package ravendb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExternalReplicationMentorName(t *testing.T) {
+	replication := NewExternalReplication("db", "cs")
+	replication.MentorName = "A"
+	data, err := json.Marshal(replication)
+	assert.NoError(t, err)
+	var m map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, "A", m["MentorNode"])
+	assert.Equal(t, "db", m["Database"])
+	_, ok := m["MentorName"]
+	assert.False(t, ok)
+
+	replication.MentorNode = "B"
+	data, err = json.Marshal(replication)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, "B", m["MentorNode"])
+
+	var decoded ExternalReplication
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "B", decoded.MentorNode)
+	assert.Equal(t, "B", decoded.MentorName)
+	assert.Equal(t, "db", decoded.Database)
+	assert.Equal(t, "cs", decoded.ConnectionStringName)
+}
@@ -92,6 +92,19 @@ func (b *FacetBuilder) AverageOn(path string) *FacetBuilder {
 	return b
 }
 
+// buildFacet calls builder on a new FacetBuilder and returns the resulting facet
+func buildFacet(builder func(*FacetBuilder)) (FacetBase, error) {
+	if builder == nil {
+		return nil, newIllegalArgumentError("builder cannot be nil")
+	}
+	b := NewFacetBuilder()
+	builder(b)
+	if b._default == nil && b._range == nil {
+		return nil, newIllegalStateError("builder must call ByField(), ByRanges() or AllResults()")
+	}
+	return b.GetFacet(), nil
+}
+
 func (b *FacetBuilder) GetFacet() FacetBase {
 	if b._default != nil {
 		return b._default
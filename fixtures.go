@@ -0,0 +1,150 @@
+package ravendb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FixturesOptions describes how LoadFixtures stores documents
+type FixturesOptions struct {
+	// Database to load the documents into. Defaults to store's database
+	Database string
+	// IDField is a field holding the id of a document. If empty or missing
+	// in a document, the id is taken from @metadata.@id or, for files with
+	// a single document, derived from the file path relative to the
+	// fixtures directory e.g. users/1.json becomes users/1
+	IDField string
+	// Collection overrides @collection of all loaded documents
+	Collection string
+}
+
+// LoadFixtures stores documents from .json and .ndjson (or .jsonl) files
+// in dir and its sub-directories using bulk insert. A .json file contains
+// either a single document or an array of documents, a .ndjson file contains
+// a document per line. Returns the number of stored documents
+func LoadFixtures(store *DocumentStore, dir string, options *FixturesOptions) (int, error) {
+	if store == nil {
+		return 0, newIllegalArgumentError("store cannot be nil")
+	}
+	if options == nil {
+		options = &FixturesOptions{}
+	}
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && isFixtureFile(path) {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	// predictable order makes failures reproducible
+	sort.Strings(files)
+
+	bulkInsert := store.BulkInsert(options.Database)
+	n := 0
+	for _, path := range files {
+		docs, err := readFixtureFile(path)
+		if err != nil {
+			_ = bulkInsert.Abort()
+			return n, err
+		}
+		for _, doc := range docs {
+			id, err := fixtureDocumentID(dir, path, doc, len(docs), options)
+			if err != nil {
+				_ = bulkInsert.Abort()
+				return n, err
+			}
+			metadata := &MetadataAsDictionary{}
+			if options.Collection != "" {
+				metadata.Put(MetadataCollection, options.Collection)
+			}
+			if err = bulkInsert.StoreWithID(doc, id, metadata); err != nil {
+				_ = bulkInsert.Abort()
+				return n, err
+			}
+			n++
+		}
+	}
+	return n, bulkInsert.Close()
+}
+
+func isFixtureFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".ndjson", ".jsonl":
+		return true
+	}
+	return false
+}
+
+func readFixtureFile(path string) ([]map[string]interface{}, error) {
+	d, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		d = bytes.TrimSpace(d)
+		if len(d) > 0 && d[0] == '[' {
+			var docs []map[string]interface{}
+			if err = json.Unmarshal(d, &docs); err != nil {
+				return nil, newIllegalArgumentError("Invalid fixture file '%s': %s", path, err)
+			}
+			return docs, nil
+		}
+		var doc map[string]interface{}
+		if err = json.Unmarshal(d, &doc); err != nil {
+			return nil, newIllegalArgumentError("Invalid fixture file '%s': %s", path, err)
+		}
+		return []map[string]interface{}{doc}, nil
+	}
+
+	var docs []map[string]interface{}
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	scanner.Buffer(nil, len(d)+1)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var doc map[string]interface{}
+		if err = json.Unmarshal(line, &doc); err != nil {
+			return nil, newIllegalArgumentError("Invalid fixture file '%s', line %d: %s", path, lineNo, err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, scanner.Err()
+}
+
+func fixtureDocumentID(dir string, path string, doc map[string]interface{}, nDocs int, options *FixturesOptions) (string, error) {
+	if options.IDField != "" {
+		if id, ok := doc[options.IDField].(string); ok && id != "" {
+			return id, nil
+		}
+	}
+	if metadata, ok := doc[MetadataKey].(map[string]interface{}); ok {
+		if id, ok := metadata[MetadataID].(string); ok && id != "" {
+			return id, nil
+		}
+	}
+	if nDocs == 1 && strings.ToLower(filepath.Ext(path)) == ".json" {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return "", err
+		}
+		rel = strings.TrimSuffix(rel, filepath.Ext(rel))
+		return filepath.ToSlash(rel), nil
+	}
+	return "", newIllegalArgumentError("Cannot determine id of a document in fixture file '%s'", path)
+}
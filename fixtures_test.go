@@ -0,0 +1,57 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFixture(t *testing.T, dir string, name string, s string) string {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, ioutil.WriteFile(path, []byte(s), 0644))
+	return path
+}
+
+func TestFixtureDocuments(t *testing.T) {
+	dir := t.TempDir()
+	options := &FixturesOptions{IDField: "key"}
+
+	path := writeFixture(t, dir, "users/1.json", `{"name": "John"}`)
+	docs, err := readFixtureFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(docs))
+	id, err := fixtureDocumentID(dir, path, docs[0], len(docs), options)
+	assert.NoError(t, err)
+	assert.Equal(t, "users/1", id)
+
+	path = writeFixture(t, dir, "products.json", `[{"key": "products/1"}, {"@metadata": {"@id": "products/2"}}]`)
+	docs, err = readFixtureFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(docs))
+	id, err = fixtureDocumentID(dir, path, docs[0], len(docs), options)
+	assert.NoError(t, err)
+	assert.Equal(t, "products/1", id)
+	id, err = fixtureDocumentID(dir, path, docs[1], len(docs), options)
+	assert.NoError(t, err)
+	assert.Equal(t, "products/2", id)
+
+	path = writeFixture(t, dir, "orders.ndjson", "{\"key\": \"orders/1\"}\n\n{\"name\": \"no id\"}\n")
+	docs, err = readFixtureFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(docs))
+	// documents in multi-document files need an explicit id
+	_, err = fixtureDocumentID(dir, path, docs[1], len(docs), options)
+	assert.Error(t, err)
+
+	path = writeFixture(t, dir, "broken.ndjson", "{\"key\": \"orders/1\"}\n{")
+	_, err = readFixtureFile(path)
+	assert.Error(t, err)
+
+	assert.True(t, isFixtureFile("a/b.JSONL"))
+	assert.False(t, isFixtureFile("a/readme.md"))
+}
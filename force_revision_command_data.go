@@ -0,0 +1,41 @@
+package ravendb
+
+// ForceRevisionStrategy describes if SaveChanges creates a revision of a document
+type ForceRevisionStrategy string
+
+const (
+	// ForceRevisionStrategyNone doesn't force creation of a revision
+	ForceRevisionStrategyNone ForceRevisionStrategy = "None"
+	// ForceRevisionStrategyBefore creates a revision of the document as it was
+	// before changes made by SaveChanges, if there's no such revision yet
+	ForceRevisionStrategyBefore ForceRevisionStrategy = "Before"
+)
+
+var forceRevisionStrategyValues = []string{string(ForceRevisionStrategyNone), string(ForceRevisionStrategyBefore)}
+
+// MarshalJSON returns an error if ForceRevisionStrategy is not one of the defined values
+func (f ForceRevisionStrategy) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(f), "ForceRevisionStrategy", forceRevisionStrategyValues)
+}
+
+var _ ICommandData = &ForceRevisionCommandData{}
+
+// ForceRevisionCommandData is a command for SaveChanges that creates
+// a revision of a document with its current content
+type ForceRevisionCommandData struct {
+	*CommandData
+}
+
+// NewForceRevisionCommandData returns new ForceRevisionCommandData
+func NewForceRevisionCommandData(id string) *ForceRevisionCommandData {
+	return &ForceRevisionCommandData{
+		CommandData: &CommandData{
+			Type: CommandForceRevisionCreation,
+			ID:   id,
+		},
+	}
+}
+
+func (d *ForceRevisionCommandData) serialize(conventions *DocumentConventions) (interface{}, error) {
+	return d.baseJSON(), nil
+}
@@ -1,6 +1,8 @@
 package ravendb
 
 import (
+	"io"
+	"io/ioutil"
 	"net/http"
 	"strconv"
 )
@@ -16,8 +18,14 @@ type GetAttachmentOperation struct {
 	_name         string
 	_type         AttachmentType
 	_changeVector *string
+
+	ifNoneMatch string
+	hasRange    bool
+	rangeFrom   int64
+	rangeTo     int64
 }
 
+// Deprecated: use operations.NewGetAttachmentOperation.
 func NewGetAttachmentOperation(documentID string, name string, typ AttachmentType, contentType string, changeVector *string) *GetAttachmentOperation {
 	return &GetAttachmentOperation{
 		_documentID:   documentID,
@@ -27,10 +35,51 @@ func NewGetAttachmentOperation(documentID string, name string, typ AttachmentTyp
 	}
 }
 
+// NewGetAttachmentIfModifiedOperation returns an operation that gets an attachment
+// only if its change vector is different than changeVector, e.g. the one
+// of a copy cached by the caller. If the attachment didn't change,
+// Command.Result.NotModified is true and no data is downloaded
+//
+// Deprecated: use operations.NewGetAttachmentIfModifiedOperation.
+func NewGetAttachmentIfModifiedOperation(documentID string, name string, changeVector string) *GetAttachmentOperation {
+	return &GetAttachmentOperation{
+		_documentID: documentID,
+		_name:       name,
+		_type:       AttachmentDocument,
+		ifNoneMatch: changeVector,
+	}
+}
+
+// NewGetAttachmentRangeOperation returns an operation that gets only bytes
+// from from to to (inclusive) of an attachment's content. If to is negative,
+// the content is returned until its end. A negative from or to smaller
+// than from is reported as an error when the command is created
+//
+// Deprecated: use operations.NewGetAttachmentRangeOperation.
+func NewGetAttachmentRangeOperation(documentID string, name string, from int64, to int64) *GetAttachmentOperation {
+	return &GetAttachmentOperation{
+		_documentID: documentID,
+		_name:       name,
+		_type:       AttachmentDocument,
+		hasRange:    true,
+		rangeFrom:   from,
+		rangeTo:     to,
+	}
+}
+
 func (o *GetAttachmentOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
 	var err error
 	o.Command, err = NewGetAttachmentCommand(o._documentID, o._name, o._type, o._changeVector)
-	return o.Command, err
+	if err != nil {
+		return nil, err
+	}
+	o.Command.ifNoneMatch = o.ifNoneMatch
+	if o.hasRange {
+		if err = o.Command.setRange(o.rangeFrom, o.rangeTo); err != nil {
+			return nil, err
+		}
+	}
+	return o.Command, nil
 }
 
 var _ RavenCommand = &GetAttachmentCommand{}
@@ -43,6 +92,13 @@ type GetAttachmentCommand struct {
 	_type         AttachmentType
 	_changeVector *string
 
+	// if set, sent as If-None-Match header
+	ifNoneMatch string
+
+	hasRange  bool
+	rangeFrom int64
+	rangeTo   int64
+
 	Result *AttachmentResult
 }
 
@@ -69,9 +125,29 @@ func NewGetAttachmentCommand(documentID string, name string, typ AttachmentType,
 		_changeVector: changeVector,
 	}
 	cmd.IsReadRequest = true
+	// attachments are not kept in http cache
+	cmd.CanCache = false
 	return cmd, nil
 }
 
+// setRange limits the content to bytes from from to to (inclusive).
+// Negative to means until the end
+func (c *GetAttachmentCommand) setRange(from int64, to int64) error {
+	if from < 0 {
+		return newIllegalArgumentError("from cannot be negative")
+	}
+	if to >= 0 && to < from {
+		return newIllegalArgumentError("to (%d) cannot be smaller than from (%d)", to, from)
+	}
+	if c._type != AttachmentDocument {
+		return newIllegalArgumentError("range can only be requested for attachment type " + AttachmentDocument)
+	}
+	c.hasRange = true
+	c.rangeFrom = from
+	c.rangeTo = to
+	return nil
+}
+
 func (c *GetAttachmentCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	url := node.URL + "/databases/" + node.Database + "/attachments?id=" + urlUtilsEscapeDataString(c._documentID) + "&name=" + urlUtilsEscapeDataString(c._name)
 
@@ -87,7 +163,21 @@ func (c *GetAttachmentCommand) CreateRequest(node *ServerNode) (*http.Request, e
 		return NewHttpPost(url, d)
 	}
 
-	return newHttpGet(url)
+	request, err := newHttpGet(url)
+	if err != nil {
+		return nil, err
+	}
+	if c.ifNoneMatch != "" {
+		request.Header.Set(headersIfNoneMatch, "\""+c.ifNoneMatch+"\"")
+	}
+	if c.hasRange {
+		rangeHeader := "bytes=" + i64toa(c.rangeFrom) + "-"
+		if c.rangeTo >= 0 {
+			rangeHeader += i64toa(c.rangeTo)
+		}
+		request.Header.Set("Range", rangeHeader)
+	}
+	return request, nil
 }
 
 func (c *GetAttachmentCommand) processResponse(cache *httpCache, response *http.Response, url string) (responseDisposeHandling, error) {
@@ -111,5 +201,33 @@ func (c *GetAttachmentCommand) processResponse(cache *httpCache, response *http.
 		DocumentID:   c._documentID,
 	}
 	c.Result = newAttachmentResult(response, attachmentDetails)
+	if c.hasRange && response.StatusCode != http.StatusPartialContent {
+		// the server ignored the range and sent the whole content
+		if _, err := io.CopyN(ioutil.Discard, response.Body, c.rangeFrom); err != nil && err != io.EOF {
+			response.Body.Close()
+			return responseDisposeHandlingAutomatic, err
+		}
+		if c.rangeTo >= 0 {
+			c.Result.Data = io.LimitReader(response.Body, c.rangeTo-c.rangeFrom+1)
+		}
+	}
 	return responseDisposeHandlingManually, nil
 }
+
+// SetResponse is only called when there is no response body to process:
+// when the attachment doesn't exist or wasn't modified
+func (c *GetAttachmentCommand) SetResponse(response []byte, fromCache bool) error {
+	if c.StatusCode == http.StatusNotModified {
+		c.Result = &AttachmentResult{
+			Details: &AttachmentDetails{
+				AttachmentName: AttachmentName{
+					Name: c._name,
+				},
+				ChangeVector: &c.ifNoneMatch,
+				DocumentID:   c._documentID,
+			},
+			NotModified: true,
+		}
+	}
+	return nil
+}
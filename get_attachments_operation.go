@@ -0,0 +1,160 @@
+package ravendb
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+var (
+	_ IOperation = &GetAttachmentsOperation{}
+)
+
+// AttachmentRequest identifies an attachment requested with GetAttachmentsOperation
+type AttachmentRequest struct {
+	DocumentID string `json:"DocumentId"`
+	Name       string `json:"Name"`
+}
+
+// GetAttachmentsOperation gets multiple attachments in a single request
+type GetAttachmentsOperation struct {
+	Command *GetAttachmentsCommand
+
+	attachments []*AttachmentRequest
+	typ         AttachmentType
+}
+
+// NewGetAttachmentsOperation returns an operation that gets attachments.
+// The result is Command.Result, which must be closed
+//
+// Deprecated: use operations.NewGetAttachmentsOperation.
+func NewGetAttachmentsOperation(attachments []*AttachmentRequest, typ AttachmentType) *GetAttachmentsOperation {
+	return &GetAttachmentsOperation{
+		attachments: attachments,
+		typ:         typ,
+	}
+}
+
+func (o *GetAttachmentsOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewGetAttachmentsCommand(o.attachments, o.typ)
+	return o.Command, err
+}
+
+var _ RavenCommand = &GetAttachmentsCommand{}
+
+// GetAttachmentsCommand gets multiple attachments in a single request
+type GetAttachmentsCommand struct {
+	RavenCommandBase
+
+	attachments []*AttachmentRequest
+	typ         AttachmentType
+
+	Result *AttachmentsIterator
+}
+
+// NewGetAttachmentsCommand returns new GetAttachmentsCommand
+func NewGetAttachmentsCommand(attachments []*AttachmentRequest, typ AttachmentType) (*GetAttachmentsCommand, error) {
+	if len(attachments) == 0 {
+		return nil, newIllegalArgumentError("attachments cannot be empty")
+	}
+	for _, a := range attachments {
+		if a == nil || stringIsBlank(a.DocumentID) || stringIsBlank(a.Name) {
+			return nil, newIllegalArgumentError("DocumentId and Name of every attachment must be provided")
+		}
+	}
+	if typ == "" {
+		typ = AttachmentDocument
+	}
+
+	cmd := &GetAttachmentsCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		attachments: attachments,
+		typ:         typ,
+	}
+	cmd.IsReadRequest = true
+	cmd.CanCache = false
+	return cmd, nil
+}
+
+func (c *GetAttachmentsCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/attachments/bulk"
+
+	m := map[string]interface{}{
+		"AttachmentType": c.typ,
+		"Attachments":    c.attachments,
+	}
+	d, err := jsonMarshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+// the response is a json object with details of all attachments
+// followed by their content, in the order they were requested
+func (c *GetAttachmentsCommand) processResponse(cache *httpCache, response *http.Response, url string) (responseDisposeHandling, error) {
+	r := bufio.NewReader(response.Body)
+	var res struct {
+		AttachmentsMetadata []*AttachmentDetails `json:"AttachmentsMetadata"`
+	}
+	dec := json.NewDecoder(r)
+	if err := dec.Decode(&res); err != nil {
+		return responseDisposeHandlingAutomatic, err
+	}
+	c.Result = &AttachmentsIterator{
+		details:  res.AttachmentsMetadata,
+		stream:   io.MultiReader(dec.Buffered(), r),
+		response: response,
+	}
+	return responseDisposeHandlingManually, nil
+}
+
+// SetResponse is only called when there's no response body
+func (c *GetAttachmentsCommand) SetResponse(response []byte, fromCache bool) error {
+	return throwInvalidResponse()
+}
+
+// AttachmentsIterator iterates over attachments returned by GetAttachmentsOperation.
+// Data of an attachment can only be read until the next call to Next
+type AttachmentsIterator struct {
+	details  []*AttachmentDetails
+	stream   io.Reader
+	response *http.Response
+
+	current *io.LimitedReader
+	pos     int
+}
+
+// Next returns the next attachment. Returns io.EOF when there are no more attachments
+func (i *AttachmentsIterator) Next() (*AttachmentResult, error) {
+	if i.current != nil {
+		// skip unread content of the previous attachment
+		if _, err := io.Copy(ioutil.Discard, i.current); err != nil {
+			return nil, err
+		}
+		i.current = nil
+	}
+	if i.pos >= len(i.details) {
+		return nil, io.EOF
+	}
+	details := i.details[i.pos]
+	i.pos++
+	i.current = &io.LimitedReader{R: i.stream, N: details.Size}
+	res := &AttachmentResult{
+		Data:    i.current,
+		Details: details,
+	}
+	return res, nil
+}
+
+// Close releases the response. Must be called after using the iterator
+func (i *AttachmentsIterator) Close() error {
+	if i.response != nil && i.response.Body != nil {
+		return i.response.Body.Close()
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ type GetClientConfigurationOperation struct {
 	Command *GetClientConfigurationCommand
 }
 
+// Deprecated: use operations.NewGetClientConfigurationOperation.
 func NewGetClientConfigurationOperation() *GetClientConfigurationOperation {
 	return &GetClientConfigurationOperation{}
 }
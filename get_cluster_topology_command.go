@@ -0,0 +1,48 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ RavenCommand = &GetClusterTopologyCommand{}
+
+// ClusterTopologyResponse describes the cluster as seen by the node
+// that answered the request
+type ClusterTopologyResponse struct {
+	Leader       string           `json:"Leader"`
+	NodeTag      string           `json:"NodeTag"`
+	Topology     *ClusterTopology `json:"Topology"`
+	Etag         int64            `json:"Etag"`
+	CurrentState string           `json:"CurrentState"`
+	CurrentTerm  int64            `json:"CurrentTerm"`
+}
+
+// GetClusterTopologyCommand returns cluster topology with members,
+// promotables and watchers
+type GetClusterTopologyCommand struct {
+	RavenCommandBase
+
+	Result *ClusterTopologyResponse
+}
+
+// NewGetClusterTopologyCommand returns new GetClusterTopologyCommand
+func NewGetClusterTopologyCommand() *GetClusterTopologyCommand {
+	cmd := &GetClusterTopologyCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetClusterTopologyCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/cluster/topology"
+	return newHttpGet(url)
+}
+
+func (c *GetClusterTopologyCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
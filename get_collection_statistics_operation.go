@@ -12,6 +12,7 @@ type GetCollectionStatisticsOperation struct {
 	Command *GetCollectionStatisticsCommand
 }
 
+// Deprecated: use operations.NewGetCollectionStatisticsOperation.
 func NewGetCollectionStatisticsOperation() *GetCollectionStatisticsOperation {
 	return &GetCollectionStatisticsOperation{}
 }
@@ -16,6 +16,7 @@ type GetCompareExchangeValueOperation struct {
 	_clazz reflect.Type
 }
 
+// Deprecated: use operations.NewGetCompareExchangeValueOperation.
 func NewGetCompareExchangeValueOperation(clazz reflect.Type, key string) (*GetCompareExchangeValueOperation, error) {
 	if stringIsEmpty(key) {
 		return nil, newIllegalArgumentError("The key argument must have value")
@@ -62,9 +63,8 @@ func NewGetCompareExchangeValueCommand(clazz reflect.Type, key string, conventio
 }
 
 func (c *GetCompareExchangeValueCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
-	url := node.URL + "/databases/" + node.Database + "/cmpxchg?key=" + urlEncode(c._key)
+	url := node.URL + "/databases/" + node.Database + "/cmpxchg?key=" + urlUtilsEscapeDataString(c._key)
 	return newHttpGet(url)
-
 }
 
 func (c *GetCompareExchangeValueCommand) SetResponse(response []byte, fromCache bool) error {
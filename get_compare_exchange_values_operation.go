@@ -21,6 +21,7 @@ type GetCompareExchangeValuesOperation struct {
 	_pageSize  int
 }
 
+// Deprecated: use operations.NewGetCompareExchangeValuesOperationWithKeys.
 func NewGetCompareExchangeValuesOperationWithKeys(clazz reflect.Type, keys []string) (*GetCompareExchangeValuesOperation, error) {
 	if len(keys) == 0 {
 		return nil, newIllegalArgumentError("Keys cannot be null or empty array")
@@ -35,6 +36,7 @@ func NewGetCompareExchangeValuesOperationWithKeys(clazz reflect.Type, keys []str
 	}, nil
 }
 
+// Deprecated: use operations.NewGetCompareExchangeValuesOperation.
 func NewGetCompareExchangeValuesOperation(clazz reflect.Type, startWith string, start int, pageSize int) (*GetCompareExchangeValuesOperation, error) {
 	return &GetCompareExchangeValuesOperation{
 		_clazz: clazz,
@@ -0,0 +1,94 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &GetConnectionStringsOperation{}
+)
+
+// GetConnectionStringsOperation returns connection strings defined in a database
+type GetConnectionStringsOperation struct {
+	connectionStringName string
+	typ                  ConnectionStringType
+
+	Command *GetConnectionStringsCommand
+}
+
+// NewGetConnectionStringsOperation returns an operation that gets all connection strings
+//
+// Deprecated: use operations.NewGetConnectionStringsOperation.
+func NewGetConnectionStringsOperation() *GetConnectionStringsOperation {
+	return &GetConnectionStringsOperation{}
+}
+
+// NewGetConnectionStringOperation returns an operation that gets a connection
+// string with a given name and type
+//
+// Deprecated: use operations.NewGetConnectionStringOperation.
+func NewGetConnectionStringOperation(connectionStringName string, typ ConnectionStringType) (*GetConnectionStringsOperation, error) {
+	if connectionStringName == "" {
+		return nil, newIllegalArgumentError("connectionStringName cannot be empty")
+	}
+	if typ == "" || typ == ConnectionStringTypeNone {
+		return nil, newIllegalArgumentError("type must be specified")
+	}
+	return &GetConnectionStringsOperation{
+		connectionStringName: connectionStringName,
+		typ:                  typ,
+	}, nil
+}
+
+func (o *GetConnectionStringsOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetConnectionStringsCommand(o.connectionStringName, o.typ)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetConnectionStringsCommand{}
+
+// GetConnectionStringsCommand describes "get connection strings" command
+type GetConnectionStringsCommand struct {
+	RavenCommandBase
+
+	connectionStringName string
+	typ                  ConnectionStringType
+
+	Result *GetConnectionStringsResult
+}
+
+// NewGetConnectionStringsCommand returns new GetConnectionStringsCommand.
+// connectionStringName and typ are optional
+func NewGetConnectionStringsCommand(connectionStringName string, typ ConnectionStringType) *GetConnectionStringsCommand {
+	cmd := &GetConnectionStringsCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		connectionStringName: connectionStringName,
+		typ:                  typ,
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetConnectionStringsCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/connection-strings"
+	if c.connectionStringName != "" {
+		url += "?connectionStringName=" + urlUtilsEscapeDataString(c.connectionStringName) + "&type=" + c.typ
+	}
+	return newHttpGet(url)
+}
+
+func (c *GetConnectionStringsCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
+
+// GetConnectionStringsResult describes connection strings keyed by name
+type GetConnectionStringsResult struct {
+	RavenConnectionStrings map[string]*RavenConnectionString `json:"RavenConnectionStrings"`
+	SqlConnectionStrings   map[string]*SqlConnectionString   `json:"SqlConnectionStrings"`
+	OlapConnectionStrings  map[string]*OlapConnectionString  `json:"OlapConnectionStrings"`
+}
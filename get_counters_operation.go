@@ -0,0 +1,120 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IOperation = &GetCountersOperation{}
+)
+
+// GetCountersOperation gets values of counters of a document
+type GetCountersOperation struct {
+	Command *GetCountersCommand
+
+	docID             string
+	counters          []string
+	returnFullResults bool
+}
+
+// NewGetCountersOperation returns an operation that gets values of counters
+// of document docID. If counters is empty, all counters are returned.
+// If returnFullResults is true, values on each node are returned
+// in CounterDetail.CounterValues
+//
+// Deprecated: use operations.NewGetCountersOperation.
+func NewGetCountersOperation(docID string, counters []string, returnFullResults bool) *GetCountersOperation {
+	return &GetCountersOperation{
+		docID:             docID,
+		counters:          counters,
+		returnFullResults: returnFullResults,
+	}
+}
+
+func (o *GetCountersOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewGetCountersCommand(o.docID, o.counters, o.returnFullResults, conventions)
+	return o.Command, err
+}
+
+var _ RavenCommand = &GetCountersCommand{}
+
+// GetCountersCommand is a command for GetCountersOperation
+type GetCountersCommand struct {
+	RavenCommandBase
+
+	docID             string
+	counters          []string
+	returnFullResults bool
+	conventions       *DocumentConventions
+
+	// Result.Counters has nil entries for requested counters that don't exist
+	Result *CountersDetail
+}
+
+// NewGetCountersCommand returns new GetCountersCommand
+func NewGetCountersCommand(docID string, counters []string, returnFullResults bool, conventions *DocumentConventions) (*GetCountersCommand, error) {
+	if stringIsBlank(docID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be null or empty")
+	}
+	for _, counter := range counters {
+		if stringIsBlank(counter) {
+			return nil, newIllegalArgumentError("counter name cannot be empty")
+		}
+	}
+	if conventions == nil {
+		conventions = getDefaultConventions()
+	}
+
+	cmd := &GetCountersCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		docID:             docID,
+		counters:          counters,
+		returnFullResults: returnFullResults,
+		conventions:       conventions,
+	}
+	cmd.IsReadRequest = true
+	return cmd, nil
+}
+
+func (c *GetCountersCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/counters?docId=" + urlUtilsEscapeDataString(c.docID)
+	if c.returnFullResults {
+		url += "&full=true"
+	}
+
+	query := ""
+	for _, counter := range c.counters {
+		query += "&counter=" + urlUtilsEscapeDataString(counter)
+	}
+	if len(url)+len(query) <= c.conventions.MaxLengthOfQueryUsingGetURL {
+		return newHttpGet(url + query)
+	}
+
+	// too many counters to fit in the url
+	// the url doesn't identify the response so it can't be cached
+	c.CanCache = false
+	docOps := &DocumentCountersOperation{
+		DocumentID: c.docID,
+	}
+	for _, counter := range c.counters {
+		docOps.Operations = append(docOps.Operations, NewCounterOperationGet(counter))
+	}
+	batch := &CounterBatch{
+		ReplyWithAllNodesValues: c.returnFullResults,
+		Documents:               []*DocumentCountersOperation{docOps},
+	}
+	d, err := jsonMarshal(batch)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(node.URL+"/databases/"+node.Database+"/counters", d)
+}
+
+func (c *GetCountersCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
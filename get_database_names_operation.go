@@ -10,6 +10,7 @@ type GetDatabaseNamesOperation struct {
 	_pageSize int
 }
 
+// Deprecated: use operations.NewGetDatabaseNamesOperation.
 func NewGetDatabaseNamesOperation(_start int, _pageSize int) *GetDatabaseNamesOperation {
 	return &GetDatabaseNamesOperation{
 		_start:    _start,
@@ -14,6 +14,7 @@ type GetDatabaseRecordOperation struct {
 	Command *GetDatabaseRecordCommand
 }
 
+// Deprecated: use operations.NewGetDatabaseRecordOperation.
 func NewGetDatabaseRecordOperation(database string) *GetDatabaseRecordOperation {
 	return &GetDatabaseRecordOperation{
 		database: database,
@@ -47,7 +48,7 @@ func NewGetDatabaseRecordCommand(conventions *DocumentConventions, database stri
 }
 
 func (c *GetDatabaseRecordCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
-	url := node.URL + "/admin/databases?name=" + c.database
+	url := node.URL + "/admin/databases?name=" + urlUtilsEscapeDataString(c.database)
 	return newHttpGet(url)
 }
 
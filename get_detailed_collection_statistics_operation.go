@@ -0,0 +1,58 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &GetDetailedCollectionStatisticsOperation{}
+)
+
+// GetDetailedCollectionStatisticsOperation returns document counts
+// and sizes of all collections
+type GetDetailedCollectionStatisticsOperation struct {
+	Command *GetDetailedCollectionStatisticsCommand
+}
+
+// NewGetDetailedCollectionStatisticsOperation returns new GetDetailedCollectionStatisticsOperation
+//
+// Deprecated: use operations.NewGetDetailedCollectionStatisticsOperation.
+func NewGetDetailedCollectionStatisticsOperation() *GetDetailedCollectionStatisticsOperation {
+	return &GetDetailedCollectionStatisticsOperation{}
+}
+
+func (o *GetDetailedCollectionStatisticsOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetDetailedCollectionStatisticsCommand()
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetDetailedCollectionStatisticsCommand{}
+
+// GetDetailedCollectionStatisticsCommand describes "get detailed collection statistics" command
+type GetDetailedCollectionStatisticsCommand struct {
+	RavenCommandBase
+
+	Result *DetailedCollectionStatistics
+}
+
+// NewGetDetailedCollectionStatisticsCommand returns new GetDetailedCollectionStatisticsCommand
+func NewGetDetailedCollectionStatisticsCommand() *GetDetailedCollectionStatisticsCommand {
+	cmd := &GetDetailedCollectionStatisticsCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetDetailedCollectionStatisticsCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/collections/stats/detailed"
+	return newHttpGet(url)
+}
+
+func (c *GetDetailedCollectionStatisticsCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
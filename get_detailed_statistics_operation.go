@@ -0,0 +1,72 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &GetDetailedStatisticsOperation{}
+)
+
+// GetDetailedStatisticsOperation returns database statistics together with
+// cluster-level counts like number of identities and compare exchange values
+type GetDetailedStatisticsOperation struct {
+	debugTag string
+
+	Command *GetDetailedStatisticsCommand
+}
+
+// NewGetDetailedStatisticsOperation returns new GetDetailedStatisticsOperation
+//
+// Deprecated: use operations.NewGetDetailedStatisticsOperation.
+func NewGetDetailedStatisticsOperation(debugTag string) *GetDetailedStatisticsOperation {
+	return &GetDetailedStatisticsOperation{
+		debugTag: debugTag,
+	}
+}
+
+func (o *GetDetailedStatisticsOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetDetailedStatisticsCommand(o.debugTag)
+	return o.Command, nil
+}
+
+var (
+	_ RavenCommand = &GetDetailedStatisticsCommand{}
+)
+
+// GetDetailedStatisticsCommand describes "get detailed statistics" command
+type GetDetailedStatisticsCommand struct {
+	RavenCommandBase
+
+	debugTag string
+
+	Result *DetailedDatabaseStatistics
+}
+
+// NewGetDetailedStatisticsCommand returns new GetDetailedStatisticsCommand
+func NewGetDetailedStatisticsCommand(debugTag string) *GetDetailedStatisticsCommand {
+	cmd := &GetDetailedStatisticsCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		debugTag: debugTag,
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetDetailedStatisticsCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/stats/detailed"
+	if c.debugTag != "" {
+		url += "?" + c.debugTag
+	}
+
+	return newHttpGet(url)
+}
+
+func (c *GetDetailedStatisticsCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -12,6 +12,7 @@ type GetIdentitiesOperation struct {
 	Command *GetIdentitiesCommand
 }
 
+// Deprecated: use operations.NewGetIdentitiesOperation.
 func NewGetIdentitiesOperation() *GetIdentitiesOperation {
 	return &GetIdentitiesOperation{}
 }
@@ -39,9 +40,11 @@ func (c *GetIdentitiesCommand) CreateRequest(node *ServerNode) (*http.Request, e
 	url := node.URL + "/databases/" + node.Database + "/debug/identities"
 
 	return newHttpGet(url)
-
 }
 
 func (c *GetIdentitiesCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
 	return jsonUnmarshal(response, &c.Result)
 }
@@ -8,12 +8,15 @@ var (
 	_ IMaintenanceOperation = &GetIndexErrorsOperation{}
 )
 
+// GetIndexErrorsOperation gets indexing errors of given indexes
+// or of all indexes if no names are given
 type GetIndexErrorsOperation struct {
 	indexNames []string
 
 	Command *GetIndexErrorsCommand
 }
 
+// Deprecated: use operations.NewGetIndexErrorsOperation.
 func NewGetIndexErrorsOperation(indexNames []string) *GetIndexErrorsOperation {
 	return &GetIndexErrorsOperation{
 		indexNames: indexNames,
@@ -48,12 +51,13 @@ func NewGetIndexErrorsCommand(indexNames []string) *GetIndexErrorsCommand {
 func (c *GetIndexErrorsCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	url := node.URL + "/databases/" + node.Database + "/indexes/errors"
 
-	if len(c.indexNames) > 0 {
-		url += "?"
-
-		for _, indexName := range c.indexNames {
-			url += "&name=" + indexName
+	for i, indexName := range c.indexNames {
+		if i == 0 {
+			url += "?name="
+		} else {
+			url += "&name="
 		}
+		url += urlUtilsEscapeDataString(indexName)
 	}
 
 	return newHttpGet(url)
@@ -14,6 +14,7 @@ type GetIndexNamesOperation struct {
 	Command *GetIndexNamesCommand
 }
 
+// Deprecated: use operations.NewGetIndexNamesOperation.
 func NewGetIndexNamesOperation(start int, pageSize int) *GetIndexNamesOperation {
 	return &GetIndexNamesOperation{
 		_start:    start,
@@ -6,14 +6,18 @@ import (
 
 var _ IMaintenanceOperation = &GetIndexOperation{}
 
+// GetIndexOperation gets definition of an index.
+// Command.Result is nil if the index doesn't exist
 type GetIndexOperation struct {
 	_indexName string
 
 	Command *GetIndexCommand
 }
 
+// NewGetIndexOperation returns GetIndexOperation
+//
+// Deprecated: use operations.NewGetIndexOperation.
 func NewGetIndexOperation(indexName string) *GetIndexOperation {
-	panicIf(indexName == "", "Index name connot be empty")
 	return &GetIndexOperation{
 		_indexName: indexName,
 	}
@@ -42,7 +46,7 @@ type GetIndexCommand struct {
 
 func NewGetIndexCommand(indexName string) (*GetIndexCommand, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 
 	res := &GetIndexCommand{
@@ -61,8 +65,9 @@ func (c *GetIndexCommand) CreateRequest(node *ServerNode) (*http.Request, error)
 }
 
 func (c *GetIndexCommand) SetResponse(response []byte, fromCache bool) error {
+	// index doesn't exist
 	if response == nil {
-		return throwInvalidResponse()
+		return nil
 	}
 
 	var res struct {
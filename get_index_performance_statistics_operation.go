@@ -0,0 +1,78 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IMaintenanceOperation = &GetIndexPerformanceStatisticsOperation{}
+
+// GetIndexPerformanceStatisticsOperation gets statistics of recent indexing
+// batches of given indexes or of all indexes if no names are given
+type GetIndexPerformanceStatisticsOperation struct {
+	indexNames []string
+
+	Command *GetIndexPerformanceStatisticsCommand
+}
+
+// NewGetIndexPerformanceStatisticsOperation returns GetIndexPerformanceStatisticsOperation
+//
+// Deprecated: use operations.NewGetIndexPerformanceStatisticsOperation.
+func NewGetIndexPerformanceStatisticsOperation(indexNames ...string) *GetIndexPerformanceStatisticsOperation {
+	return &GetIndexPerformanceStatisticsOperation{
+		indexNames: indexNames,
+	}
+}
+
+func (o *GetIndexPerformanceStatisticsOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetIndexPerformanceStatisticsCommand(o.indexNames)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetIndexPerformanceStatisticsCommand{}
+
+type GetIndexPerformanceStatisticsCommand struct {
+	RavenCommandBase
+
+	indexNames []string
+
+	Result []*IndexPerformanceStats
+}
+
+func NewGetIndexPerformanceStatisticsCommand(indexNames []string) *GetIndexPerformanceStatisticsCommand {
+	res := &GetIndexPerformanceStatisticsCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		indexNames: indexNames,
+	}
+	res.IsReadRequest = true
+	return res
+}
+
+func (c *GetIndexPerformanceStatisticsCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/indexes/performance"
+	for i, indexName := range c.indexNames {
+		if i == 0 {
+			url += "?name="
+		} else {
+			url += "&name="
+		}
+		url += urlUtilsEscapeDataString(indexName)
+	}
+
+	return newHttpGet(url)
+}
+
+func (c *GetIndexPerformanceStatisticsCommand) SetResponse(response []byte, fromCache bool) error {
+	if response == nil {
+		return throwInvalidResponse()
+	}
+	var res struct {
+		Results []*IndexPerformanceStats `json:"Results"`
+	}
+	err := jsonUnmarshal(response, &res)
+	if err != nil {
+		return err
+	}
+	c.Result = res.Results
+	return nil
+}
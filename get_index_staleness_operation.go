@@ -0,0 +1,78 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IMaintenanceOperation = &GetIndexStalenessOperation{}
+
+// IndexStaleness tells if an index is stale and why
+type IndexStaleness struct {
+	IsStale bool `json:"IsStale"`
+	// StalenessReasons are human-readable reasons, e.g. documents
+	// the index didn't process yet
+	StalenessReasons []string `json:"StalenessReasons"`
+}
+
+// GetIndexStalenessOperation checks if an index is stale
+type GetIndexStalenessOperation struct {
+	indexName string
+
+	Command *GetIndexStalenessCommand
+}
+
+// NewGetIndexStalenessOperation returns GetIndexStalenessOperation
+//
+// Deprecated: use operations.NewGetIndexStalenessOperation.
+func NewGetIndexStalenessOperation(indexName string) *GetIndexStalenessOperation {
+	return &GetIndexStalenessOperation{
+		indexName: indexName,
+	}
+}
+
+func (o *GetIndexStalenessOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewGetIndexStalenessCommand(o.indexName)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetIndexStalenessCommand{}
+
+type GetIndexStalenessCommand struct {
+	RavenCommandBase
+
+	indexName string
+
+	Result *IndexStaleness
+}
+
+func NewGetIndexStalenessCommand(indexName string) (*GetIndexStalenessCommand, error) {
+	if indexName == "" {
+		return nil, newIllegalArgumentError("Index name cannot be empty")
+	}
+	res := &GetIndexStalenessCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		indexName: indexName,
+	}
+	res.IsReadRequest = true
+	// staleness changes all the time
+	res.CanCache = false
+	return res, nil
+}
+
+func (c *GetIndexStalenessCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/indexes/staleness?name=" + urlUtilsEscapeDataString(c.indexName)
+
+	return newHttpGet(url)
+}
+
+func (c *GetIndexStalenessCommand) SetResponse(response []byte, fromCache bool) error {
+	if response == nil {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -12,8 +12,8 @@ type GetIndexStatisticsOperation struct {
 	Command *GetIndexStatisticsCommand
 }
 
+// Deprecated: use operations.NewGetIndexStatisticsOperation.
 func NewGetIndexStatisticsOperation(indexName string) *GetIndexStatisticsOperation {
-	panicIf(indexName == "", "Index name connot be empty")
 	return &GetIndexStatisticsOperation{
 		indexName: indexName,
 	}
@@ -42,7 +42,7 @@ type GetIndexStatisticsCommand struct {
 
 func NewGetIndexStatisticsCommand(indexName string) (*GetIndexStatisticsCommand, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 
 	res := &GetIndexStatisticsCommand{
@@ -14,6 +14,7 @@ type GetIndexesOperation struct {
 	Command *GetIndexesCommand
 }
 
+// Deprecated: use operations.NewGetIndexesOperation.
 func NewGetIndexesOperation(_start int, _pageSize int) *GetIndexesOperation {
 	return &GetIndexesOperation{
 		_start:    _start,
@@ -10,6 +10,7 @@ type GetIndexesStatisticsOperation struct {
 	Command *GetIndexesStatisticsCommand
 }
 
+// Deprecated: use operations.NewGetIndexesStatisticsOperation.
 func NewGetIndexesStatisticsOperation() *GetIndexesStatisticsOperation {
 	return &GetIndexesStatisticsOperation{}
 }
@@ -10,6 +10,7 @@ type GetIndexingStatusOperation struct {
 	Command *GetIndexingStatusCommand
 }
 
+// Deprecated: use operations.NewGetIndexingStatusOperation.
 func NewGetIndexingStatusOperation() *GetIndexingStatusOperation {
 	return &GetIndexingStatusOperation{}
 }
@@ -0,0 +1,116 @@
+package ravendb
+
+import (
+	"net/http"
+	"time"
+)
+
+var (
+	_ IOperation = &GetMultipleTimeSeriesOperation{}
+)
+
+// TimeSeriesRange is a time range of a time series.
+// nil From or To means the range is unbounded
+type TimeSeriesRange struct {
+	Name string
+	From *time.Time
+	To   *time.Time
+}
+
+// TimeSeriesDetails describes entries of multiple time series of a document
+type TimeSeriesDetails struct {
+	ID string `json:"Id"`
+	// maps time series name to results for requested ranges
+	Values map[string][]*TimeSeriesRangeResult `json:"Values"`
+}
+
+// GetMultipleTimeSeriesOperation gets entries of multiple ranges
+// of time series of a document in a single request
+type GetMultipleTimeSeriesOperation struct {
+	Command *GetMultipleTimeSeriesCommand
+
+	docID    string
+	ranges   []*TimeSeriesRange
+	start    int
+	pageSize int
+}
+
+// NewGetMultipleTimeSeriesOperation returns an operation that gets entries
+// in ranges, skipping start entries and returning at most pageSize
+// entries (0 means no limit)
+//
+// Deprecated: use operations.NewGetMultipleTimeSeriesOperation.
+func NewGetMultipleTimeSeriesOperation(docID string, ranges []*TimeSeriesRange, start int, pageSize int) *GetMultipleTimeSeriesOperation {
+	return &GetMultipleTimeSeriesOperation{
+		docID:    docID,
+		ranges:   ranges,
+		start:    start,
+		pageSize: pageSize,
+	}
+}
+
+func (o *GetMultipleTimeSeriesOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewGetMultipleTimeSeriesCommand(o.docID, o.ranges, o.start, o.pageSize)
+	return o.Command, err
+}
+
+var _ RavenCommand = &GetMultipleTimeSeriesCommand{}
+
+// GetMultipleTimeSeriesCommand is a command for GetMultipleTimeSeriesOperation
+type GetMultipleTimeSeriesCommand struct {
+	RavenCommandBase
+
+	docID    string
+	ranges   []*TimeSeriesRange
+	start    int
+	pageSize int
+
+	// nil if the document doesn't exist
+	Result *TimeSeriesDetails
+}
+
+// NewGetMultipleTimeSeriesCommand returns new GetMultipleTimeSeriesCommand
+func NewGetMultipleTimeSeriesCommand(docID string, ranges []*TimeSeriesRange, start int, pageSize int) (*GetMultipleTimeSeriesCommand, error) {
+	if stringIsBlank(docID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be null or empty")
+	}
+	if len(ranges) == 0 {
+		return nil, newIllegalArgumentError("Ranges cannot be empty")
+	}
+	for _, r := range ranges {
+		if r == nil || stringIsBlank(r.Name) {
+			return nil, newIllegalArgumentError("Name of every range must be provided")
+		}
+	}
+	if err := validateTimeSeriesPaging(start, pageSize); err != nil {
+		return nil, err
+	}
+
+	cmd := &GetMultipleTimeSeriesCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		docID:    docID,
+		ranges:   ranges,
+		start:    start,
+		pageSize: pageSize,
+	}
+	cmd.IsReadRequest = true
+	return cmd, nil
+}
+
+func (c *GetMultipleTimeSeriesCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/timeseries/ranges?docId=" + urlUtilsEscapeDataString(c.docID)
+	url += timeSeriesPagingQuery(c.start, c.pageSize)
+	for _, r := range c.ranges {
+		url += timeSeriesRangeQuery(r.Name, r.From, r.To)
+	}
+	return newHttpGet(url)
+}
+
+func (c *GetMultipleTimeSeriesCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
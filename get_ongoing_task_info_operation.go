@@ -0,0 +1,113 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var (
+	_ IMaintenanceOperation = &GetOngoingTaskInfoOperation{}
+)
+
+// GetOngoingTaskInfoOperation returns information about a background task.
+// Result is *OngoingTaskReplication, *OngoingTaskRavenEtl etc. depending on task type
+type GetOngoingTaskInfoOperation struct {
+	taskID   int64
+	taskName string
+	taskType OngoingTaskType
+
+	Command *GetOngoingTaskInfoCommand
+}
+
+// NewGetOngoingTaskInfoOperation returns an operation that gets a task by id
+//
+// Deprecated: use operations.NewGetOngoingTaskInfoOperation.
+func NewGetOngoingTaskInfoOperation(taskID int64, taskType OngoingTaskType) (*GetOngoingTaskInfoOperation, error) {
+	if taskID <= 0 {
+		return nil, newIllegalArgumentError("taskID must be positive")
+	}
+	if _, err := newOngoingTaskForType(taskType); err != nil {
+		return nil, err
+	}
+	return &GetOngoingTaskInfoOperation{
+		taskID:   taskID,
+		taskType: taskType,
+	}, nil
+}
+
+// NewGetOngoingTaskInfoOperationByName returns an operation that gets a task by name
+//
+// Deprecated: use operations.NewGetOngoingTaskInfoOperationByName.
+func NewGetOngoingTaskInfoOperationByName(taskName string, taskType OngoingTaskType) (*GetOngoingTaskInfoOperation, error) {
+	if taskName == "" {
+		return nil, newIllegalArgumentError("taskName cannot be empty")
+	}
+	if _, err := newOngoingTaskForType(taskType); err != nil {
+		return nil, err
+	}
+	return &GetOngoingTaskInfoOperation{
+		taskName: taskName,
+		taskType: taskType,
+	}, nil
+}
+
+func (o *GetOngoingTaskInfoOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetOngoingTaskInfoCommand(o.taskID, o.taskName, o.taskType)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetOngoingTaskInfoCommand{}
+
+// GetOngoingTaskInfoCommand describes "get ongoing task info" command
+type GetOngoingTaskInfoCommand struct {
+	RavenCommandBase
+
+	taskID   int64
+	taskName string
+	taskType OngoingTaskType
+
+	// nil if the task doesn't exist
+	Result IOngoingTask
+}
+
+// NewGetOngoingTaskInfoCommand returns new GetOngoingTaskInfoCommand.
+// The task is identified by taskName if it's not empty, by taskID otherwise
+func NewGetOngoingTaskInfoCommand(taskID int64, taskName string, taskType OngoingTaskType) *GetOngoingTaskInfoCommand {
+	cmd := &GetOngoingTaskInfoCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID:   taskID,
+		taskName: taskName,
+		taskType: taskType,
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetOngoingTaskInfoCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/task?"
+	if c.taskName != "" {
+		url += "taskName=" + urlUtilsEscapeDataString(c.taskName)
+	} else {
+		url += "key=" + strconv.FormatInt(c.taskID, 10)
+	}
+	url += "&type=" + string(c.taskType)
+	return newHttpGet(url)
+}
+
+func (c *GetOngoingTaskInfoCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		c.Result = nil
+		return nil
+	}
+
+	res, err := newOngoingTaskForType(c.taskType)
+	if err != nil {
+		return err
+	}
+	if err = jsonUnmarshal(response, res); err != nil {
+		return err
+	}
+	c.Result = res
+	return nil
+}
@@ -0,0 +1,72 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &GetPeriodicBackupStatusOperation{}
+)
+
+// GetPeriodicBackupStatusOperationResult is a result of GetPeriodicBackupStatusOperation.
+// Status is nil if the task didn't run yet
+type GetPeriodicBackupStatusOperationResult struct {
+	Status *PeriodicBackupStatus `json:"Status"`
+}
+
+// GetPeriodicBackupStatusOperation returns the status of a periodic backup task
+type GetPeriodicBackupStatusOperation struct {
+	taskID int64
+
+	Command *GetPeriodicBackupStatusCommand
+}
+
+// NewGetPeriodicBackupStatusOperation returns new GetPeriodicBackupStatusOperation
+//
+// Deprecated: use operations.NewGetPeriodicBackupStatusOperation.
+func NewGetPeriodicBackupStatusOperation(taskID int64) *GetPeriodicBackupStatusOperation {
+	return &GetPeriodicBackupStatusOperation{
+		taskID: taskID,
+	}
+}
+
+func (o *GetPeriodicBackupStatusOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetPeriodicBackupStatusCommand(o.taskID)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetPeriodicBackupStatusCommand{}
+
+// GetPeriodicBackupStatusCommand describes "get periodic backup status" command
+type GetPeriodicBackupStatusCommand struct {
+	RavenCommandBase
+
+	taskID int64
+
+	Result *GetPeriodicBackupStatusOperationResult
+}
+
+// NewGetPeriodicBackupStatusCommand returns new GetPeriodicBackupStatusCommand
+func NewGetPeriodicBackupStatusCommand(taskID int64) *GetPeriodicBackupStatusCommand {
+	cmd := &GetPeriodicBackupStatusCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID: taskID,
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetPeriodicBackupStatusCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/periodic-backup/status?name=" + urlUtilsEscapeDataString(node.Database) + "&taskId=" + i64toa(c.taskID)
+
+	return newHttpGet(url)
+}
+
+func (c *GetPeriodicBackupStatusCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -0,0 +1,58 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &GetReplicationPerformanceStatisticsOperation{}
+)
+
+// GetReplicationPerformanceStatisticsOperation returns statistics of recent
+// incoming and outgoing replication batches
+type GetReplicationPerformanceStatisticsOperation struct {
+	Command *GetReplicationPerformanceStatisticsCommand
+}
+
+// NewGetReplicationPerformanceStatisticsOperation returns new GetReplicationPerformanceStatisticsOperation
+//
+// Deprecated: use operations.NewGetReplicationPerformanceStatisticsOperation.
+func NewGetReplicationPerformanceStatisticsOperation() *GetReplicationPerformanceStatisticsOperation {
+	return &GetReplicationPerformanceStatisticsOperation{}
+}
+
+func (o *GetReplicationPerformanceStatisticsOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetReplicationPerformanceStatisticsCommand()
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetReplicationPerformanceStatisticsCommand{}
+
+// GetReplicationPerformanceStatisticsCommand describes "get replication performance statistics" command
+type GetReplicationPerformanceStatisticsCommand struct {
+	RavenCommandBase
+
+	Result *ReplicationPerformance
+}
+
+// NewGetReplicationPerformanceStatisticsCommand returns new GetReplicationPerformanceStatisticsCommand
+func NewGetReplicationPerformanceStatisticsCommand() *GetReplicationPerformanceStatisticsCommand {
+	cmd := &GetReplicationPerformanceStatisticsCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetReplicationPerformanceStatisticsCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/replication/performance"
+	return newHttpGet(url)
+}
+
+func (c *GetReplicationPerformanceStatisticsCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
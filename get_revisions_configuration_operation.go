@@ -0,0 +1,60 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &GetRevisionsConfigurationOperation{}
+)
+
+// GetRevisionsConfigurationOperation returns revisions configuration of a database.
+// Command.Result is nil if revisions were never configured
+type GetRevisionsConfigurationOperation struct {
+	Command *GetRevisionsConfigurationCommand
+}
+
+// NewGetRevisionsConfigurationOperation returns new GetRevisionsConfigurationOperation
+//
+// Deprecated: use operations.NewGetRevisionsConfigurationOperation.
+func NewGetRevisionsConfigurationOperation() *GetRevisionsConfigurationOperation {
+	return &GetRevisionsConfigurationOperation{}
+}
+
+// GetCommand returns new RavenCommand for this operation
+func (o *GetRevisionsConfigurationOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetRevisionsConfigurationCommand()
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetRevisionsConfigurationCommand{}
+
+// GetRevisionsConfigurationCommand represents get revisions configuration command
+type GetRevisionsConfigurationCommand struct {
+	RavenCommandBase
+
+	Result *RevisionsConfiguration
+}
+
+// NewGetRevisionsConfigurationCommand returns new GetRevisionsConfigurationCommand
+func NewGetRevisionsConfigurationCommand() *GetRevisionsConfigurationCommand {
+	cmd := &GetRevisionsConfigurationCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetRevisionsConfigurationCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/revisions/config"
+
+	return newHttpGet(url)
+}
+
+func (c *GetRevisionsConfigurationCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		c.Result = nil
+		return nil
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
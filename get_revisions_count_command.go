@@ -0,0 +1,52 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ RavenCommand = &GetRevisionsCountCommand{}
+)
+
+// GetRevisionsCountCommand returns the number of revisions of a document
+type GetRevisionsCountCommand struct {
+	RavenCommandBase
+
+	id string
+
+	Result int64
+}
+
+// NewGetRevisionsCountCommand returns new GetRevisionsCountCommand
+func NewGetRevisionsCountCommand(id string) (*GetRevisionsCountCommand, error) {
+	if stringIsBlank(id) {
+		return nil, newIllegalArgumentError("Id cannot be null or empty")
+	}
+	cmd := &GetRevisionsCountCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		id: id,
+	}
+	cmd.IsReadRequest = true
+	return cmd, nil
+}
+
+func (c *GetRevisionsCountCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/revisions/count?id=" + urlUtilsEscapeDataString(c.id)
+	return newHttpGet(url)
+}
+
+func (c *GetRevisionsCountCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		c.Result = 0
+		return nil
+	}
+	var res struct {
+		RevisionsCount int64 `json:"RevisionsCount"`
+	}
+	if err := jsonUnmarshal(response, &res); err != nil {
+		return err
+	}
+	c.Result = res.RevisionsCount
+	return nil
+}
@@ -0,0 +1,75 @@
+package ravendb
+
+import (
+	"reflect"
+)
+
+var (
+	_ IOperation = &GetRevisionsOperation{}
+)
+
+// GetRevisionsOperation gets revisions of a document outside of a session.
+// Revisions are ordered from the most recent one
+type GetRevisionsOperation struct {
+	Command *GetRevisionsCommand
+
+	id       string
+	start    int
+	pageSize int
+}
+
+// NewGetRevisionsOperation returns an operation that gets at most pageSize
+// revisions of document id, skipping start most recent revisions.
+// pageSize of 0 means the server's default
+//
+// Deprecated: use operations.NewGetRevisionsOperation.
+func NewGetRevisionsOperation(id string, start int, pageSize int) *GetRevisionsOperation {
+	return &GetRevisionsOperation{
+		id:       id,
+		start:    start,
+		pageSize: pageSize,
+	}
+}
+
+func (o *GetRevisionsOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	if stringIsBlank(o.id) {
+		return nil, newIllegalArgumentError("Id cannot be null or empty")
+	}
+	o.Command = NewGetRevisionsCommandRange(o.id, o.start, o.pageSize, false)
+	return o.Command, nil
+}
+
+// TotalResults returns the total number of revisions of the document
+func (o *GetRevisionsOperation) TotalResults() int64 {
+	if o.Command == nil || o.Command.Result == nil {
+		return 0
+	}
+	return o.Command.Result.TotalResults
+}
+
+// GetResults sets results, which should be *[]*<type>, to revisions
+// returned by the operation
+func (o *GetRevisionsOperation) GetResults(results interface{}) error {
+	if o.Command == nil || o.Command.Result == nil {
+		return newIllegalStateError("operation has not been executed")
+	}
+	slice, err := makeSliceForResults(results)
+	if err != nil {
+		return err
+	}
+	elemType := reflect.TypeOf(results).Elem().Elem()
+	tmpSlice := slice
+	for _, document := range o.Command.Result.getResults() {
+		id := ""
+		if metadata, ok := document[MetadataKey].(map[string]interface{}); ok {
+			id, _ = jsonGetAsText(metadata, MetadataID)
+		}
+		entity, err := entityToJSONConvertToEntity(elemType, id, document)
+		if err != nil {
+			return err
+		}
+		tmpSlice = reflect.Append(tmpSlice, reflect.ValueOf(entity))
+	}
+	slice.Set(tmpSlice)
+	return nil
+}
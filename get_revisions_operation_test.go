@@ -0,0 +1,90 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRevisionsCountCommand(t *testing.T) {
+	_, err := NewGetRevisionsCountCommand("")
+	assert.Error(t, err)
+
+	cmd, err := NewGetRevisionsCountCommand("users/1")
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://127.0.0.1:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/databases/db/revisions/count", req.URL.Path)
+	assert.Equal(t, "id=users%2F1", req.URL.RawQuery)
+	assert.NoError(t, cmd.SetResponse([]byte(`{"RevisionsCount":3}`), false))
+	assert.Equal(t, int64(3), cmd.Result)
+}
+
+func TestGetRevisionsOperationGetResults(t *testing.T) {
+	type user struct {
+		ID   string
+		Name string
+	}
+	op := NewGetRevisionsOperation("users/1", 0, 10)
+	var results []*user
+	assert.Error(t, op.GetResults(&results))
+
+	_, err := NewGetRevisionsOperation("", 0, 10).GetCommand(nil, nil, nil)
+	assert.Error(t, err)
+	cmd, err := op.GetCommand(nil, nil, nil)
+	assert.NoError(t, err)
+	rsp := `{"Results":[{"Name":"John","@metadata":{"@id":"users/1"}},{"Name":"Jon","@metadata":{"@id":"users/1"}}],"TotalResults":5}`
+	assert.NoError(t, cmd.SetResponse([]byte(rsp), false))
+	assert.Equal(t, int64(5), op.TotalResults())
+	assert.NoError(t, op.GetResults(&results))
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, "John", results[0].Name)
+	assert.Equal(t, "users/1", results[1].ID)
+}
+
+func TestForceRevisionCreation(t *testing.T) {
+	s := &InMemoryDocumentSessionOperations{}
+	assert.Error(t, s.forceRevisionCreationFor("", ForceRevisionStrategyBefore))
+	assert.NoError(t, s.forceRevisionCreationFor("users/2", ForceRevisionStrategyBefore))
+	assert.NoError(t, s.forceRevisionCreationFor("users/1", ForceRevisionStrategyBefore))
+	assert.NoError(t, s.forceRevisionCreationFor("users/1", ForceRevisionStrategyBefore))
+	assert.Error(t, s.forceRevisionCreationFor("users/1", ForceRevisionStrategyNone))
+
+	result := &saveChangesData{}
+	s.prepareForCreatingRevisionsFromIDs(result)
+	assert.Nil(t, s.idsForCreatingForcedRevisions)
+	assert.Equal(t, 2, len(result.deferredCommands))
+	v, err := result.deferredCommands[0].serialize(nil)
+	assert.NoError(t, err)
+	m := v.(map[string]interface{})
+	assert.Equal(t, "users/1", m["Id"])
+	assert.Equal(t, CommandForceRevisionCreation, m["Type"])
+
+	put := newPutCommandDataWithJSON("users/1", nil, map[string]interface{}{})
+	v, _ = put.serialize(nil)
+	_, ok := v.(map[string]interface{})["ForceRevisionCreationStrategy"]
+	assert.False(t, ok)
+	put.forceRevisionCreationStrategy = ForceRevisionStrategyBefore
+	v, _ = put.serialize(nil)
+	assert.Equal(t, ForceRevisionStrategyBefore, v.(map[string]interface{})["ForceRevisionCreationStrategy"])
+}
+
+func TestRevertRevisionsCommand(t *testing.T) {
+	pointInTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	op := NewRevertRevisionsOperation(pointInTime, time.Hour, "Users")
+	cmd, err := op.GetCommand(nil)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://127.0.0.1:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/databases/db/revisions/revert", req.URL.Path)
+	d, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Time":"2020-01-01T00:00:00.0000000Z","WindowInSec":3600,"Collections":["Users"]}`, string(d))
+	assert.NoError(t, cmd.SetResponse([]byte(`{"OperationId":7}`), false))
+	assert.Equal(t, int64(7), getCommandOperationIDResult(cmd).OperationID)
+
+	_, err = NewRevertRevisionsOperation(pointInTime, -time.Hour).GetCommand(nil)
+	assert.Error(t, err)
+}
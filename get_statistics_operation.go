@@ -14,6 +14,7 @@ type GetStatisticsOperation struct {
 	Command *GetStatisticsCommand
 }
 
+// Deprecated: use operations.NewGetStatisticsOperation.
 func NewGetStatisticsOperation(debugTag string) *GetStatisticsOperation {
 	return &GetStatisticsOperation{
 		debugTag: debugTag,
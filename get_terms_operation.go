@@ -18,12 +18,14 @@ type GetTermsOperation struct {
 }
 
 // NewGetTermsOperation returns GetTermsOperation. pageSize 0 means default size
+//
+// Deprecated: use operations.NewGetTermsOperation.
 func NewGetTermsOperation(indexName string, field string, fromValue string, pageSize int) (*GetTermsOperation, error) {
 	if indexName == "" {
-		return nil, newIllegalStateError("Index name connot be empty")
+		return nil, newIllegalStateError("Index name cannot be empty")
 	}
 	if field == "" {
-		return nil, newIllegalStateError("Field name connot be empty")
+		return nil, newIllegalStateError("Field name cannot be empty")
 
 	}
 	return &GetTermsOperation{
@@ -63,7 +65,7 @@ type GetTermsCommand struct {
 // NewGetTermsCommand returns new GetTermsCommand
 func NewGetTermsCommand(indexName string, field string, fromValue string, pageSize int) (*GetTermsCommand, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 
 	res := &GetTermsCommand{
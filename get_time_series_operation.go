@@ -0,0 +1,136 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var (
+	_ IOperation = &GetTimeSeriesOperation{}
+)
+
+// GetTimeSeriesOperation gets entries of a time series of a document
+type GetTimeSeriesOperation struct {
+	Command *GetTimeSeriesCommand
+
+	docID    string
+	name     string
+	from     *time.Time
+	to       *time.Time
+	start    int
+	pageSize int
+}
+
+// NewGetTimeSeriesOperation returns an operation that gets entries of time series
+// name between from and to (inclusive, nil means unbounded), skipping start
+// entries and returning at most pageSize entries (0 means no limit)
+//
+// Deprecated: use operations.NewGetTimeSeriesOperation.
+func NewGetTimeSeriesOperation(docID string, name string, from *time.Time, to *time.Time, start int, pageSize int) *GetTimeSeriesOperation {
+	return &GetTimeSeriesOperation{
+		docID:    docID,
+		name:     name,
+		from:     from,
+		to:       to,
+		start:    start,
+		pageSize: pageSize,
+	}
+}
+
+func (o *GetTimeSeriesOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewGetTimeSeriesCommand(o.docID, o.name, o.from, o.to, o.start, o.pageSize)
+	return o.Command, err
+}
+
+var _ RavenCommand = &GetTimeSeriesCommand{}
+
+// GetTimeSeriesCommand is a command for GetTimeSeriesOperation
+type GetTimeSeriesCommand struct {
+	RavenCommandBase
+
+	docID    string
+	name     string
+	from     *time.Time
+	to       *time.Time
+	start    int
+	pageSize int
+
+	// nil if the document or the time series doesn't exist
+	Result *TimeSeriesRangeResult
+}
+
+// NewGetTimeSeriesCommand returns new GetTimeSeriesCommand
+func NewGetTimeSeriesCommand(docID string, name string, from *time.Time, to *time.Time, start int, pageSize int) (*GetTimeSeriesCommand, error) {
+	if stringIsBlank(docID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be null or empty")
+	}
+	if stringIsBlank(name) {
+		return nil, newIllegalArgumentError("Time series name cannot be null or empty")
+	}
+	if err := validateTimeSeriesPaging(start, pageSize); err != nil {
+		return nil, err
+	}
+
+	cmd := &GetTimeSeriesCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		docID:    docID,
+		name:     name,
+		from:     from,
+		to:       to,
+		start:    start,
+		pageSize: pageSize,
+	}
+	cmd.IsReadRequest = true
+	return cmd, nil
+}
+
+func validateTimeSeriesPaging(start int, pageSize int) error {
+	if start < 0 {
+		return newIllegalArgumentError("start cannot be negative")
+	}
+	if pageSize < 0 {
+		return newIllegalArgumentError("pageSize cannot be negative")
+	}
+	return nil
+}
+
+// timeSeriesPagingQuery returns url query parameters for paging
+func timeSeriesPagingQuery(start int, pageSize int) string {
+	res := ""
+	if start > 0 {
+		res += "&start=" + strconv.Itoa(start)
+	}
+	if pageSize > 0 {
+		res += "&pageSize=" + strconv.Itoa(pageSize)
+	}
+	return res
+}
+
+// timeSeriesRangeQuery returns url query parameters for a range of time series name
+func timeSeriesRangeQuery(name string, from *time.Time, to *time.Time) string {
+	res := "&name=" + urlUtilsEscapeDataString(name)
+	if from != nil {
+		res += "&from=" + urlUtilsEscapeDataString(Time(from.UTC()).Format())
+	}
+	if to != nil {
+		res += "&to=" + urlUtilsEscapeDataString(Time(to.UTC()).Format())
+	}
+	return res
+}
+
+func (c *GetTimeSeriesCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/timeseries?docId=" + urlUtilsEscapeDataString(c.docID)
+	url += timeSeriesPagingQuery(c.start, c.pageSize)
+	url += timeSeriesRangeQuery(c.name, c.from, c.to)
+	return newHttpGet(url)
+}
+
+func (c *GetTimeSeriesCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -10,4 +10,5 @@ require (
 	github.com/kjk/httplogproxy v0.0.0-20190214011443-6743ea9a2d3d
 	github.com/kylelemons/godebug v0.0.0-20170820004349-d65d576e9348
 	github.com/stretchr/testify v1.3.0
+	golang.org/x/crypto v0.0.0-20211117183948-ae814b36b871
 )
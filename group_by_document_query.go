@@ -40,22 +40,12 @@ func (q *GroupByDocumentQuery) SelectSum(field *GroupByField, fields ...*GroupBy
 		return q.query
 	}
 
-	if field == nil {
-		q.err = newIllegalArgumentError("Field cannot be null")
-	}
-
-	q.err = q.query.groupBySum(field.FieldName, field.ProjectedName)
-	if q.err != nil {
-		q.query.err = q.err
-		return q.query
-	}
-
-	if len(fields) == 0 {
-		return q.query
-	}
-
-	for _, f := range fields {
-		q.err = q.query.groupBySum(f.FieldName, f.ProjectedName)
+	for _, f := range append([]*GroupByField{field}, fields...) {
+		if f == nil {
+			q.err = newIllegalArgumentError("Field cannot be null")
+		} else {
+			q.err = q.query.groupBySum(f.FieldName, f.ProjectedName)
+		}
 		if q.err != nil {
 			q.query.err = q.err
 			break
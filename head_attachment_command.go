@@ -33,19 +33,22 @@ func NewHeadAttachmentCommand(documentID string, name string, changeVector *stri
 		_name:         name,
 		_changeVector: changeVector,
 	}
+	cmd.IsReadRequest = true
+	cmd.CanCache = false
 	return cmd, nil
 }
 
 func (c *HeadAttachmentCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	url := node.URL + "/databases/" + node.Database + "/attachments?id=" + urlUtilsEscapeDataString(c._documentID) + "&name=" + urlUtilsEscapeDataString(c._name)
 
-	request, err := newHttpGet(url)
+	// HEAD so that we only get the headers and not attachment's content
+	request, err := newHttpHead(url)
 	if err != nil {
 		return nil, err
 	}
 
 	if c._changeVector != nil {
-		request.Header.Set(headersIfNoneMatch, *c._changeVector)
+		request.Header.Set(headersIfNoneMatch, "\""+*c._changeVector+"\"")
 	}
 
 	return request, nil
@@ -74,10 +77,15 @@ func (c *HeadAttachmentCommand) processResponse(cache *httpCache, response *http
 	return responseDisposeHandlingAutomatic, nil
 }
 
+// SetResponse is called when the attachment doesn't exist or, if
+// a change vector was provided, when the attachment wasn't modified
 func (c *HeadAttachmentCommand) SetResponse(response []byte, fromCache bool) error {
 	if response != nil {
 		return throwInvalidResponse()
 	}
 	c.Result = ""
+	if c.StatusCode == http.StatusNotModified && c._changeVector != nil {
+		c.Result = *c._changeVector
+	}
 	return nil
 }
@@ -12,6 +12,10 @@ type RangeValue struct {
 	Min     int64
 	Max     int64
 	Current int64 // atomic
+
+	// prefix and tag of the server that handed out this range
+	prefix    string
+	serverTag string
 }
 
 // NewRangeValue creates a new RangeValue
@@ -24,88 +28,221 @@ func NewRangeValue(min int64, max int64) *RangeValue {
 	return res
 }
 
-// HiLoIDGenerator generates document ids server side
+func (r *RangeValue) documentID(id int64) string {
+	if r.serverTag == "" {
+		return fmt.Sprintf("%s%d", r.prefix, id)
+	}
+	return fmt.Sprintf("%s%d-%s", r.prefix, id, r.serverTag)
+}
+
+// HiLoIDGenerator generates document ids server side.
+// It's safe for concurrent use: ids are handed out from the current range
+// with atomic increments and only one goroutine at a time fetches a new
+// range, which is then swapped in atomically
 type HiLoIDGenerator struct {
+	// serializes fetching new ranges and protects _lastBatchSize and _lastRangeDate
 	generatorLock           sync.Mutex
 	_store                  *DocumentStore
 	_tag                    string
-	prefix                  string
 	_lastBatchSize          int64
 	_lastRangeDate          time.Time
 	_dbName                 string
 	_identityPartsSeparator string
-	_range                  *RangeValue
-	serverTag               string
+	_range                  atomic.Value // *RangeValue
+
+	// size of the last range we asked for and when we got it,
+	// used when DocumentConventions configure HiLo range sizes
+	capacity     int64
+	lastFetchAt  time.Time
+	metricsState hiLoMetricsState
 }
 
+// HiLoMetrics describes activity of HiLo id generators
+type HiLoMetrics struct {
+	// number of ranges fetched from the server
+	RangesFetched int64
+	// number of ids handed out
+	IDsGenerated int64
+	// size of the most recently fetched range. For metrics summed
+	// over many generators it's the largest of their last ranges
+	LastRangeSize int64
+}
+
+type hiLoMetricsState struct {
+	rangesFetched int64 // atomic
+	idsGenerated  int64 // atomic
+	lastRangeSize int64 // atomic
+}
+
+func (m *HiLoMetrics) add(other HiLoMetrics) {
+	m.RangesFetched += other.RangesFetched
+	m.IDsGenerated += other.IDsGenerated
+	if other.LastRangeSize > m.LastRangeSize {
+		m.LastRangeSize = other.LastRangeSize
+	}
+}
+
+const (
+	// the server never hands out ranges smaller than this
+	hiLoMinRangeSize = 32
+	// a range used up faster than this is considered exhausted too quickly
+	hiLoFastExhaustion = 30 * time.Second
+)
+
 // NewHiLoIDGenerator creates a HiLoIDGenerator
 func NewHiLoIDGenerator(tag string, store *DocumentStore, dbName string, identityPartsSeparator string) *HiLoIDGenerator {
-	return &HiLoIDGenerator{
+	res := &HiLoIDGenerator{
 		_store:                  store,
 		_tag:                    tag,
 		_dbName:                 dbName,
 		_identityPartsSeparator: identityPartsSeparator,
-		_range:                  NewRangeValue(1, 0),
 	}
+	res._range.Store(NewRangeValue(1, 0))
+	return res
 }
 
+func (g *HiLoIDGenerator) currentRange() *RangeValue {
+	return g._range.Load().(*RangeValue)
+}
+
+// GetDocumentIDFromID builds document id from a numeric id, the prefix and
+// the tag of the server that handed out the current range
 func (g *HiLoIDGenerator) GetDocumentIDFromID(nextID int64) string {
-	return fmt.Sprintf("%s%d-%s", g.prefix, nextID, g.serverTag)
+	return g.currentRange().documentID(nextID)
 }
 
 // GenerateDocumentID returns next key
 func (g *HiLoIDGenerator) GenerateDocumentID(entity interface{}) (string, error) {
-	id, err := g.NextID()
+	id, rangev, err := g.nextID()
 	if err != nil {
 		return "", err
 	}
-	return g.GetDocumentIDFromID(id), nil
+	// use the range the id came from, the current range might have changed since
+	return rangev.documentID(id), nil
 }
 
+// NextID returns next numeric id, fetching a new range from the server
+// when the current one is exhausted
 func (g *HiLoIDGenerator) NextID() (int64, error) {
+	id, _, err := g.nextID()
+	return id, err
+}
+
+func (g *HiLoIDGenerator) nextID() (int64, *RangeValue, error) {
 	for {
 		// local range is not exhausted yet
-		rangev := g._range
+		rangev := g.currentRange()
 		id := atomic.AddInt64(&rangev.Current, 1)
 		if id <= rangev.Max {
-			return id, nil
+			atomic.AddInt64(&g.metricsState.idsGenerated, 1)
+			return id, rangev, nil
 		}
 
 		// local range is exhausted , need to get a new range
 		g.generatorLock.Lock()
-		defer g.generatorLock.Unlock()
-
-		id = atomic.LoadInt64(&g._range.Current)
-		if id <= g._range.Max {
-			return id, nil
+		// another goroutine might have fetched a new range while we were waiting
+		if g.currentRange() != rangev {
+			g.generatorLock.Unlock()
+			continue
 		}
-		err := g.GetNextRange()
+		err := g.getNextRange(rangev)
+		g.generatorLock.Unlock()
 		if err != nil {
-			return 0, err
+			return 0, nil, err
 		}
 	}
 }
 
+// GetNextRange asks the server for a new range of ids
 func (g *HiLoIDGenerator) GetNextRange() error {
-	hiloCommand := NewNextHiLoCommand(g._tag, g._lastBatchSize, &g._lastRangeDate,
-		g._identityPartsSeparator, g._range.Max)
+	g.generatorLock.Lock()
+	defer g.generatorLock.Unlock()
+	return g.getNextRange(g.currentRange())
+}
+
+// must be called with generatorLock held
+func (g *HiLoIDGenerator) getNextRange(last *RangeValue) error {
+	lastBatchSize := g._lastBatchSize
+	lastRangeDate := g._lastRangeDate
+	capacity := g.nextCapacity()
+	if capacity > 0 {
+		// the server has no parameter for the size of a range. It doubles
+		// the size of the previous range if it was fetched recently, so we
+		// report a previous range of half the capacity fetched just now.
+		// This depends on how the server sizes ranges and on our clock
+		// agreeing with the server's, so the capacity is only a hint
+		lastBatchSize = (capacity + 1) / 2
+		lastRangeDate = time.Now()
+	}
+	hiloCommand := NewNextHiLoCommand(g._tag, lastBatchSize, &lastRangeDate,
+		g._identityPartsSeparator, last.Max)
 	re := g._store.GetRequestExecutor(g._dbName)
 	if err := re.ExecuteCommand(hiloCommand, nil); err != nil {
 		return err
 	}
 	result := hiloCommand.Result
-	g.prefix = result.Prefix
-	g.serverTag = result.ServerTag
-	g._lastRangeDate = time.Time(*result.LastRangeAt)
+	g._lastRangeDate = result.LastRangeAt.toTime()
 	g._lastBatchSize = result.LastSize
-	g._range = NewRangeValue(result.Low, result.High)
+	g.capacity = capacity
+	g.lastFetchAt = time.Now()
+
+	high := result.High
+	rangev := NewRangeValue(result.Low, high)
+	rangev.prefix = result.Prefix
+	rangev.serverTag = result.ServerTag
+	g._range.Store(rangev)
+
+	atomic.AddInt64(&g.metricsState.rangesFetched, 1)
+	atomic.StoreInt64(&g.metricsState.lastRangeSize, high-result.Low+1)
 	return nil
 }
 
+// nextCapacity returns the size of the next range according to HiLo settings
+// in DocumentConventions or 0 if the server should decide it
+func (g *HiLoIDGenerator) nextCapacity() int64 {
+	conventions := g._store.GetConventions()
+	if !conventions.hasHiLoRangePolicy() {
+		return 0
+	}
+	capacity := g.capacity
+	if capacity == 0 {
+		capacity = conventions.HiLoInitialCapacity
+	} else if conventions.HiLoGrowthFactor > 1 && time.Since(g.lastFetchAt) < hiLoFastExhaustion {
+		capacity = int64(float64(capacity) * conventions.HiLoGrowthFactor)
+	}
+	if max := conventions.HiLoMaxRangeSize; max > 0 && capacity > max {
+		capacity = max
+	}
+	if capacity < hiLoMinRangeSize {
+		capacity = hiLoMinRangeSize
+	}
+	return capacity
+}
+
+// GetMetrics returns metrics of this generator
+func (g *HiLoIDGenerator) GetMetrics() HiLoMetrics {
+	return HiLoMetrics{
+		RangesFetched: atomic.LoadInt64(&g.metricsState.rangesFetched),
+		IDsGenerated:  atomic.LoadInt64(&g.metricsState.idsGenerated),
+		LastRangeSize: atomic.LoadInt64(&g.metricsState.lastRangeSize),
+	}
+}
+
 // ReturnUnusedRange returns unused range to the server
 func (g *HiLoIDGenerator) ReturnUnusedRange() error {
-	curr := atomic.LoadInt64(&g._range.Current)
-	returnCommand, err := NewHiLoReturnCommand(g._tag, curr, g._range.Max)
+	g.generatorLock.Lock()
+	defer g.generatorLock.Unlock()
+
+	rangev := g.currentRange()
+	if rangev.Max == 0 {
+		// we never got a range from the server
+		return nil
+	}
+	curr := atomic.LoadInt64(&rangev.Current)
+	if curr > rangev.Max {
+		curr = rangev.Max
+	}
+	returnCommand, err := NewHiLoReturnCommand(g._tag, curr, rangev.Max)
 	if err != nil {
 		return err
 	}
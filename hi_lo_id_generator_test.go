@@ -0,0 +1,218 @@
+package ravendb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeHiLoServer hands out ranges of size ids (2 by default) and records hilo requests
+type fakeHiLoServer struct {
+	mu        sync.Mutex
+	serverTag string
+	size      int64
+	high      int64
+	next      []url.Values
+	returned  []url.Values
+}
+
+func (s *fakeHiLoServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/hilo/next"):
+		s.next = append(s.next, r.URL.Query())
+		low := s.high + 1
+		if s.size == 0 {
+			s.size = 2
+		}
+		s.high += s.size
+		tag := r.URL.Query().Get("tag")
+		w.Write([]byte(`{"Prefix": "` + tag + `/", "Low": ` + strconv.FormatInt(low, 10) +
+			`, "High": ` + strconv.FormatInt(s.high, 10) + `, "LastSize": 2, "ServerTag": "` + s.serverTag +
+			`", "LastRangeAt": "2020-01-02T03:04:05.0000000Z"}`))
+	case strings.HasSuffix(r.URL.Path, "/hilo/return"):
+		s.returned = append(s.returned, r.URL.Query())
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newHiLoTestStore(t *testing.T, fake *fakeHiLoServer) (*DocumentStore, func()) {
+	server := httptest.NewServer(fake)
+	store := NewDocumentStore([]string{server.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	assert.NoError(t, store.Initialize())
+	return store, func() {
+		store.Close()
+		server.Close()
+	}
+}
+
+func TestHiLoIDGeneratorFetchesRanges(t *testing.T) {
+	fake := &fakeHiLoServer{serverTag: "A"}
+	store, cleanup := newHiLoTestStore(t, fake)
+	defer cleanup()
+
+	generator := NewHiLoIDGenerator("orders&lines", store, "db", "/")
+	for i := 1; i <= 3; i++ {
+		id, err := generator.GenerateDocumentID(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "orders&lines/"+strconv.Itoa(i)+"-A", id)
+	}
+
+	assert.Equal(t, 2, len(fake.next))
+	assert.Equal(t, "orders&lines", fake.next[0].Get("tag"))
+	assert.Equal(t, "/", fake.next[0].Get("identityPartsSeparator"))
+	assert.Equal(t, "", fake.next[0].Get("lastRangeAt"))
+	assert.Equal(t, "0", fake.next[0].Get("lastMax"))
+	assert.Equal(t, "2020-01-02T03:04:05.0000000Z", fake.next[1].Get("lastRangeAt"))
+	assert.Equal(t, "2", fake.next[1].Get("lastBatchSize"))
+	assert.Equal(t, "2", fake.next[1].Get("lastMax"))
+
+	assert.NoError(t, generator.ReturnUnusedRange())
+	assert.Equal(t, 1, len(fake.returned))
+	assert.Equal(t, "orders&lines", fake.returned[0].Get("tag"))
+	assert.Equal(t, "3", fake.returned[0].Get("last"))
+	assert.Equal(t, "4", fake.returned[0].Get("end"))
+}
+
+func TestHiLoIDGeneratorWithoutServerTag(t *testing.T) {
+	fake := &fakeHiLoServer{}
+	store, cleanup := newHiLoTestStore(t, fake)
+	defer cleanup()
+
+	generator := NewHiLoIDGenerator("users", store, "db", "/")
+	// nothing was fetched so there's nothing to return
+	assert.NoError(t, generator.ReturnUnusedRange())
+	assert.Equal(t, 0, len(fake.returned))
+
+	id, err := generator.GenerateDocumentID(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "users/1", id)
+}
+
+func TestDocumentStoreCloseReturnsUnusedHiLoRanges(t *testing.T) {
+	fake := &fakeHiLoServer{serverTag: "A"}
+	store, cleanup := newHiLoTestStore(t, fake)
+	defer cleanup()
+
+	session, err := store.OpenSession("")
+	assert.NoError(t, err)
+	assert.NoError(t, session.Store(&User{}))
+	session.Close()
+
+	store.Close()
+	assert.Equal(t, 1, len(fake.returned))
+	assert.Equal(t, "users", fake.returned[0].Get("tag"))
+	assert.Equal(t, "1", fake.returned[0].Get("last"))
+	assert.Equal(t, "2", fake.returned[0].Get("end"))
+}
+
+func TestHiLoIDGeneratorIsSafeForConcurrentUse(t *testing.T) {
+	fake := &fakeHiLoServer{serverTag: "A"}
+	store, cleanup := newHiLoTestStore(t, fake)
+	defer cleanup()
+
+	generator := NewHiLoIDGenerator("users", store, "db", "/")
+
+	const goroutines = 8
+	const idsPerGoroutine = 25
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	ids := map[string]bool{}
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < idsPerGoroutine; j++ {
+				id, err := generator.GenerateDocumentID(nil)
+				assert.NoError(t, err)
+				mu.Lock()
+				assert.False(t, ids[id], "duplicate id %s", id)
+				ids[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, goroutines*idsPerGoroutine, len(ids))
+	// every range of 2 ids was fetched exactly once
+	assert.Equal(t, goroutines*idsPerGoroutine/2, len(fake.next))
+}
+
+func TestHiLoIDGeneratorRangePolicy(t *testing.T) {
+	fake := &fakeHiLoServer{size: 64}
+	store, cleanup := newHiLoTestStore(t, fake)
+	defer cleanup()
+	conventions := store.GetConventions()
+	conventions.HiLoInitialCapacity = 100
+	conventions.HiLoGrowthFactor = 2
+	conventions.HiLoMaxRangeSize = 40
+
+	generator := NewHiLoIDGenerator("users", store, "db", "/")
+	for i := 0; i < 64; i++ {
+		_, err := generator.NextID()
+		assert.NoError(t, err)
+	}
+	id, err := generator.NextID()
+	assert.NoError(t, err)
+	// a range larger than max range size is used in full
+	assert.Equal(t, int64(65), id)
+
+	assert.Equal(t, 2, len(fake.next))
+	// initial capacity is capped by max range size
+	assert.Equal(t, "20", fake.next[0].Get("lastBatchSize"))
+	assert.NotEqual(t, "", fake.next[0].Get("lastRangeAt"))
+	assert.Equal(t, "20", fake.next[1].Get("lastBatchSize"))
+
+	metrics := generator.GetMetrics()
+	assert.Equal(t, int64(2), metrics.RangesFetched)
+	assert.Equal(t, int64(65), metrics.IDsGenerated)
+	assert.Equal(t, int64(64), metrics.LastRangeSize)
+}
+
+func TestHiLoIDGeneratorGrowsCapacityOnFastExhaustion(t *testing.T) {
+	fake := &fakeHiLoServer{}
+	store, cleanup := newHiLoTestStore(t, fake)
+	defer cleanup()
+	conventions := store.GetConventions()
+	conventions.HiLoGrowthFactor = 1.5
+
+	generator := NewHiLoIDGenerator("users", store, "db", "/")
+	for i := 0; i < 5; i++ {
+		_, err := generator.NextID()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 3, len(fake.next))
+	assert.Equal(t, "16", fake.next[0].Get("lastBatchSize"))
+	assert.Equal(t, "24", fake.next[1].Get("lastBatchSize"))
+	assert.Equal(t, "36", fake.next[2].Get("lastBatchSize"))
+}
+
+func TestDocumentStoreGetHiLoMetrics(t *testing.T) {
+	fake := &fakeHiLoServer{}
+	store, cleanup := newHiLoTestStore(t, fake)
+	defer cleanup()
+
+	assert.Equal(t, HiLoMetrics{}, store.GetHiLoMetrics())
+
+	session, err := store.OpenSession("")
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, session.Store(&User{}))
+	}
+	session.Close()
+
+	metrics := store.GetHiLoMetrics()
+	assert.Equal(t, int64(2), metrics.RangesFetched)
+	assert.Equal(t, int64(3), metrics.IDsGenerated)
+	assert.Equal(t, int64(2), metrics.LastRangeSize)
+}
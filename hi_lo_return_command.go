@@ -42,7 +42,7 @@ func NewHiLoReturnCommand(tag string, last int64, end int64) (*HiLoReturnCommand
 }
 
 func (c *HiLoReturnCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
-	url := node.URL + "/databases/" + node.Database + "/hilo/return?tag=" + c.tag + "&end=" + i64toa(c.end) + "&last=" + i64toa(c.last)
+	url := node.URL + "/databases/" + node.Database + "/hilo/return?tag=" + urlUtilsEscapeDataString(c.tag) + "&end=" + i64toa(c.end) + "&last=" + i64toa(c.last)
 
 	return newHttpPut(url, nil)
 }
@@ -2,6 +2,7 @@ package ravendb
 
 import (
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"net/http"
@@ -76,6 +77,28 @@ func NewHttpPost(uri string, data []byte) (*http.Request, error) {
 	return req, nil
 }
 
+// newHttpPostCompressed is like NewHttpPost but sends data compressed
+// with gzip if compress is true
+func newHttpPostCompressed(uri string, data []byte, compress bool) (*http.Request, error) {
+	if !compress || len(data) == 0 {
+		return NewHttpPost(uri, data)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	req, err := NewHttpPost(uri, buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Encoding", "gzip")
+	return req, nil
+}
+
 func newHttpPut(uri string, data []byte) (*http.Request, error) {
 	var body io.Reader
 	if len(data) > 0 {
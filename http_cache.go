@@ -1,8 +1,7 @@
 package ravendb
 
 import (
-	//"fmt"
-
+	"container/list"
 	"math"
 	"sync"
 	"sync/atomic"
@@ -10,48 +9,120 @@ import (
 )
 
 // equivalent of com.google.common.cache.Cache, specialized for String -> HttpCacheItem mapping
-// TODO: match semantics
+// When total weight of items exceeds maximumWeight, least recently used items are evicted
 type genericCache struct {
-	softValues    bool
 	maximumWeight int
 	weighter      func(string, *httpCacheItem) int
 
-	data *sync.Map
+	mu        sync.Mutex
+	data      map[string]*list.Element
+	lru       *list.List // of *genericCacheEntry, most recently used at the front
+	weight    int
+	evictions int64
+}
+
+type genericCacheEntry struct {
+	uri    string
+	item   *httpCacheItem
+	weight int
+}
+
+func newGenericCache(maximumWeight int, weighter func(string, *httpCacheItem) int) *genericCache {
+	return &genericCache{
+		maximumWeight: maximumWeight,
+		weighter:      weighter,
+		data:          map[string]*list.Element{},
+		lru:           list.New(),
+	}
 }
 
 func (c *genericCache) size() int {
-	// this is probably only ever going to be called from tests
-	// fine to do O(N) work here
-	length := 0
-	c.data.Range(func(_, _ interface{}) bool {
-		length++
-		return true
-	})
-	return length
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.data)
+}
+
+func (c *genericCache) totalWeight() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.weight
 }
 
 func (c *genericCache) invalidateAll() {
-	c.data = &sync.Map{}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data = map[string]*list.Element{}
+	c.lru.Init()
+	c.weight = 0
 }
 
 func (c *genericCache) getIfPresent(uri string) *httpCacheItem {
-	item, found := c.data.Load(uri)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, found := c.data[uri]
 	if !found {
 		return nil
 	}
-	return item.(*httpCacheItem)
+	c.lru.MoveToFront(el)
+	return el.Value.(*genericCacheEntry).item
 }
 
 func (c *genericCache) put(uri string, i *httpCacheItem) {
-	//fmt.Printf("genericCache.put(): url: %s, changeVector: %s, len(result): %d\n", uri, *i.changeVector, len(i.payload))
+	weight := c.weighter(uri, i)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, found := c.data[uri]; found {
+		c.remove(el)
+	}
+	// an item that doesn't fit would evict everything else
+	if weight > c.maximumWeight {
+		return
+	}
+	entry := &genericCacheEntry{
+		uri:    uri,
+		item:   i,
+		weight: weight,
+	}
+	c.data[uri] = c.lru.PushFront(entry)
+	c.weight += weight
+	for c.weight > c.maximumWeight {
+		c.remove(c.lru.Back())
+		c.evictions++
+	}
+}
+
+// must be called with mu held
+func (c *genericCache) remove(el *list.Element) {
+	entry := c.lru.Remove(el).(*genericCacheEntry)
+	delete(c.data, entry.uri)
+	c.weight -= entry.weight
+}
 
-	// TODO: probably implement cache eviction
-	c.data.Store(uri, i)
+// HttpCacheStats describes the state of RequestExecutor's http cache
+type HttpCacheStats struct {
+	// NumberOfItems is the number of cached responses
+	NumberOfItems int
+	// Size is the approximate size in bytes of cached responses
+	Size int
+	// MaxSize is the size limit, see DocumentConventions.MaxHttpCacheSize
+	MaxSize int
+	// Hits counts requests served from the cache, either because server
+	// responded with 304 Not Modified or thanks to aggressive caching
+	Hits int64
+	// Misses counts cacheable requests for which server sent a full response
+	Misses int64
+	// Evictions counts responses removed from the cache to stay within MaxSize
+	Evictions int64
 }
 
 type httpCache struct {
 	items      *genericCache
 	generation int32 // atomic
+	closed     int32 // atomic
+
+	hits   int64 // atomic
+	misses int64 // atomic
 }
 
 func (c *httpCache) incGeneration() {
@@ -63,20 +134,13 @@ func (c *httpCache) getGeneration() int {
 	return int(v)
 }
 
+// newHttpCache creates a cache limited to size bytes. Size 0 disables caching
 func newHttpCache(size int) *httpCache {
-	if size == 0 {
-		size = 1 * 1024 * 1024 // TODO: check what is default size of com.google.common.cache.Cache is
-	}
-	cache := &genericCache{
-		softValues:    true,
-		maximumWeight: size,
-		weighter: func(k string, v *httpCacheItem) int {
-			return len(v.payload) + 20
-		},
-		data: &sync.Map{ },
+	weighter := func(k string, v *httpCacheItem) int {
+		return len(k) + len(v.payload) + 20
 	}
 	return &httpCache{
-		items: cache,
+		items: newGenericCache(size, weighter),
 	}
 }
 
@@ -84,12 +148,44 @@ func (c *httpCache) GetNumberOfItems() int {
 	return c.items.size()
 }
 
+// GetStats returns the number of cached items, their size and hit/miss statistics
+func (c *httpCache) GetStats() HttpCacheStats {
+	c.items.mu.Lock()
+	stats := HttpCacheStats{
+		NumberOfItems: len(c.items.data),
+		Size:          c.items.weight,
+		MaxSize:       c.items.maximumWeight,
+		Evictions:     c.items.evictions,
+	}
+	c.items.mu.Unlock()
+	stats.Hits = atomic.LoadInt64(&c.hits)
+	stats.Misses = atomic.LoadInt64(&c.misses)
+	return stats
+}
+
+func (c *httpCache) recordHit() {
+	atomic.AddInt64(&c.hits, 1)
+}
+
+func (c *httpCache) recordMiss() {
+	atomic.AddInt64(&c.misses, 1)
+}
+
+func (c *httpCache) isClosed() bool {
+	return atomic.LoadInt32(&c.closed) != 0
+}
+
+// close drops all cached items. Requests that are still in flight can
+// use the cache safely but nothing is cached anymore
 func (c *httpCache) close() {
+	atomic.StoreInt32(&c.closed, 1)
 	c.items.invalidateAll()
-	c.items = nil
 }
 
 func (c *httpCache) set(url string, changeVector *string, result []byte) {
+	if c.isClosed() {
+		return
+	}
 	httpCacheItem := newHttpCacheItem()
 	httpCacheItem.changeVector = changeVector
 	httpCacheItem.payload = result
@@ -102,16 +198,15 @@ func (c *httpCache) set(url string, changeVector *string, result []byte) {
 func (c *httpCache) get(url string) (*releaseCacheItem, *string, []byte) {
 	item := c.items.getIfPresent(url)
 	if item != nil {
-		//fmt.Printf("HttpCache.get(): found url: %s, changeVector: %s, len(payload): %d\n", url, *item.changeVector, len(item.payload))
 		return newReleaseCacheItem(item), item.changeVector, item.payload
 	}
-
-	//fmt.Printf("HttpCache.get(): didn't find url: %s\n", url)
 	return newReleaseCacheItem(nil), nil, nil
 }
 
 func (c *httpCache) setNotFound(url string) {
-	//fmt.Printf("HttpCache.setNotFound(): url: %s\n", url)
+	if c.isClosed() {
+		return
+	}
 	httpCacheItem := newHttpCacheItem()
 	s := "404 response"
 	httpCacheItem.changeVector = &s
@@ -133,7 +228,7 @@ func newReleaseCacheItem(item *httpCacheItem) *releaseCacheItem {
 
 func (i *releaseCacheItem) notModified() {
 	if i.item != nil {
-		i.item.lastServerUpdate = time.Now()
+		i.item.setLastServerUpdate(time.Now())
 	}
 }
 
@@ -141,7 +236,7 @@ func (i *releaseCacheItem) getAge() time.Duration {
 	if i.item == nil {
 		return time.Duration(math.MaxInt64)
 	}
-	return time.Since(i.item.lastServerUpdate)
+	return time.Since(i.item.getLastServerUpdate())
 }
 
 func (i *releaseCacheItem) getMightHaveBeenModified() bool {
@@ -1,18 +1,31 @@
 package ravendb
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
 
 type httpCacheItem struct {
 	changeVector     *string // TODO: can probably be string
 	payload          []byte
-	lastServerUpdate time.Time
-	generation       int // TODO: should this be atomicInteger?
+	lastServerUpdate int64 // atomic, UnixNano
+	generation       int
 
 	cache *httpCache
 }
 
 func newHttpCacheItem() *httpCacheItem {
 	return &httpCacheItem{
-		lastServerUpdate: time.Now(),
+		lastServerUpdate: time.Now().UnixNano(),
 	}
 }
+
+func (i *httpCacheItem) getLastServerUpdate() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&i.lastServerUpdate))
+}
+
+// setLastServerUpdate is called when server confirms that the cached
+// response is still valid, which can happen concurrently with reads
+func (i *httpCacheItem) setLastServerUpdate(t time.Time) {
+	atomic.StoreInt64(&i.lastServerUpdate, t.UnixNano())
+}
@@ -0,0 +1,97 @@
+package ravendb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHttpCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newHttpCache(150)
+	cv := "A:1"
+	payload := make([]byte, 40)
+
+	cache.set("a", &cv, payload)
+	cache.set("b", &cv, payload)
+	_, changeVector, _ := cache.get("a")
+	assert.NotNil(t, changeVector)
+	// doesn't fit with both a and b, b was used least recently
+	cache.set("c", &cv, payload)
+
+	_, changeVector, _ = cache.get("b")
+	assert.Nil(t, changeVector)
+	_, changeVector, _ = cache.get("a")
+	assert.NotNil(t, changeVector)
+
+	stats := cache.GetStats()
+	assert.Equal(t, 2, stats.NumberOfItems)
+	assert.Equal(t, 2*(1+40+20), stats.Size)
+	assert.Equal(t, 150, stats.MaxSize)
+	assert.Equal(t, int64(1), stats.Evictions)
+
+	// responses bigger than the whole cache are not cached
+	cache.set("d", &cv, make([]byte, 200))
+	assert.Equal(t, 2, cache.GetNumberOfItems())
+
+	cache.close()
+	cache.set("e", &cv, payload)
+	assert.Equal(t, 0, cache.GetNumberOfItems())
+	assert.Equal(t, 0, cache.GetStats().Size)
+}
+
+func TestHttpCacheDisabled(t *testing.T) {
+	cache := newHttpCache(0)
+	cv := "A:1"
+	cache.set("a", &cv, nil)
+	cache.setNotFound("b")
+	assert.Equal(t, 0, cache.GetNumberOfItems())
+}
+
+func TestRequestExecutorRevalidatesCachedResponses(t *testing.T) {
+	var requests, notModified int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get(headersIfNoneMatch) == `"A:1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set(headersEtag, `"A:1"`)
+		id := r.URL.Query().Get("id")
+		w.Write([]byte(`{"Results": [{"Name": "` + strings.ToUpper(id) + `", "@metadata": {"@id": "` + id + `"}}], "Includes": {}}`))
+	}))
+	defer server.Close()
+
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(server.URL, "db", nil, nil, nil)
+	defer re.Close()
+
+	get := func(id string) *GetDocumentsResult {
+		cmd, err := NewGetDocumentsCommand([]string{id}, nil, false)
+		assert.NoError(t, err)
+		assert.NoError(t, re.ExecuteCommand(cmd, nil))
+		return cmd.Result
+	}
+
+	assert.Equal(t, "USERS/1", get("users/1").Results[0]["Name"])
+	assert.Equal(t, int32(0), atomic.LoadInt32(&notModified))
+	// served from the cache after server confirmed it didn't change
+	assert.Equal(t, "USERS/1", get("users/1").Results[0]["Name"])
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+	assert.Equal(t, "USERS/2", get("users/2").Results[0]["Name"])
+
+	stats := re.GetHttpCacheStats()
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(2), stats.Misses)
+	assert.Equal(t, 2, stats.NumberOfItems)
+
+	// requests that opt out of caching don't use the cache
+	cmd, _ := NewGetDocumentsCommand([]string{"users/1"}, nil, false)
+	assert.NoError(t, re.ExecuteCommand(cmd, &SessionInfo{noCaching: true}))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+	assert.Equal(t, int64(1), re.GetHttpCacheStats().Hits)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+}
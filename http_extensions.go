@@ -2,7 +2,9 @@ package ravendb
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 func gttpExtensionsGetRequiredEtagHeader(response *http.Response) (*string, error) {
@@ -47,3 +49,27 @@ func httpExtensionsGetBooleanHeader(response *http.Response, header string) bool
 	hdr := response.Header.Get(header)
 	return strings.EqualFold(hdr, "true")
 }
+
+// returns a delay from Retry-After header, which is either a number
+// of seconds or an HTTP date. Returns false if header is missing or invalid
+func httpExtensionsGetRetryAfter(response *http.Response, now time.Time) (time.Duration, bool) {
+	hdr := strings.TrimSpace(response.Header.Get(headersRetryAfter))
+	if hdr == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(hdr); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(hdr)
+	if err != nil {
+		return 0, false
+	}
+	d := t.Sub(now)
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
@@ -0,0 +1,40 @@
+package ravendb
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHttpExtensionsGetRetryAfter(t *testing.T) {
+	now := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	newResponse := func(hdr string) *http.Response {
+		rsp := &http.Response{Header: http.Header{}}
+		if hdr != "" {
+			rsp.Header.Set("Retry-After", hdr)
+		}
+		return rsp
+	}
+
+	_, ok := httpExtensionsGetRetryAfter(newResponse(""), now)
+	assert.False(t, ok)
+
+	_, ok = httpExtensionsGetRetryAfter(newResponse("soon"), now)
+	assert.False(t, ok)
+
+	d, ok := httpExtensionsGetRetryAfter(newResponse("3"), now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	date := now.Add(time.Minute).Format(http.TimeFormat)
+	d, ok = httpExtensionsGetRetryAfter(newResponse(date), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, d)
+
+	date = now.Add(-time.Minute).Format(http.TimeFormat)
+	d, ok = httpExtensionsGetRetryAfter(newResponse(date), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+}
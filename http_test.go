@@ -0,0 +1,33 @@
+package ravendb
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHttpPostCompressed(t *testing.T) {
+	data := []byte(`{"Commands": []}`)
+
+	req, err := newHttpPostCompressed("http://127.0.0.1:8080/bulk_docs", data, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "", req.Header.Get("Content-Encoding"))
+
+	req, err = newHttpPostCompressed("http://127.0.0.1:8080/bulk_docs", data, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+	assert.Equal(t, "application/json; charset=UTF-8", req.Header.Get("Content-Type"))
+
+	// the body can be read again when the request is retried on another node
+	for i := 0; i < 2; i++ {
+		body, err := req.GetBody()
+		assert.NoError(t, err)
+		gz, err := gzip.NewReader(body)
+		assert.NoError(t, err)
+		d, err := ioutil.ReadAll(gz)
+		assert.NoError(t, err)
+		assert.Equal(t, data, d)
+	}
+}
@@ -3,6 +3,7 @@ package ravendb
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"sync/atomic"
 	"time"
 )
@@ -55,6 +56,15 @@ type InMemoryDocumentSessionOperations struct {
 	// TODO: ignore case for keys
 	includedDocumentsByID map[string]*documentInfo
 
+	// counters, time series, compare exchange values and revisions included
+	// by queries. Counters and time series are keyed by document id.
+	// countersByDocID and timeSeriesByDocID also cache values read
+	// by the session
+	countersByDocID         map[string]*countersCache
+	timeSeriesByDocID       map[string]map[string][]*TimeSeriesRangeResult
+	includedCompareExchange map[string]*CompareExchangeValue
+	includedRevisionsByCV   map[string]map[string]interface{}
+
 	// hold the data required to manage the data for RavenDB's Unit of Work
 	// Note: in Java it's LinkedHashMap where iteration order is same
 	// as insertion order. In Go map has random iteration order so we must
@@ -72,11 +82,19 @@ type InMemoryDocumentSessionOperations struct {
 	maxNumberOfRequestsPerSession int
 	useOptimisticConcurrency      bool
 
+	transactionMode TransactionMode
+	// compare exchange values tracked in cluster-wide sessions,
+	// created by DocumentSession.ClusterTransaction()
+	clusterSession *ClusterTransactionOperations
+
 	deferredCommands []ICommandData
 
 	// Note: using value type so that lookups are based on value
 	deferredCommandsMap map[idTypeAndName]ICommandData
 
+	// ids of documents for which SaveChanges forces creation of a revision
+	idsForCreatingForcedRevisions map[string]ForceRevisionStrategy
+
 	generateEntityIDOnTheClient *generateEntityIDOnTheClient
 	entityToJSON                *entityToJSON
 
@@ -84,6 +102,10 @@ type InMemoryDocumentSessionOperations struct {
 	// so we can upcast/downcast between them
 	// In Go we need a backlink to reach DocumentSession
 	session *DocumentSession
+
+	// 1 while a session method that reads or modifies session state runs.
+	// Used to detect using the session from multiple goroutines
+	inUse atomicInteger
 }
 
 func newInMemoryDocumentSessionOperations(dbName string, store *DocumentStore, re *RequestExecutor, id string) *InMemoryDocumentSessionOperations {
@@ -94,7 +116,7 @@ func newInMemoryDocumentSessionOperations(dbName string, store *DocumentStore, r
 		deletedEntities:               newObjectSet(),
 		requestExecutor:               re,
 		generateDocumentKeysOnStore:   true,
-		sessionInfo:                   &SessionInfo{SessionID: clientSessionID},
+		sessionInfo:                   newSessionInfo(clientSessionID, re.conventions, dbName),
 		documentsByID:                 newDocumentsByID(),
 		includedDocumentsByID:         map[string]*documentInfo{},
 		documentsByEntity:             []*documentInfo{},
@@ -117,11 +139,18 @@ func (s *InMemoryDocumentSessionOperations) GetCurrentSessionNode() (*ServerNode
 	var result *CurrentIndexAndNode
 	readBalance := s.documentStore.GetConventions().ReadBalanceBehavior
 	var err error
+	if s.sessionInfo.canUseLoadBalanceBehavior && s.requestExecutor.getLoadBalanceBehavior() == LoadBalanceBehaviorUseSessionContext {
+		result, err = s.requestExecutor.getNodeBySessionID(s.sessionInfo.getSessionID())
+		if err != nil {
+			return nil, err
+		}
+		return result.currentNode, nil
+	}
 	switch readBalance {
 	case ReadBalanceBehaviorNone:
 		result, err = s.requestExecutor.getPreferredNode()
 	case ReadBalanceBehaviorRoundRobin:
-		result, err = s.requestExecutor.getNodeBySessionID(s.clientSessionID)
+		result, err = s.requestExecutor.getNodeBySessionID(s.sessionInfo.getSessionID())
 	case ReadBalanceBehaviorFastestNode:
 		result, err = s.requestExecutor.getFastestNode()
 	default:
@@ -200,6 +229,24 @@ func (s *InMemoryDocumentSessionOperations) GetNumberOfEntitiesInUnitOfWork() in
 	return len(s.documentsByEntity)
 }
 
+// enter marks the session as being used by operation. Sessions are not safe
+// for concurrent use so it fails if another operation is already running.
+// Every successful enter must be followed by exit
+func (s *InMemoryDocumentSessionOperations) enter(operation string) error {
+	if s.inUse.compareAndSet(0, 1) {
+		return nil
+	}
+	err := newConcurrentSessionUsageError(operation)
+	if s.requestExecutor != nil && s.requestExecutor.conventions.PanicOnConcurrentSessionUsage {
+		panic(err)
+	}
+	return err
+}
+
+func (s *InMemoryDocumentSessionOperations) exit() {
+	s.inUse.set(0)
+}
+
 // GetConventions returns DocumentConventions
 func (s *InMemoryDocumentSessionOperations) GetConventions() *DocumentConventions {
 	return s.requestExecutor.conventions
@@ -483,6 +530,23 @@ func (s *InMemoryDocumentSessionOperations) TrackEntity(result interface{}, id s
 	return nil
 }
 
+// registerExternalLoadedIntoTheSession starts tracking a document that was
+// loaded outside of the session, e.g. received in a subscription batch
+func (s *InMemoryDocumentSessionOperations) registerExternalLoadedIntoTheSession(info *documentInfo) error {
+	if existing := s.documentsByID.getValue(info.id); existing != nil {
+		if existing.entity == info.entity {
+			return nil
+		}
+		return newIllegalStateError("The document %s is already in the session with a different entity instance", info.id)
+	}
+	if existing := getDocumentInfoByEntity(s.documentsByEntity, info.entity); existing != nil {
+		return newIllegalStateError("Attempted to load an entity with id %s, but the entity instance already exists in the session with id: %s", info.id, existing.id)
+	}
+	s.documentsByID.add(info)
+	setDocumentInfo(&s.documentsByEntity, info)
+	return nil
+}
+
 // will convert **Foo => *Foo if tp is *Foo and o is **Foo
 // TODO: probably there's a better way
 // Test case: TestCachingOfDocumentInclude.cofi_can_avoid_using_server_for_multiload_with_include_if_everything_is_in_session_cache
@@ -501,6 +565,11 @@ func matchValueToType(o interface{}, tp reflect.Type) interface{} {
 
 // Delete marks the specified entity for deletion. The entity will be deleted when SaveChanges is called.
 func (s *InMemoryDocumentSessionOperations) Delete(entity interface{}) error {
+	if err := s.enter("Delete"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	err := checkValidEntityIn(entity, "entity")
 	if err != nil {
 		return err
@@ -520,6 +589,11 @@ func (s *InMemoryDocumentSessionOperations) Delete(entity interface{}) error {
 // DeleteByID marks the specified entity for deletion. The entity will be deleted when SaveChanges is called.
 // WARNING: This method will not call beforeDelete listener!
 func (s *InMemoryDocumentSessionOperations) DeleteByID(id string, expectedChangeVector string) error {
+	if err := s.enter("DeleteByID"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	if id == "" {
 		return newIllegalArgumentError("id cannot be empty")
 	}
@@ -632,6 +706,9 @@ func (s *InMemoryDocumentSessionOperations) Store(entity interface{}) error {
 }
 
 // StoreWithID stores  entity in the session, explicitly specifying its Id. The entity will be saved when SaveChanges is called.
+// An id ending with "|" (e.g. "orders|") asks the server to generate the id from identity
+// and an id ending with "/" asks the server to generate the id from the database etag.
+// The generated id is set on the entity after SaveChanges.
 func (s *InMemoryDocumentSessionOperations) StoreWithID(entity interface{}, id string) error {
 	err := checkValidEntityIn(entity, "entity")
 	if err != nil {
@@ -661,6 +738,11 @@ func (s *InMemoryDocumentSessionOperations) rememberEntityForDocumentIdGeneratio
 }
 
 func (s *InMemoryDocumentSessionOperations) storeInternal(entity interface{}, changeVector string, id string, forceConcurrencyCheck ConcurrencyCheckMode) error {
+	if err := s.enter("Store"); err != nil {
+		return err
+	}
+	defer s.exit()
+
 	value := getDocumentInfoByEntity(s.documentsByEntity, entity)
 	if value != nil {
 		if changeVector != "" {
@@ -756,8 +838,21 @@ func (s *InMemoryDocumentSessionOperations) assertNoNonUniqueInstance(entity int
 	return newNonUniqueObjectError("Attempted to associate a different object with id '" + id + "'.")
 }
 
+// GetTransactionMode returns the transaction mode the session was opened with
+func (s *InMemoryDocumentSessionOperations) GetTransactionMode() TransactionMode {
+	if s.transactionMode == "" {
+		return TransactionModeSingleNode
+	}
+	return s.transactionMode
+}
+
 func (s *InMemoryDocumentSessionOperations) prepareForSaveChanges() (*saveChangesData, error) {
 	result := newSaveChangesData(s)
+	if s.transactionMode == TransactionModeClusterWide {
+		if err := s.validateClusterTransaction(result); err != nil {
+			return nil, err
+		}
+	}
 
 	s.deferredCommands = nil
 	s.deferredCommandsMap = make(map[idTypeAndName]ICommandData)
@@ -779,11 +874,35 @@ func (s *InMemoryDocumentSessionOperations) prepareForSaveChanges() (*saveChange
 			result.deferredCommandsMap[k] = v
 		}
 		s.deferredCommands = nil
-		s.deferredCommandsMap = nil
+		s.deferredCommandsMap = make(map[idTypeAndName]ICommandData)
+	}
+
+	s.prepareForCreatingRevisionsFromIDs(result)
+
+	if s.clusterSession != nil {
+		commands, err := s.clusterSession.prepareCommands()
+		if err != nil {
+			return nil, err
+		}
+		result.deferredCommands = append(result.deferredCommands, commands...)
 	}
 	return result, nil
 }
 
+func (s *InMemoryDocumentSessionOperations) validateClusterTransaction(result *saveChangesData) error {
+	if s.useOptimisticConcurrency {
+		return newIllegalStateError("useOptimisticConcurrency is not supported with TransactionMode set to %s", TransactionModeClusterWide)
+	}
+	for _, command := range result.deferredCommands {
+		switch command.getType() {
+		case CommandPut, CommandDelete:
+		default:
+			return newIllegalStateError("The command '%s' is not supported in a cluster session", command.getType())
+		}
+	}
+	return nil
+}
+
 func (s *InMemoryDocumentSessionOperations) UpdateMetadataModifications(documentInfo *documentInfo) bool {
 	dirty := false
 	metadataInstance := documentInfo.metadataInstance
@@ -872,6 +991,23 @@ func (s *InMemoryDocumentSessionOperations) prepareForEntitiesDeletion(result *s
 	return nil
 }
 
+// prepareForCreatingRevisionsFromIDs adds commands forcing creation of
+// revisions of documents that are not modified by SaveChanges
+func (s *InMemoryDocumentSessionOperations) prepareForCreatingRevisionsFromIDs(result *saveChangesData) {
+	ids := make([]string, 0, len(s.idsForCreatingForcedRevisions))
+	for id, strategy := range s.idsForCreatingForcedRevisions {
+		if strategy != ForceRevisionStrategyNone {
+			ids = append(ids, id)
+		}
+	}
+	// for deterministic order of commands
+	sort.Strings(ids)
+	for _, id := range ids {
+		result.deferredCommands = append(result.deferredCommands, NewForceRevisionCommandData(id))
+	}
+	s.idsForCreatingForcedRevisions = nil
+}
+
 func (s *InMemoryDocumentSessionOperations) prepareForEntitiesPuts(result *saveChangesData) error {
 	for _, entityValue := range s.documentsByEntity {
 		if entityValue.ignoreChanges {
@@ -911,6 +1047,10 @@ func (s *InMemoryDocumentSessionOperations) prepareForEntitiesPuts(result *saveC
 			}
 		}
 
+		if err := s.checkDocumentSize(entityValue, document); err != nil {
+			return err
+		}
+
 		entityValue.newDocument = false
 		result.addEntity(entityKey)
 
@@ -935,11 +1075,47 @@ func (s *InMemoryDocumentSessionOperations) prepareForEntitiesPuts(result *saveC
 			changeVector = nil // TODO: redundant
 		}
 		cmdData := newPutCommandDataWithJSON(entityValue.id, changeVector, document)
+		if strategy, ok := s.idsForCreatingForcedRevisions[entityValue.id]; ok {
+			// the revision is created by the put
+			delete(s.idsForCreatingForcedRevisions, entityValue.id)
+			cmdData.forceRevisionCreationStrategy = strategy
+		}
 		result.addSessionCommandData(cmdData)
 	}
 	return nil
 }
 
+// checkDocumentSize enforces DocumentConventions.LargeDocumentSize
+func (s *InMemoryDocumentSessionOperations) checkDocumentSize(entityValue *documentInfo, document map[string]interface{}) error {
+	conventions := s.GetConventions()
+	maxSize := conventions.LargeDocumentSize
+	if maxSize <= 0 {
+		return nil
+	}
+	d, err := jsonMarshal(document)
+	if err != nil {
+		return err
+	}
+	if len(d) <= maxSize {
+		return nil
+	}
+
+	collection, _ := entityValue.metadata[MetadataCollection].(string)
+	if collection == "" {
+		collection = conventions.getCollectionName(entityValue.entity)
+	}
+	info := &LargeDocumentInfo{
+		ID:         entityValue.id,
+		Collection: collection,
+		Size:       len(d),
+		MaxSize:    maxSize,
+	}
+	if conventions.OnLargeDocument != nil {
+		return conventions.OnLargeDocument(info)
+	}
+	return newLargeDocumentError(info)
+}
+
 func (s *InMemoryDocumentSessionOperations) throwInvalidModifiedDocumentWithDeferredCommand(resultCommand ICommandData) error {
 	err := newIllegalStateError("Cannot perform save because document " + resultCommand.getId() + " has been modified by the session and is also taking part in deferred " + resultCommand.getType() + " command")
 	return err
@@ -997,10 +1173,13 @@ func (s *InMemoryDocumentSessionOperations) HasChanged(entity interface{}) (bool
 	return s.entityChanged(document, documentInfo, nil), nil
 }
 
+// WaitForReplicationAfterSaveChanges makes the next SaveChanges wait until
+// the changes are replicated to other nodes
 func (s *InMemoryDocumentSessionOperations) WaitForReplicationAfterSaveChanges(options func(*ReplicationWaitOptsBuilder)) {
-	// TODO: what does it do? looks like a no-op
-	builder := &ReplicationWaitOptsBuilder{}
-	options(builder)
+	builder := &ReplicationWaitOptsBuilder{session: s}
+	if options != nil {
+		options(builder)
+	}
 
 	builderOptions := builder.getOptions()
 	if builderOptions.waitForReplicasTimeout == 0 {
@@ -1009,10 +1188,13 @@ func (s *InMemoryDocumentSessionOperations) WaitForReplicationAfterSaveChanges(o
 	builderOptions.waitForReplicas = true
 }
 
+// WaitForIndexesAfterSaveChanges makes the next SaveChanges wait until
+// indexes catch up with the saved changes
 func (s *InMemoryDocumentSessionOperations) WaitForIndexesAfterSaveChanges(options func(*IndexesWaitOptsBuilder)) {
-	// TODO: what does it do? looks like a no-op
-	builder := &IndexesWaitOptsBuilder{}
-	options(builder)
+	builder := &IndexesWaitOptsBuilder{session: s}
+	if options != nil {
+		options(builder)
+	}
 
 	builderOptions := builder.getOptions()
 	if builderOptions.waitForIndexesTimeout == 0 {
@@ -1065,6 +1247,10 @@ func (s *InMemoryDocumentSessionOperations) Clear() {
 	s.documentsByID = nil
 	s.knownMissingIds = nil
 	s.includedDocumentsByID = nil
+	s.countersByDocID = nil
+	s.timeSeriesByDocID = nil
+	s.idsForCreatingForcedRevisions = nil
+	s.clusterSession = nil
 }
 
 // Defer defers commands to be executed on SaveChanges()
@@ -1081,9 +1267,10 @@ func (s *InMemoryDocumentSessionOperations) deferInternal(command ICommandData)
 	idType = newIDTypeAndName(command.getId(), CommandClientAnyCommand, "")
 	s.deferredCommandsMap[idType] = command
 
+	// those don't modify the document so it can be modified in the same SaveChanges
 	cmdType := command.getType()
-	isAttachmentCmd := (cmdType == CommandAttachmentPut) || (cmdType == CommandAttachmentDelete)
-	if !isAttachmentCmd {
+	modifiesDocument := cmdType != CommandAttachmentPut && cmdType != CommandAttachmentDelete && cmdType != CommandCounters && cmdType != CommandTimeSeries
+	if modifiesDocument {
 		idType = newIDTypeAndName(command.getId(), CommandClientNotAttachment, "")
 		s.deferredCommandsMap[idType] = command
 	}
@@ -1134,6 +1321,197 @@ func (s *InMemoryDocumentSessionOperations) registerIncludes(includes map[string
 	}
 }
 
+func (s *InMemoryDocumentSessionOperations) registerQueryIncludes(queryResult *QueryResult) {
+	for docID, counters := range queryResult.CounterIncludes {
+		cache := s.getCountersCache(docID)
+		names, ok := queryResult.IncludedCounterNames[docID]
+		if ok && len(names) == 0 {
+			// all counters of the document were included
+			cache.gotAll = true
+			cache.values = map[string]*int64{}
+		}
+		// requested counters that aren't in the result don't exist
+		for _, name := range names {
+			cache.set(name, nil)
+		}
+		for _, counter := range counters {
+			// nil means the counter doesn't exist
+			if counter != nil {
+				value := counter.TotalValue
+				cache.set(counter.CounterName, &value)
+			}
+		}
+	}
+
+	for docID, series := range queryResult.TimeSeriesIncludes {
+		for name, ranges := range series {
+			s.addTimeSeriesRanges(docID, name, ranges...)
+		}
+	}
+
+	for key, raw := range queryResult.CompareExchangeValueIncludes {
+		if s.includedCompareExchange == nil {
+			s.includedCompareExchange = map[string]*CompareExchangeValue{}
+		}
+		index, _ := jsonGetAsInt64(raw, "Index")
+		var value interface{}
+		if m, ok := raw["Value"].(map[string]interface{}); ok {
+			value = m["Object"]
+		}
+		s.includedCompareExchange[key] = NewCompareExchangeValue(key, index, value)
+	}
+
+	for _, raw := range queryResult.RevisionIncludes {
+		changeVector, _ := jsonGetAsText(raw, "ChangeVector")
+		revision, ok := raw["Revision"].(map[string]interface{})
+		if changeVector == "" || !ok {
+			continue
+		}
+		if s.includedRevisionsByCV == nil {
+			s.includedRevisionsByCV = map[string]map[string]interface{}{}
+		}
+		s.includedRevisionsByCV[changeVector] = revision
+	}
+}
+
+// GetIncludedCounters returns values of counters of a document included by
+// queries. Counters that don't exist are not in the map
+func (s *InMemoryDocumentSessionOperations) GetIncludedCounters(documentID string) map[string]int64 {
+	return s.countersByDocID[documentID].getAll()
+}
+
+// getCountersCache returns counters cache of a document, creating it if needed
+func (s *InMemoryDocumentSessionOperations) getCountersCache(documentID string) *countersCache {
+	if s.countersByDocID == nil {
+		s.countersByDocID = map[string]*countersCache{}
+	}
+	cache := s.countersByDocID[documentID]
+	if cache == nil {
+		cache = &countersCache{
+			values: map[string]*int64{},
+		}
+		s.countersByDocID[documentID] = cache
+	}
+	return cache
+}
+
+// registerCountersBatchResult updates counters cache with values
+// returned by the server after SaveChanges
+func (s *InMemoryDocumentSessionOperations) registerCountersBatchResult(batchResult map[string]interface{}) {
+	docID, _ := jsonGetAsText(batchResult, "Id")
+	detail, ok := batchResult["CountersDetail"].(map[string]interface{})
+	if docID == "" || !ok {
+		return
+	}
+	counters, _ := detail["Counters"].([]interface{})
+	cache := s.getCountersCache(docID)
+	for _, v := range counters {
+		counter, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := jsonGetAsText(counter, "CounterName")
+		value, ok := jsonGetAsInt64(counter, "TotalValue")
+		if name != "" && ok {
+			cache.set(name, &value)
+		}
+	}
+}
+
+// GetIncludedTimeSeries returns ranges of a time series of a document
+// included by queries or read by the session
+func (s *InMemoryDocumentSessionOperations) GetIncludedTimeSeries(documentID string, name string) []*TimeSeriesRangeResult {
+	return s.timeSeriesByDocID[documentID][name]
+}
+
+// addTimeSeriesRanges adds ranges of a time series to the cache
+func (s *InMemoryDocumentSessionOperations) addTimeSeriesRanges(documentID string, name string, ranges ...*TimeSeriesRangeResult) {
+	if s.timeSeriesByDocID == nil {
+		s.timeSeriesByDocID = map[string]map[string][]*TimeSeriesRangeResult{}
+	}
+	cache := s.timeSeriesByDocID[documentID]
+	if cache == nil {
+		cache = map[string][]*TimeSeriesRangeResult{}
+		s.timeSeriesByDocID[documentID] = cache
+	}
+	cache[name] = append(cache[name], ranges...)
+}
+
+// registerTimeSeriesBatchResult evicts cached time series of a document
+// modified by SaveChanges. The server doesn't return the new entries
+func (s *InMemoryDocumentSessionOperations) registerTimeSeriesBatchResult(batchResult map[string]interface{}) {
+	docID, _ := jsonGetAsText(batchResult, "Id")
+	delete(s.timeSeriesByDocID, docID)
+}
+
+// forceRevisionCreationFor makes SaveChanges create a revision of document id
+func (s *InMemoryDocumentSessionOperations) forceRevisionCreationFor(id string, strategy ForceRevisionStrategy) error {
+	if stringIsBlank(id) {
+		return newIllegalArgumentError("Id cannot be null or empty")
+	}
+	if existing, ok := s.idsForCreatingForcedRevisions[id]; ok && existing != strategy {
+		return newIllegalStateError("A request for creating a revision was already made for document %s in the current session but with a different force strategy. New strategy requested: %s. Previous strategy: %s.", id, strategy, existing)
+	}
+	if s.idsForCreatingForcedRevisions == nil {
+		s.idsForCreatingForcedRevisions = map[string]ForceRevisionStrategy{}
+	}
+	s.idsForCreatingForcedRevisions[id] = strategy
+	return nil
+}
+
+// registerForceRevisionResult updates the change vector and metadata of
+// a tracked document after SaveChanges created its revision
+func (s *InMemoryDocumentSessionOperations) registerForceRevisionResult(batchResult map[string]interface{}) {
+	if created, _ := jsonGetAsBool(batchResult, "RevisionCreated"); !created {
+		// the revision already existed
+		return
+	}
+	id, _ := jsonGetAsText(batchResult, MetadataID)
+	changeVector := jsonGetAsTextPointer(batchResult, MetadataChangeVector)
+	if id == "" || changeVector == nil || s.documentsByID == nil {
+		return
+	}
+	documentInfo := s.documentsByID.getValue(id)
+	if documentInfo == nil || documentInfo.metadata == nil {
+		return
+	}
+	documentInfo.changeVector = changeVector
+	for propertyName, v := range batchResult {
+		if propertyName == "Type" || propertyName == "RevisionCreated" {
+			continue
+		}
+		documentInfo.metadata[propertyName] = v
+	}
+	documentInfo.metadataInstance = nil
+	if documentInfo.document != nil {
+		documentInfo.document[MetadataKey] = documentInfo.metadata
+	}
+}
+
+// GetIncludedCompareExchangeValue returns a compare exchange value included
+// by queries or nil if it wasn't included
+func (s *InMemoryDocumentSessionOperations) GetIncludedCompareExchangeValue(key string) *CompareExchangeValue {
+	return s.includedCompareExchange[key]
+}
+
+// GetIncludedRevision sets result to a revision with a given change vector
+// included by queries. Returns false if the revision wasn't included
+func (s *InMemoryDocumentSessionOperations) GetIncludedRevision(changeVector string, result interface{}) (bool, error) {
+	document, ok := s.includedRevisionsByCV[changeVector]
+	if !ok {
+		return false, nil
+	}
+	if err := checkValidLoadArg(result, "result"); err != nil {
+		return false, err
+	}
+	metadata, _ := document[MetadataKey].(map[string]interface{})
+	id, _ := jsonGetAsText(metadata, MetadataID)
+	if err := queryOperationDeserialize(result, id, document, metadata, nil, true, s); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (s *InMemoryDocumentSessionOperations) registerMissingIncludes(results []map[string]interface{}, includes map[string]interface{}, includePaths []string) {
 	if len(includePaths) == 0 {
 		return
@@ -1305,15 +1683,17 @@ func copyDeferredCommandsMap(in map[idTypeAndName]ICommandData) map[idTypeAndNam
 	return res
 }
 
+// ReplicationWaitOptsBuilder configures waiting for replication after SaveChanges
 type ReplicationWaitOptsBuilder struct {
-	saveChangesOptions *BatchOptions
+	session *InMemoryDocumentSessionOperations
 }
 
+// getOptions returns save changes options of the session, creating them if needed
 func (b *ReplicationWaitOptsBuilder) getOptions() *BatchOptions {
-	if b.saveChangesOptions == nil {
-		b.saveChangesOptions = NewBatchOptions()
+	if b.session.saveChangesOptions == nil {
+		b.session.saveChangesOptions = NewBatchOptions()
 	}
-	return b.saveChangesOptions
+	return b.session.saveChangesOptions
 }
 
 func (b *ReplicationWaitOptsBuilder) WithTimeout(timeout time.Duration) *ReplicationWaitOptsBuilder {
@@ -1336,26 +1716,26 @@ func (b *ReplicationWaitOptsBuilder) Majority(waitForMajority bool) *Replication
 	return b
 }
 
+// IndexesWaitOptsBuilder configures waiting for indexes after SaveChanges
 type IndexesWaitOptsBuilder struct {
-	saveChangesOptions *BatchOptions
+	session *InMemoryDocumentSessionOperations
 }
 
+// getOptions returns save changes options of the session, creating them if needed
 func (b *IndexesWaitOptsBuilder) getOptions() *BatchOptions {
-	if b.saveChangesOptions == nil {
-		b.saveChangesOptions = NewBatchOptions()
+	if b.session.saveChangesOptions == nil {
+		b.session.saveChangesOptions = NewBatchOptions()
 	}
-	return b.saveChangesOptions
+	return b.session.saveChangesOptions
 }
 
 func (b *IndexesWaitOptsBuilder) WithTimeout(timeout time.Duration) *IndexesWaitOptsBuilder {
-	// TODO: most likely a bug and meant waitForIndexesTimeout
-	b.getOptions().waitForReplicasTimeout = timeout
+	b.getOptions().waitForIndexesTimeout = timeout
 	return b
 }
 
 func (b *IndexesWaitOptsBuilder) ThrowOnTimeout(shouldThrow bool) *IndexesWaitOptsBuilder {
-	// TODO: most likely a bug and meant throwOnTimeoutInWaitForIndexes
-	b.getOptions().throwOnTimeoutInWaitForReplicas = shouldThrow
+	b.getOptions().throwOnTimeoutInWaitForIndexes = shouldThrow
 	return b
 }
 
@@ -0,0 +1,71 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionConcurrentUsageDetection(t *testing.T) {
+	conventions := NewDocumentConventions()
+	s := &InMemoryDocumentSessionOperations{
+		requestExecutor: &RequestExecutor{conventions: conventions},
+	}
+
+	assert.NoError(t, s.enter("Load"))
+	err := s.enter("SaveChanges")
+	assert.Error(t, err)
+	if e, ok := err.(*ConcurrentSessionUsageError); assert.True(t, ok) {
+		assert.Equal(t, "SaveChanges", e.Operation)
+	}
+
+	conventions.PanicOnConcurrentSessionUsage = true
+	assert.Panics(t, func() { _ = s.enter("Query") })
+
+	s.exit()
+	assert.NoError(t, s.enter("Query"))
+	s.exit()
+}
+
+func TestSessionMethodsSendingRequestsDetectConcurrentUsage(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:1"}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	assert.NoError(t, store.Initialize())
+	defer store.Close()
+	session, err := store.OpenSession("")
+	assert.NoError(t, err)
+	defer session.Close()
+
+	lazyUser, err := session.Lazily().Load("users/1")
+	assert.NoError(t, err)
+
+	assert.NoError(t, session.enter("Load"))
+	defer session.exit()
+
+	var user *User
+	var users []*User
+	calls := map[string]func() error{
+		"Exists": func() error {
+			_, err := session.Exists("users/1")
+			return err
+		},
+		"Refresh": func() error {
+			return session.Refresh(&User{})
+		},
+		"LoadStartingWith": func() error {
+			return session.LoadStartingWith(&users, &StartsWithArgs{StartsWith: "users/"})
+		},
+		"Load": func() error {
+			return session.Include("Name").Load(&user, "users/1")
+		},
+		"ExecuteAllPendingLazyOperations": func() error {
+			return lazyUser.GetValue(&user)
+		},
+	}
+	for operation, call := range calls {
+		err := call()
+		if e, ok := err.(*ConcurrentSessionUsageError); assert.True(t, ok, "%s: unexpected error %v", operation, err) {
+			assert.Equal(t, operation, e.Operation)
+		}
+	}
+}
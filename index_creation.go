@@ -1,17 +1,19 @@
 package ravendb
 
-func indexCreationCreateIndexesToAdd(indexCreationTasks []*IndexCreationTask, conventions *DocumentConventions) []*IndexDefinition {
+func indexCreationCreateIndexesToAdd(indexCreationTasks []*IndexCreationTask, conventions *DocumentConventions) ([]*IndexDefinition, error) {
 	var res []*IndexDefinition
 	for _, x := range indexCreationTasks {
-		x.Conventions = conventions
-		definition := x.CreateIndexDefinition()
-		definition.Name = x.IndexName
-		pri := x.Priority
-		if pri == "" {
-			pri = IndexPriorityNormal
+		if x == nil {
+			return nil, newIllegalArgumentError("tasks cannot contain nil")
+		}
+		definition, err := x.toIndexDefinition(conventions)
+		if err != nil {
+			return nil, err
+		}
+		if definition.Priority == "" {
+			definition.Priority = IndexPriorityNormal
 		}
-		definition.Priority = pri
 		res = append(res, definition)
 	}
-	return res
+	return res, nil
 }
@@ -1,5 +1,7 @@
 package ravendb
 
+import "strings"
+
 type IndexDefinition struct {
 	Name              string                        `json:"Name"`
 	Priority          IndexPriority                 `json:"Priority,omitempty"`
@@ -14,6 +16,9 @@ type IndexDefinition struct {
 	OutputReduceToCollection *string 		`json:"OutputReduceToCollection"`
 	PatternReferencesCollectionName *string 	`json:"PatternReferencesCollectionName"`
 	PatternForOutputReduceToCollectionReferences *string `json:"PatternForOutputReduceToCollectionReferences"`
+	// AdditionalAssemblies are .NET assemblies and NuGet packages
+	// available to C# index definitions
+	AdditionalAssemblies []*AdditionalAssembly `json:"AdditionalAssemblies,omitempty"`
 }
 
 func toStrPtr(s string) *string {
@@ -101,10 +106,50 @@ func (d *IndexDefinition) SetType(indexType IndexType) {
 }
 
 func (d *IndexDefinition) detectStaticIndexType() IndexType {
-	if d.Reduce == nil || stringIsBlank(*d.Reduce) {
-		return IndexTypeMap
+	isMapReduce := d.Reduce != nil && !stringIsBlank(*d.Reduce)
+	if len(d.Maps) > 0 && isJavaScriptIndexMap(d.Maps[0]) {
+		if isMapReduce {
+			return IndexTypeJavaScriptMapReduce
+		}
+		return IndexTypeJavaScriptMap
+	}
+	if isMapReduce {
+		return IndexTypeMapReduce
+	}
+	return IndexTypeMap
+}
+
+// isJavaScriptIndexMap returns true if map is a JavaScript function like
+// map('Users', u => ({ Name: u.Name })) and not a C# LINQ expression,
+// which starts with "from" or "docs"
+func isJavaScriptIndexMap(smap string) bool {
+	smap = stripLeadingComments(smap)
+	if smap == "" {
+		return false
+	}
+	return !strings.HasPrefix(smap, "from") && !strings.HasPrefix(smap, "docs")
+}
+
+func stripLeadingComments(s string) string {
+	for {
+		s = strings.TrimSpace(s)
+		switch {
+		case strings.HasPrefix(s, "//"):
+			idx := strings.Index(s, "\n")
+			if idx < 0 {
+				return ""
+			}
+			s = s[idx+1:]
+		case strings.HasPrefix(s, "/*"):
+			idx := strings.Index(s, "*/")
+			if idx < 0 {
+				return ""
+			}
+			s = s[idx+2:]
+		default:
+			return s
+		}
 	}
-	return IndexTypeMapReduce
 }
 
 //TBD 4.1  bool isTestIndex()
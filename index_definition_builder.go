@@ -47,7 +47,6 @@ func (d *IndexDefinitionBuilder) toIndexDefinition(conventions *DocumentConventi
 	indexDefinition.LockMode = d.lockMode
 	indexDefinition.Priority = d.priority
 	indexDefinition.SetOutputReduceToCollection(d.outputReduceToCollection)
-	indexDefinition.updateIndexTypeAndMaps()
 
 	suggestions := make(map[string]bool)
 	for _, suggestionsOption := range d.suggestionsOptions {
@@ -100,6 +99,7 @@ func (d *IndexDefinitionBuilder) toIndexDefinition(conventions *DocumentConventi
 	if d.smap != "" {
 		indexDefinition.Maps = append(indexDefinition.Maps, d.smap)
 	}
+	indexDefinition.updateIndexTypeAndMaps()
 
 	indexDefinition.SetAdditionalSources(d.additionalSources)
 	return indexDefinition
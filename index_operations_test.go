@@ -0,0 +1,92 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnableDisableIndexCommandURL(t *testing.T) {
+	node := &ServerNode{URL: "http://localhost:8080", Database: "db"}
+
+	disable, err := NewDisableIndexOperation("Users/ByName")
+	assert.NoError(t, err)
+	cmd, err := disable.GetCommand(nil)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/admin/indexes/disable?name=Users%2FByName", req.URL.String())
+
+	enable, err := NewEnableIndexOperationClusterWide("Users/ByName")
+	assert.NoError(t, err)
+	cmd, err = enable.GetCommand(nil)
+	assert.NoError(t, err)
+	req, err = cmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/admin/indexes/enable?name=Users%2FByName&clusterWide=true", req.URL.String())
+
+	_, err = NewDisableIndexOperationClusterWide("")
+	assert.Error(t, err)
+}
+
+func TestIndexMonitoringCommands(t *testing.T) {
+	node := &ServerNode{URL: "http://localhost:8080", Database: "db"}
+
+	errorsCmd := NewGetIndexErrorsCommand([]string{"Users/ByName", "Orders"})
+	req, err := errorsCmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/indexes/errors?name=Users%2FByName&name=Orders", req.URL.String())
+
+	perfCmd := NewGetIndexPerformanceStatisticsCommand(nil)
+	req, err = perfCmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/indexes/performance", req.URL.String())
+
+	js := `{"Results":[{"Name":"Orders","Performance":[{"Id":1,"Started":"2018-01-01T10:00:00.0000000Z","DurationInMs":12.5,"InputCount":10,"SuccessCount":10,"Details":{"Name":"Indexing","DurationInMs":12.5,"Operations":[{"Name":"Map","DurationInMs":3}]}}]}]}`
+	err = perfCmd.SetResponse([]byte(js), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(perfCmd.Result))
+	stats := perfCmd.Result[0].Performance[0]
+	assert.Equal(t, 10, stats.SuccessCount)
+	assert.Nil(t, stats.Completed)
+	assert.Equal(t, "Map", stats.Details.Operations[0].Name)
+
+	_, err = NewGetIndexStalenessCommand("")
+	assert.Error(t, err)
+	stalenessCmd, err := NewGetIndexStalenessCommand("Orders")
+	assert.NoError(t, err)
+	err = stalenessCmd.SetResponse([]byte(`{"IsStale":true,"StalenessReasons":["There are still 5 documents to process"]}`), false)
+	assert.NoError(t, err)
+	assert.True(t, stalenessCmd.Result.IsStale)
+	assert.Equal(t, 1, len(stalenessCmd.Result.StalenessReasons))
+}
+
+func TestSetIndexesLockAndPriorityValidation(t *testing.T) {
+	_, err := NewSetIndexesLockOperation("Users/ByName", "Locked")
+	assert.Error(t, err)
+	_, err = NewSetIndexesLockOperationWithParameters(&SetIndexesLockParameters{Mode: IndexLockModeLockedError})
+	assert.Error(t, err)
+	_, err = NewSetIndexesLockOperationWithParameters(&SetIndexesLockParameters{
+		IndexNames: []string{"Users/ByName", "Auto/Users/ByAge"},
+		Mode:       IndexLockModeLockedError,
+	})
+	assert.Error(t, err)
+	_, err = NewSetIndexesLockOperationWithParameters(&SetIndexesLockParameters{
+		IndexNames: []string{"Users/ByName", "Orders"},
+		Mode:       IndexLockModeLockedError,
+	})
+	assert.NoError(t, err)
+
+	_, err = NewSetIndexesPriorityOperation("Users/ByName", "Highest")
+	assert.Error(t, err)
+	_, err = NewSetIndexesPriorityOperationWithParameters(nil)
+	assert.Error(t, err)
+	op, err := NewSetIndexesPriorityOperationWithParameters(&SetIndexesPriorityParameters{
+		IndexNames: []string{"Users/ByName", "Orders"},
+		Priority:   IndexPriorityLow,
+	})
+	assert.NoError(t, err)
+	cmd, err := op.GetCommand(NewDocumentConventions())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"IndexNames":["Users/ByName","Orders"],"Priority":"Low"}`, string(cmd.(*SetIndexesPriorityCommand)._parameters))
+}
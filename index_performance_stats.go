@@ -0,0 +1,30 @@
+package ravendb
+
+// IndexPerformanceStats describes recent indexing batches of an index
+type IndexPerformanceStats struct {
+	Name        string                      `json:"Name"`
+	Performance []*IndexingPerformanceStats `json:"Performance"`
+}
+
+// IndexingPerformanceStats describes a single indexing batch
+type IndexingPerformanceStats struct {
+	ID             int                           `json:"Id"`
+	Started        Time                          `json:"Started"`
+	Completed      *Time                         `json:"Completed"`
+	DurationInMs   float64                       `json:"DurationInMs"`
+	InputCount     int                           `json:"InputCount"`
+	SuccessCount   int                           `json:"SuccessCount"`
+	FailedCount    int                           `json:"FailedCount"`
+	OutputCount    int                           `json:"OutputCount"`
+	AllocatedBytes int64                         `json:"AllocatedBytes"`
+	DocumentsSize  int64                         `json:"DocumentsSize"`
+	Details        *IndexingPerformanceOperation `json:"Details"`
+}
+
+// IndexingPerformanceOperation is a timed step of an indexing batch,
+// e.g. "Map" or "Storage/Commit", with its sub-steps
+type IndexingPerformanceOperation struct {
+	Name         string                          `json:"Name"`
+	DurationInMs float64                         `json:"DurationInMs"`
+	Operations   []*IndexingPerformanceOperation `json:"Operations"`
+}
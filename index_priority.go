@@ -1,9 +1,16 @@
 package ravendb
 
-type IndexPriority = string
+type IndexPriority string
 
 const (
-	IndexPriorityLow    = "Low"
-	IndexPriorityNormal = "Normal"
-	IndexPriorityHigh   = "High"
+	IndexPriorityLow    IndexPriority = "Low"
+	IndexPriorityNormal IndexPriority = "Normal"
+	IndexPriorityHigh   IndexPriority = "High"
 )
+
+var indexPriorityValues = []string{string(IndexPriorityLow), string(IndexPriorityNormal), string(IndexPriorityHigh)}
+
+// MarshalJSON returns an error if IndexPriority is not one of the defined values
+func (p IndexPriority) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(p), "IndexPriority", indexPriorityValues)
+}
@@ -18,7 +18,8 @@ type IndexQuery struct {
 	skipDuplicateChecking bool
 
 	// from IndexQuery
-	disableCaching bool
+	disableCaching     bool
+	projectionBehavior ProjectionBehavior
 }
 
 // from IndexQuery
@@ -53,6 +54,7 @@ func (q *IndexQuery) GetQueryHash() string {
 	hasher.write(q.start)
 	hasher.write(q.pageSize)
 	hasher.write(q.queryParameters)
+	hasher.write(q.projectionBehavior)
 	return hasher.getHash()
 }
 
@@ -1,10 +1,17 @@
 package ravendb
 
-type IndexState = string
+type IndexState string
 
 const (
-	IndexStateNormal   = "Normal"
-	IndexStateDisabled = "Disabled"
-	IndexStateIdle     = "Idle"
-	IndexStateError    = "Error"
+	IndexStateNormal   IndexState = "Normal"
+	IndexStateDisabled IndexState = "Disabled"
+	IndexStateIdle     IndexState = "Idle"
+	IndexStateError    IndexState = "Error"
 )
+
+var indexStateValues = []string{string(IndexStateNormal), string(IndexStateDisabled), string(IndexStateIdle), string(IndexStateError)}
+
+// MarshalJSON returns an error if IndexState is not one of the defined values
+func (s IndexState) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(s), "IndexState", indexStateValues)
+}
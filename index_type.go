@@ -9,4 +9,7 @@ const (
 	IndexTypeMap           = "Map"
 	IndexTypeMapReduce     = "MapReduce"
 	IndexTypeFaulty        = "Faulty"
+
+	IndexTypeJavaScriptMap       = "JavaScriptMap"
+	IndexTypeJavaScriptMapReduce = "JavaScriptMapReduce"
 )
@@ -3,6 +3,8 @@ package ravendb
 // JSONArrayResult describes server's JSON response to batch command
 type JSONArrayResult struct {
 	Results []map[string]interface{} `json:"Results"`
+	// set by commands that return a page of results
+	TotalResults int64 `json:"TotalResults"`
 }
 
 func (r *JSONArrayResult) getResults() []map[string]interface{} {
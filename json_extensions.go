@@ -24,6 +24,10 @@ func jsonExtensionsWriteIndexQuery(conventions *DocumentConventions, query *Inde
 		res["DisableCaching"] = query.disableCaching
 	}
 
+	if query.projectionBehavior != "" && query.projectionBehavior != ProjectionBehaviorDefault {
+		res["ProjectionBehavior"] = query.projectionBehavior
+	}
+
 	if query.skipDuplicateChecking {
 		res["SkipDuplicateChecking"] = query.skipDuplicateChecking
 	}
@@ -33,6 +33,17 @@ func jsonUnmarshal(d []byte, v interface{}) error {
 	return json.Unmarshal(d, v)
 }
 
+// enumToJSON encodes value of an enum type typeName, which must be
+// one of valid values or empty (not set).
+// Decoding is not validated, so that values added in newer servers
+// don't fail decoding of a whole response
+func enumToJSON(value string, typeName string, valid []string) ([]byte, error) {
+	if value != "" && !stringArrayContains(valid, value) {
+		return nil, newIllegalArgumentError("invalid %s '%s', must be one of: %s", typeName, value, strings.Join(valid, ", "))
+	}
+	return json.Marshal(value)
+}
+
 func jsonGetAsTextPointer(doc map[string]interface{}, key string) *string {
 	v, ok := doc[key]
 	if !ok {
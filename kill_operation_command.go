@@ -33,3 +33,35 @@ func (c *KillOperationCommand) CreateRequest(node *ServerNode) (*http.Request, e
 
 	return NewHttpPost(url, nil)
 }
+
+var (
+	_ RavenCommand = &KillServerOperationCommand{}
+)
+
+// KillServerOperationCommand kills a server-wide operation
+type KillServerOperationCommand struct {
+	RavenCommandBase
+
+	id string
+}
+
+// NewKillServerOperationCommand returns new KillServerOperationCommand
+func NewKillServerOperationCommand(id string) (*KillServerOperationCommand, error) {
+	if id == "" {
+		return nil, newIllegalArgumentError("id cannot be empty")
+	}
+	cmd := &KillServerOperationCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		id: id,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+
+	return cmd, nil
+}
+
+func (c *KillServerOperationCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/admin/operations/kill?id=" + c.id
+
+	return NewHttpPost(url, nil)
+}
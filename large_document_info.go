@@ -0,0 +1,12 @@
+package ravendb
+
+// LargeDocumentInfo describes a document whose serialized size exceeds
+// DocumentConventions.LargeDocumentSize
+type LargeDocumentInfo struct {
+	ID         string
+	Collection string
+	// Size of the serialized document in bytes
+	Size int
+	// MaxSize is DocumentConventions.LargeDocumentSize at the time of the check
+	MaxSize int
+}
@@ -0,0 +1,19 @@
+package ravendb
+
+// LoadBalanceBehavior defines how requests of a session are spread over nodes
+type LoadBalanceBehavior string
+
+const (
+	LoadBalanceBehaviorNone LoadBalanceBehavior = "None"
+	// requests of sessions with the same context go to the same node,
+	// see DocumentConventions.LoadBalancerPerSessionContextSelector and
+	// SessionInfo.SetContext
+	LoadBalanceBehaviorUseSessionContext LoadBalanceBehavior = "UseSessionContext"
+)
+
+var loadBalanceBehaviorValues = []string{string(LoadBalanceBehaviorNone), string(LoadBalanceBehaviorUseSessionContext)}
+
+// MarshalJSON returns an error if LoadBalanceBehavior is not one of the defined values
+func (l LoadBalanceBehavior) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(l), "LoadBalanceBehavior", loadBalanceBehaviorValues)
+}
@@ -1,6 +1,10 @@
 package ravendb
 
-import "strings"
+import (
+	"context"
+	"strings"
+	"time"
+)
 
 type MaintenanceOperationExecutor struct {
 	store                   *DocumentStore
@@ -9,6 +13,7 @@ type MaintenanceOperationExecutor struct {
 	serverOperationExecutor *ServerOperationExecutor
 }
 
+// Deprecated: use operations.NewMaintenanceOperationExecutor.
 func NewMaintenanceOperationExecutor(store *DocumentStore, databaseName string) *MaintenanceOperationExecutor {
 
 	res := &MaintenanceOperationExecutor{
@@ -46,6 +51,11 @@ func (e *MaintenanceOperationExecutor) ForDatabase(databaseName string) *Mainten
 }
 
 func (e *MaintenanceOperationExecutor) Send(operation IMaintenanceOperation) error {
+	return e.SendWithContext(context.Background(), operation)
+}
+
+// SendWithContext is like Send but gives up when ctx is cancelled
+func (e *MaintenanceOperationExecutor) SendWithContext(ctx context.Context, operation IMaintenanceOperation) error {
 	if err := e.assertDatabaseNameSet(); err != nil {
 		return err
 	}
@@ -53,10 +63,16 @@ func (e *MaintenanceOperationExecutor) Send(operation IMaintenanceOperation) err
 	if err != nil {
 		return err
 	}
-	return e.GetRequestExecutor().ExecuteCommand(command, nil)
+	return e.GetRequestExecutor().ExecuteCommandWithContext(ctx, command, nil)
 }
 
 func (e *MaintenanceOperationExecutor) SendAsync(operation IMaintenanceOperation) (*Operation, error) {
+	return e.SendAsyncWithContext(context.Background(), operation)
+}
+
+// SendAsyncWithContext is like SendAsync but gives up when ctx is cancelled.
+// ctx only applies to starting the operation, not to waiting for its completion
+func (e *MaintenanceOperationExecutor) SendAsyncWithContext(ctx context.Context, operation IMaintenanceOperation) (*Operation, error) {
 	if err := e.assertDatabaseNameSet(); err != nil {
 		return nil, err
 	}
@@ -64,7 +80,7 @@ func (e *MaintenanceOperationExecutor) SendAsync(operation IMaintenanceOperation
 	if err != nil {
 		return nil, err
 	}
-	if err = e.GetRequestExecutor().ExecuteCommand(command, nil); err != nil {
+	if err = e.GetRequestExecutor().ExecuteCommandWithContext(ctx, command, nil); err != nil {
 		return nil, err
 	}
 	fn := func() *DatabaseChanges {
@@ -72,7 +88,9 @@ func (e *MaintenanceOperationExecutor) SendAsync(operation IMaintenanceOperation
 	}
 	re := e.GetRequestExecutor()
 	id := getCommandOperationIDResult(command)
-	return NewOperation(re, fn, re.GetConventions(), id.OperationID), nil
+	op := NewOperation(re, fn, re.GetConventions(), id.OperationID)
+	op.nodeTag = id.OperationNodeTag
+	return op, nil
 }
 
 func (e *MaintenanceOperationExecutor) assertDatabaseNameSet() error {
@@ -81,3 +99,69 @@ func (e *MaintenanceOperationExecutor) assertDatabaseNameSet() error {
 	}
 	return nil
 }
+
+// WaitForIndexing polls database statistics until none of the given indexes
+// (or, if none are given, no index in the database) are stale.
+// Returns TimeoutError if ctx is done before that and IndexInvalidError
+// if one of the indexes is in error state.
+// database is optional, defaults to executor's database.
+func (e *MaintenanceOperationExecutor) WaitForIndexing(ctx context.Context, database string, indexes ...string) error {
+	executor := e
+	if database != "" {
+		executor = e.ForDatabase(database)
+	}
+	if err := executor.assertDatabaseNameSet(); err != nil {
+		return err
+	}
+
+	isWaitedFor := func(name string) bool {
+		if len(indexes) == 0 {
+			return true
+		}
+		name = strings.TrimPrefix(name, IndexingSideBySideIndexNamePrefix)
+		for _, s := range indexes {
+			if strings.EqualFold(s, name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for {
+		op := NewGetStatisticsOperation("")
+		if err := executor.SendWithContext(ctx, op); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return NewTimeoutError("Waiting for indexes was interrupted: %s", ctxErr)
+			}
+			return err
+		}
+
+		var stale, errored []string
+		for _, index := range op.Command.Result.Indexes {
+			if !isWaitedFor(index.Name) || index.State == IndexStateDisabled {
+				continue
+			}
+			if index.IsStale || strings.HasPrefix(index.Name, IndexingSideBySideIndexNamePrefix) {
+				stale = append(stale, index.Name)
+			}
+			if index.State == IndexStateError {
+				errored = append(errored, index.Name)
+			}
+		}
+
+		if len(errored) > 0 {
+			err := &IndexInvalidError{}
+			err.setErrorf("Indexes %s are in error state", strings.Join(errored, ", "))
+			return err
+		}
+		if len(stale) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return NewTimeoutError("The indexes %s stayed stale: %s", strings.Join(stale, ", "), ctx.Err())
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package ravendb
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"time"
+)
+
+const (
+	defaultMigrationsStateDocumentID = "migrations/state"
+	defaultMigrationsLockKey         = "migrations/lock"
+)
+
+// Migration is a single, versioned change to data in a database.
+// Migrations are applied in order of Version, each at most once
+type Migration struct {
+	Version     int64
+	Description string
+	Up          func(*MigrationContext) error
+}
+
+// MigrationContext gives migrations access to the database being migrated
+type MigrationContext struct {
+	Store    *DocumentStore
+	Database string
+}
+
+// OpenSession opens a session for the database being migrated
+func (c *MigrationContext) OpenSession() (*DocumentSession, error) {
+	return c.Store.OpenSession(c.Database)
+}
+
+// PatchByQuery runs a patch-by-query RQL, e.g. "from Users update { this.Age = 0 }",
+// and waits for it to complete
+func (c *MigrationContext) PatchByQuery(queryToUpdate string) (*BulkOperationResult, error) {
+	operation := NewPatchByQueryOperation(queryToUpdate)
+	op, err := c.Store.Operations().ForDatabase(c.Database).SendAsync(operation, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result BulkOperationResult
+	if err = op.WaitForCompletionResult(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AppliedMigration records a migration applied to a database
+type AppliedMigration struct {
+	Version     int64     `json:"Version"`
+	Description string    `json:"Description"`
+	AppliedAt   time.Time `json:"AppliedAt"`
+}
+
+// MigrationsState is the document recording which migrations were applied
+type MigrationsState struct {
+	ID      string
+	Applied []*AppliedMigration `json:"Applied"`
+}
+
+func (s *MigrationsState) isApplied(version int64) bool {
+	for _, applied := range s.Applied {
+		if applied.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// migrationsLock is the value of compare exchange item that prevents
+// concurrent runs of migrations against the same database
+type migrationsLock struct {
+	Owner    string    `json:"Owner"`
+	LockedAt time.Time `json:"LockedAt"`
+}
+
+// MigrationRunner applies pending migrations to a database.
+// Applied migrations are recorded in a state document and concurrent
+// runs are prevented with a compare exchange lock
+type MigrationRunner struct {
+	store      *DocumentStore
+	database   string
+	migrations []*Migration
+
+	// StateDocumentID is the id of MigrationsState document.
+	// Defaults to "migrations/state"
+	StateDocumentID string
+	// LockKey is the compare exchange key used for locking.
+	// Defaults to "migrations/lock"
+	LockKey string
+	// LockTimeout is how long the lock is held before it's considered stale,
+	// e.g. because a process running migrations crashed. A stale lock is
+	// taken over by the next Run. The lock is refreshed after every applied
+	// migration, so LockTimeout must be longer than the longest migration.
+	// Defaults to 0, which means the lock never expires and a lock left by
+	// a crashed run must be released with ForceUnlock
+	LockTimeout time.Duration
+	// if DryRun is true, Run only reports pending migrations without applying them
+	DryRun bool
+}
+
+// NewMigrationRunner returns a runner for migrations against a given database.
+// Empty database means store's database
+func NewMigrationRunner(store *DocumentStore, database string, migrations ...*Migration) (*MigrationRunner, error) {
+	if store == nil {
+		return nil, newIllegalArgumentError("store cannot be nil")
+	}
+	seen := map[int64]bool{}
+	for _, migration := range migrations {
+		if migration == nil {
+			return nil, newIllegalArgumentError("migration cannot be nil")
+		}
+		if migration.Version <= 0 {
+			return nil, newIllegalArgumentError("Migration version must be positive, got %d", migration.Version)
+		}
+		if migration.Up == nil {
+			return nil, newIllegalArgumentError("Migration %d has no Up function", migration.Version)
+		}
+		if seen[migration.Version] {
+			return nil, newIllegalArgumentError("Migration version %d is used more than once", migration.Version)
+		}
+		seen[migration.Version] = true
+	}
+	sorted := append([]*Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+	if database == "" {
+		database = store.GetDatabase()
+	}
+	return &MigrationRunner{
+		store:           store,
+		database:        database,
+		migrations:      sorted,
+		StateDocumentID: defaultMigrationsStateDocumentID,
+		LockKey:         defaultMigrationsLockKey,
+	}, nil
+}
+
+func (r *MigrationRunner) loadState() (*MigrationsState, error) {
+	session, err := r.store.OpenSession(r.database)
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	var state *MigrationsState
+	if err = session.Load(&state, r.StateDocumentID); err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = &MigrationsState{}
+	}
+	return state, nil
+}
+
+// Pending returns migrations that were not yet applied, in order
+func (r *MigrationRunner) Pending() ([]*Migration, error) {
+	state, err := r.loadState()
+	if err != nil {
+		return nil, err
+	}
+	var res []*Migration
+	for _, migration := range r.migrations {
+		if !state.isApplied(migration.Version) {
+			res = append(res, migration)
+		}
+	}
+	return res, nil
+}
+
+// tryLock puts the lock if its current index is index (0 means it doesn't exist).
+// Returns the index of the lock and, if it wasn't taken, its current value
+func (r *MigrationRunner) tryLock(index int64) (*CompareExchangeResult, error) {
+	owner, _ := os.Hostname()
+	value := &migrationsLock{
+		Owner:    owner,
+		LockedAt: time.Now().UTC(),
+	}
+	op, err := NewPutCompareExchangeValueOperation(r.LockKey, value, index)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.store.Operations().ForDatabase(r.database).Send(op, nil); err != nil {
+		return nil, err
+	}
+	return op.Command.Result, nil
+}
+
+func (r *MigrationRunner) lock() (int64, error) {
+	result, err := r.tryLock(0)
+	if err != nil {
+		return 0, err
+	}
+	if result.IsSuccessful {
+		return result.Index, nil
+	}
+	current, _ := result.Value.(*migrationsLock)
+	if current != nil && r.LockTimeout > 0 && time.Since(current.LockedAt) > r.LockTimeout {
+		// take over a stale lock. This fails if someone else took it over
+		// (or released it) in the meantime, because its index changed
+		result, err = r.tryLock(result.Index)
+		if err != nil {
+			return 0, err
+		}
+		if result.IsSuccessful {
+			return result.Index, nil
+		}
+		current, _ = result.Value.(*migrationsLock)
+	}
+	if current == nil {
+		return 0, newConcurrencyError("Migrations of database '%s' are already running (lock '%s' is taken)", r.database, r.LockKey)
+	}
+	return 0, newConcurrencyError("Migrations of database '%s' are already running (lock '%s' is taken by '%s' since %s)", r.database, r.LockKey, current.Owner, current.LockedAt.Format(time.RFC3339))
+}
+
+// refreshLock updates the time of the lock so that it isn't considered stale
+// while migrations are still running. Returns the new index of the lock
+func (r *MigrationRunner) refreshLock(index int64) (int64, error) {
+	result, err := r.tryLock(index)
+	if err != nil {
+		return index, err
+	}
+	if !result.IsSuccessful {
+		return index, newConcurrencyError("Lock '%s' of migrations of database '%s' was taken over by another run", r.LockKey, r.database)
+	}
+	return result.Index, nil
+}
+
+func (r *MigrationRunner) unlock(index int64) error {
+	op, err := NewDeleteCompareExchangeValueOperation(reflect.TypeOf(&migrationsLock{}), r.LockKey, index)
+	if err != nil {
+		return err
+	}
+	return r.store.Operations().ForDatabase(r.database).Send(op, nil)
+}
+
+// ForceUnlock releases the lock regardless of who holds it. It's meant for
+// recovering from a crashed run without waiting for LockTimeout, when it's
+// known that no migrations are running
+func (r *MigrationRunner) ForceUnlock() error {
+	op, err := NewGetCompareExchangeValueOperation(reflect.TypeOf(&migrationsLock{}), r.LockKey)
+	if err != nil {
+		return err
+	}
+	if err = r.store.Operations().ForDatabase(r.database).Send(op, nil); err != nil {
+		return err
+	}
+	if op.Command.Result == nil {
+		// not locked
+		return nil
+	}
+	return r.unlock(op.Command.Result.Index)
+}
+
+// Run applies pending migrations in order and returns migrations that were
+// applied (or, if DryRun is set, would be applied). It stops at the first
+// migration that fails; migrations applied before it stay recorded
+func (r *MigrationRunner) Run() (applied []*Migration, err error) {
+	if r.DryRun {
+		return r.Pending()
+	}
+
+	index, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		unlockErr := r.unlock(index)
+		if unlockErr == nil {
+			return
+		}
+		if err == nil {
+			err = unlockErr
+		} else {
+			err = fmt.Errorf("%w (releasing lock '%s' also failed: %s)", err, r.LockKey, unlockErr)
+		}
+	}()
+
+	// re-read pending migrations under the lock
+	pending, err := r.Pending()
+	if err != nil {
+		return nil, err
+	}
+	ctx := &MigrationContext{
+		Store:    r.store,
+		Database: r.database,
+	}
+	for _, migration := range pending {
+		if err = migration.Up(ctx); err != nil {
+			return applied, fmt.Errorf("Migration %d failed: %w", migration.Version, err)
+		}
+		if err = r.markApplied(migration); err != nil {
+			return applied, err
+		}
+		applied = append(applied, migration)
+		if r.LockTimeout > 0 {
+			if index, err = r.refreshLock(index); err != nil {
+				return applied, err
+			}
+		}
+	}
+	return applied, nil
+}
+
+func (r *MigrationRunner) markApplied(migration *Migration) error {
+	session, err := r.store.OpenSession(r.database)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+	var state *MigrationsState
+	if err = session.Load(&state, r.StateDocumentID); err != nil {
+		return err
+	}
+	if state == nil {
+		state = &MigrationsState{}
+		if err = session.StoreWithID(state, r.StateDocumentID); err != nil {
+			return err
+		}
+	}
+	state.Applied = append(state.Applied, &AppliedMigration{
+		Version:     migration.Version,
+		Description: migration.Description,
+		AppliedAt:   time.Now().UTC(),
+	})
+	return session.SaveChanges()
+}
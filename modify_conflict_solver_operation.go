@@ -0,0 +1,100 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IServerOperation = &ModifyConflictSolverOperation{}
+)
+
+// ModifyConflictSolverOperation changes how replication conflicts in a database
+// are resolved: by per-collection scripts or by picking the latest version
+type ModifyConflictSolverOperation struct {
+	database           string
+	collectionByScript map[string]*ScriptResolver
+	resolveToLatest    bool
+
+	Command *ModifyConflictSolverCommand
+}
+
+// NewModifyConflictSolverOperation returns new ModifyConflictSolverOperation.
+// collectionByScript maps collection names to resolver scripts and can be nil
+//
+// Deprecated: use operations.NewModifyConflictSolverOperation.
+func NewModifyConflictSolverOperation(database string, collectionByScript map[string]*ScriptResolver, resolveToLatest bool) (*ModifyConflictSolverOperation, error) {
+	if database == "" {
+		return nil, newIllegalArgumentError("database cannot be empty")
+	}
+	for collection, resolver := range collectionByScript {
+		if resolver == nil || resolver.Script == "" {
+			return nil, newIllegalArgumentError("script for collection '%s' cannot be empty", collection)
+		}
+	}
+	return &ModifyConflictSolverOperation{
+		database:           database,
+		collectionByScript: collectionByScript,
+		resolveToLatest:    resolveToLatest,
+	}, nil
+}
+
+func (o *ModifyConflictSolverOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = newModifyConflictSolverCommand(o.database, o.collectionByScript, o.resolveToLatest)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &ModifyConflictSolverCommand{}
+
+// ModifyConflictSolverCommand describes "modify conflict solver" command
+type ModifyConflictSolverCommand struct {
+	RavenCommandBase
+
+	database           string
+	collectionByScript map[string]*ScriptResolver
+	resolveToLatest    bool
+
+	Result *ModifySolverResult
+}
+
+func newModifyConflictSolverCommand(database string, collectionByScript map[string]*ScriptResolver, resolveToLatest bool) *ModifyConflictSolverCommand {
+	return &ModifyConflictSolverCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		database:           database,
+		collectionByScript: collectionByScript,
+		resolveToLatest:    resolveToLatest,
+	}
+}
+
+func (c *ModifyConflictSolverCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/admin/replication/conflicts/solver?name=" + urlUtilsEscapeDataString(c.database)
+
+	scripts := c.collectionByScript
+	if scripts == nil {
+		scripts = map[string]*ScriptResolver{}
+	}
+	m := map[string]interface{}{
+		"ResolveToLatest": c.resolveToLatest,
+		"ScriptResolvers": scripts,
+	}
+	d, err := jsonMarshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *ModifyConflictSolverCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
+
+// ModifySolverResult is a result of ModifyConflictSolverOperation
+type ModifySolverResult struct {
+	Key              string          `json:"Key"`
+	RaftCommandIndex int64           `json:"RaftCommandIndex"`
+	Solver           *ConflictSolver `json:"Solver"`
+}
@@ -0,0 +1,26 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModifyConflictSolverOperation(t *testing.T) {
+	_, err := NewModifyConflictSolverOperation("", nil, true)
+	assert.Error(t, err)
+	_, err = NewModifyConflictSolverOperation("db", map[string]*ScriptResolver{"Users": {}}, false)
+	assert.Error(t, err)
+
+	op, err := NewModifyConflictSolverOperation("db1", nil, true)
+	assert.NoError(t, err)
+	cmd, err := op.GetCommand(NewDocumentConventions())
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080"})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/admin/replication/conflicts/solver?name=db1", req.URL.String())
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"ResolveToLatest":true,"ScriptResolvers":{}}`, string(body))
+}
@@ -25,22 +25,34 @@ func (g *MultiDatabaseHiLoIDGenerator) GenerateDocumentID(dbName string, entity
 	}
 	panicIf(dbName == "", "expected non-empty dbName")
 	generatorI, ok := g._generators.Load(dbName)
-	var generator *MultiTypeHiLoIDGenerator
 	if !ok {
-		generator = NewMultiTypeHiLoIDGenerator(g.store, dbName, g.conventions)
-		g._generators.Store(dbName, generator)
-	} else {
-		generator = generatorI.(*MultiTypeHiLoIDGenerator)
+		// LoadOrStore so that concurrent callers share the same generator
+		generatorI, _ = g._generators.LoadOrStore(dbName, NewMultiTypeHiLoIDGenerator(g.store, dbName, g.conventions))
 	}
-	return generator.GenerateDocumentID(entity)
+	return generatorI.(*MultiTypeHiLoIDGenerator).GenerateDocumentID(entity)
 }
 
 // ReturnUnusedRange returns unused range for all generators
-func (g *MultiDatabaseHiLoIDGenerator) ReturnUnusedRange() {
+func (g *MultiDatabaseHiLoIDGenerator) ReturnUnusedRange() error {
+	var firstErr error
 	cb := func(key, value interface{}) bool {
 		generator := value.(*MultiTypeHiLoIDGenerator)
-		generator.ReturnUnusedRange()
+		if err := generator.ReturnUnusedRange(); err != nil && firstErr == nil {
+			firstErr = err
+		}
 		return true
 	}
 	g._generators.Range(cb)
+	return firstErr
+}
+
+// GetMetrics returns metrics summed over generators of all databases
+func (g *MultiDatabaseHiLoIDGenerator) GetMetrics() HiLoMetrics {
+	var res HiLoMetrics
+	cb := func(key, value interface{}) bool {
+		res.add(value.(*MultiTypeHiLoIDGenerator).GetMetrics())
+		return true
+	}
+	g._generators.Range(cb)
+	return res
 }
@@ -24,6 +24,11 @@ func (l *MultiLoaderWithInclude) Include(path string) *MultiLoaderWithInclude {
 
 // results should be map[string]*struct
 func (l *MultiLoaderWithInclude) LoadMulti(results interface{}, ids []string) error {
+	if err := l.session.enter("LoadMulti"); err != nil {
+		return err
+	}
+	defer l.session.exit()
+
 	if len(ids) == 0 {
 		return newIllegalArgumentError("ids cannot be empty array")
 	}
@@ -37,6 +42,11 @@ func (l *MultiLoaderWithInclude) LoadMulti(results interface{}, ids []string) er
 // TODO: needs a test
 // TODO: better implementation
 func (l *MultiLoaderWithInclude) Load(result interface{}, id string) error {
+	if err := l.session.enter("Load"); err != nil {
+		return err
+	}
+	defer l.session.exit()
+
 	if id == "" {
 		return newIllegalArgumentError("id cannot be empty string")
 	}
@@ -43,8 +43,31 @@ func (g *MultiTypeHiLoIDGenerator) GenerateDocumentID(entity interface{}) (strin
 }
 
 // ReturnUnusedRange returns unused range for all generators
-func (g *MultiTypeHiLoIDGenerator) ReturnUnusedRange() {
+func (g *MultiTypeHiLoIDGenerator) ReturnUnusedRange() error {
+	g._generatorLock.Lock()
+	generators := make([]*HiLoIDGenerator, 0, len(g._idGeneratorsByTag))
+	for _, generator := range g._idGeneratorsByTag {
+		generators = append(generators, generator)
+	}
+	g._generatorLock.Unlock()
+
+	var firstErr error
+	for _, generator := range generators {
+		if err := generator.ReturnUnusedRange(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// GetMetrics returns metrics summed over generators of all types
+func (g *MultiTypeHiLoIDGenerator) GetMetrics() HiLoMetrics {
+	g._generatorLock.Lock()
+	defer g._generatorLock.Unlock()
+
+	var res HiLoMetrics
 	for _, generator := range g._idGeneratorsByTag {
-		generator.ReturnUnusedRange()
+		res.add(generator.GetMetrics())
 	}
+	return res
 }
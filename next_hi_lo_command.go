@@ -15,7 +15,7 @@ type NextHiLoCommand struct {
 
 	_tag                    string
 	_lastBatchSize          int64
-	_lastRangeAt            *time.Time // TODO: our Time?
+	_lastRangeAt            *time.Time
 	_identityPartsSeparator string
 	_lastRangeMax           int64
 
@@ -42,13 +42,20 @@ func NewNextHiLoCommand(tag string, lastBatchSize int64, lastRangeAt *time.Time,
 func (c *NextHiLoCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	date := ""
 	if c._lastRangeAt != nil && !c._lastRangeAt.IsZero() {
-		date = (*c._lastRangeAt).Format(timeFormat)
+		date = Time(c._lastRangeAt.UTC()).Format()
 	}
-	path := "/hilo/next?tag=" + c._tag + "&lastBatchSize=" + i64toa(c._lastBatchSize) + "&lastRangeAt=" + date + "&identityPartsSeparator=" + c._identityPartsSeparator + "&lastMax=" + i64toa(c._lastRangeMax)
+	path := "/hilo/next?tag=" + urlUtilsEscapeDataString(c._tag) +
+		"&lastBatchSize=" + i64toa(c._lastBatchSize) +
+		"&lastRangeAt=" + urlUtilsEscapeDataString(date) +
+		"&identityPartsSeparator=" + urlUtilsEscapeDataString(c._identityPartsSeparator) +
+		"&lastMax=" + i64toa(c._lastRangeMax)
 	url := node.URL + "/databases/" + node.Database + path
 	return newHttpGet(url)
 }
 
 func (c *NextHiLoCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
 	return jsonUnmarshal(response, &c.Result)
 }
@@ -22,8 +22,7 @@ func NewNextIdentityForCommand(id string) *NextIdentityForCommand {
 
 		_id: id,
 	}
-	panicIf(id == "", "Id cannot be null")
-
+	// empty id is reported as an error in CreateRequest
 	return res
 }
 
@@ -33,7 +32,7 @@ func (c *NextIdentityForCommand) CreateRequest(node *ServerNode) (*http.Request,
 		return nil, err
 	}
 
-	url := node.URL + "/databases/" + node.Database + "/identity/next?name=" + urlEncode(c._id)
+	url := node.URL + "/databases/" + node.Database + "/identity/next?name=" + urlUtilsEscapeDataString(c._id)
 
 	return NewHttpPost(url, nil)
 }
@@ -0,0 +1,31 @@
+package ravendb
+
+var (
+	_ IMaintenanceOperation = &NextIdentityForOperation{}
+)
+
+// NextIdentityForOperation increments server identity for a given name
+// (e.g. "orders" or "orders|") and returns its new value in Command.Result
+type NextIdentityForOperation struct {
+	identityName string
+
+	Command *NextIdentityForCommand
+}
+
+// NewNextIdentityForOperation returns new NextIdentityForOperation
+//
+// Deprecated: use operations.NewNextIdentityForOperation.
+func NewNextIdentityForOperation(name string) (*NextIdentityForOperation, error) {
+	if stringIsBlank(name) {
+		return nil, newIllegalArgumentError("The field name cannot be null or whitespace.")
+	}
+	return &NextIdentityForOperation{
+		identityName: name,
+	}, nil
+}
+
+// GetCommand returns new RavenCommand for this operation
+func (o *NextIdentityForOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewNextIdentityForCommand(o.identityName)
+	return o.Command, nil
+}
@@ -1,29 +1,45 @@
 package ravendb
 
 import (
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+const (
+	// how often we re-run speed tests to find the fastest node
+	speedTestInterval = time.Minute
+	// a node must win that many speed test races to become the fastest
+	speedTestWinsToSelect = 10
+)
+
 // NodeSelector describes node selector
 type NodeSelector struct {
+	// protects updateFastestNodeTimer
+	mu                     sync.Mutex
 	updateFastestNodeTimer *time.Timer
-	state                  *NodeSelectorState
+	// *NodeSelectorState, replaced when topology changes
+	state atomic.Value
 }
 
 // NewNodeSelector creates a new NodeSelector
 func NewNodeSelector(t *Topology) *NodeSelector {
 	state := NewNodeSelectorState(t)
-	return &NodeSelector{
-		state: state,
-	}
+	res := &NodeSelector{}
+	res.state.Store(state)
+	return res
+}
+
+func (s *NodeSelector) getState() *NodeSelectorState {
+	return s.state.Load().(*NodeSelectorState)
 }
 
 func (s *NodeSelector) getTopology() *Topology {
-	return s.state.topology
+	return s.getState().topology
 }
 
 func (s *NodeSelector) onFailedRequest(nodeIndex int) {
-	state := s.state
+	state := s.getState()
 	if nodeIndex < 0 || nodeIndex >= len(state.failures) {
 		return // probably already changed
 	}
@@ -36,20 +52,20 @@ func (s *NodeSelector) onUpdateTopology(topology *Topology, forceUpdate bool) bo
 		return false
 	}
 
-	stateEtag := s.state.topology.Etag
+	stateEtag := s.getState().topology.Etag
 	topologyEtag := topology.Etag
 
 	if stateEtag >= topologyEtag && !forceUpdate {
 		return false
 	}
 
-	s.state = NewNodeSelectorState(topology)
+	s.state.Store(NewNodeSelectorState(topology))
 
 	return true
 }
 
 func (s *NodeSelector) getPreferredNode() (*CurrentIndexAndNode, error) {
-	state := s.state
+	state := s.getState()
 	stateFailures := state.failures
 	serverNodes := state.nodes
 	n := min(len(serverNodes), len(stateFailures))
@@ -61,6 +77,20 @@ func (s *NodeSelector) getPreferredNode() (*CurrentIndexAndNode, error) {
 	return s.unlikelyEveryoneFaultedChoice(state)
 }
 
+// getRequestedNode returns the node with a given cluster tag
+func (s *NodeSelector) getRequestedNode(nodeTag string) (*CurrentIndexAndNode, error) {
+	state := s.getState()
+	for i, node := range state.nodes {
+		if node.ClusterTag == nodeTag {
+			return NewCurrentIndexAndNode(i, node), nil
+		}
+	}
+	if len(state.nodes) == 0 {
+		return nil, newAllTopologyNodesDownError("There are no nodes in the topology at all")
+	}
+	return nil, newRequestedNodeUnavailableError("Could not find requested node %s", nodeTag)
+}
+
 func (s *NodeSelector) unlikelyEveryoneFaultedChoice(state *NodeSelectorState) (*CurrentIndexAndNode, error) {
 	// if there are all marked as failed, we'll chose the first
 	// one so the user will get an error (or recover :-) );
@@ -72,8 +102,14 @@ func (s *NodeSelector) unlikelyEveryoneFaultedChoice(state *NodeSelectorState) (
 }
 
 func (s *NodeSelector) getNodeBySessionID(sessionId int) (*CurrentIndexAndNode, error) {
-	state := s.state
-	index := sessionId % len(state.topology.Nodes)
+	state := s.getState()
+	if len(state.nodes) == 0 {
+		return s.unlikelyEveryoneFaultedChoice(state)
+	}
+	index := sessionId % len(state.nodes)
+	if index < 0 {
+		index += len(state.nodes)
+	}
 
 	for i := index; i < len(state.failures); i++ {
 		if state.failures[i].get() == 0 && state.nodes[i].ServerRole == ServerNodeRoleMember {
@@ -91,9 +127,12 @@ func (s *NodeSelector) getNodeBySessionID(sessionId int) (*CurrentIndexAndNode,
 }
 
 func (s *NodeSelector) getFastestNode() (*CurrentIndexAndNode, error) {
-	state := s.state
-	if state.failures[state.fastest].get() == 0 && state.nodes[state.fastest].ServerRole == ServerNodeRoleMember {
-		return NewCurrentIndexAndNode(state.fastest, state.nodes[state.fastest]), nil
+	state := s.getState()
+	state.mu.Lock()
+	fastest := state.fastest
+	state.mu.Unlock()
+	if fastest < len(state.nodes) && state.failures[fastest].get() == 0 && state.nodes[fastest].ServerRole == ServerNodeRoleMember {
+		return NewCurrentIndexAndNode(fastest, state.nodes[fastest]), nil
 	}
 
 	// if the fastest node has failures, we'll immediately schedule
@@ -105,8 +144,8 @@ func (s *NodeSelector) getFastestNode() (*CurrentIndexAndNode, error) {
 }
 
 func (s *NodeSelector) restoreNodeIndex(nodeIndex int) {
-	state := s.state
-	if len(state.failures) < nodeIndex {
+	state := s.getState()
+	if nodeIndex < 0 || nodeIndex >= len(state.failures) {
 		return // the state was changed and we no longer have it?
 	}
 
@@ -121,54 +160,93 @@ func nodeSelectorThrowEmptyTopology() error {
 */
 
 func (s *NodeSelector) switchToSpeedTestPhase() {
-	state := s.state
+	state := s.getState()
 
 	if !state.speedTestMode.compareAndSet(0, 1) {
 		return
 	}
 
+	state.mu.Lock()
 	for i := 0; i < len(state.fastestRecords); i++ {
 		state.fastestRecords[i] = 0
 	}
+	state.mu.Unlock()
 
 	state.speedTestMode.incrementAndGet()
 }
 
 func (s *NodeSelector) inSpeedTestPhase() bool {
-	return s.state.speedTestMode.get() > 1
+	return s.getState().speedTestMode.get() > 1
 }
 
+// recordFastest records that node at index was the first to respond
+// during a speed test
 func (s *NodeSelector) recordFastest(index int, node *ServerNode) {
-	state := s.state
+	state := s.getState()
+	state.mu.Lock()
 	stateFastest := state.fastestRecords
 
 	// the following two checks are to verify that things didn't move
 	// while we were computing the fastest node, we verify that the index
 	// of the fastest node and the identity of the node didn't change during
 	// our check
-	if index < 0 || index >= len(stateFastest) {
-		return
-	}
-
-	if node != state.nodes[index] {
+	if index < 0 || index >= len(stateFastest) || node != state.nodes[index] {
+		state.mu.Unlock()
 		return
 	}
 
 	stateFastest[index]++
-	if stateFastest[index] >= 10 {
+	if stateFastest[index] >= speedTestWinsToSelect {
+		state.mu.Unlock()
 		s.selectFastest(state, index)
+		return
 	}
+	state.mu.Unlock()
 
-	if state.speedTestMode.incrementAndGet() <= len(state.nodes)*10 {
+	if state.speedTestMode.incrementAndGet() <= len(state.nodes)*speedTestWinsToSelect {
 		return
 	}
 
 	//too many concurrent speed tests are happening
-	maxIndex := s.findMaxIndex(state)
-	s.selectFastest(state, maxIndex)
+	s.selectFastest(state, s.findMaxIndex(state))
+}
+
+// recordLatency records how long it took node at index to respond.
+// We keep a moving average to smooth out outliers
+func (s *NodeSelector) recordLatency(index int, node *ServerNode, latency time.Duration) {
+	state := s.getState()
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if index < 0 || index >= len(state.latencies) || node != state.nodes[index] {
+		return
+	}
+	if prev := state.latencies[index]; prev != 0 {
+		latency = (prev*3 + latency) / 4
+	}
+	state.latencies[index] = latency
+}
+
+// getLatencies returns average response times of nodes measured during
+// speed tests. Nodes that weren't measured yet are not included
+func (s *NodeSelector) getLatencies() map[*ServerNode]time.Duration {
+	state := s.getState()
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	res := map[*ServerNode]time.Duration{}
+	for i, latency := range state.latencies {
+		if latency != 0 {
+			res[state.nodes[i]] = latency
+		}
+	}
+	return res
 }
 
 func (s *NodeSelector) findMaxIndex(state *NodeSelectorState) int {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
 	stateFastest := state.fastestRecords
 	maxIndex := 0
 	maxValue := 0
@@ -186,17 +264,23 @@ func (s *NodeSelector) findMaxIndex(state *NodeSelectorState) int {
 }
 
 func (s *NodeSelector) selectFastest(state *NodeSelectorState, index int) {
+	state.mu.Lock()
 	state.fastest = index
+	state.mu.Unlock()
 	state.speedTestMode.set(0)
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.updateFastestNodeTimer != nil {
-		s.updateFastestNodeTimer.Reset(time.Minute)
+		s.updateFastestNodeTimer.Reset(speedTestInterval)
 	} else {
 		f := func() {
+			s.mu.Lock()
 			s.updateFastestNodeTimer = nil
+			s.mu.Unlock()
 			s.switchToSpeedTestPhase()
 		}
-		s.updateFastestNodeTimer = time.AfterFunc(time.Minute, f)
+		s.updateFastestNodeTimer = time.AfterFunc(speedTestInterval, f)
 	}
 }
 
@@ -205,6 +289,8 @@ func (s *NodeSelector) scheduleSpeedTest() {
 }
 
 func (s *NodeSelector) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.updateFastestNodeTimer != nil {
 		s.updateFastestNodeTimer.Stop()
 		s.updateFastestNodeTimer = nil
@@ -212,12 +298,16 @@ func (s *NodeSelector) Close() {
 }
 
 type NodeSelectorState struct {
-	topology       *Topology
-	nodes          []*ServerNode
-	failures       []atomicInteger
+	topology      *Topology
+	nodes         []*ServerNode
+	failures      []atomicInteger
+	speedTestMode atomicInteger
+
+	// protects fastestRecords, fastest and latencies
+	mu             sync.Mutex
 	fastestRecords []int
 	fastest        int
-	speedTestMode  atomicInteger
+	latencies      []time.Duration
 }
 
 func NewNodeSelectorState(topology *Topology) *NodeSelectorState {
@@ -229,5 +319,6 @@ func NewNodeSelectorState(topology *Topology) *NodeSelectorState {
 	failures := make([]atomicInteger, len(nodes))
 	res.failures = failures
 	res.fastestRecords = make([]int, len(nodes))
+	res.latencies = make([]time.Duration, len(nodes))
 	return res
 }
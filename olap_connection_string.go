@@ -0,0 +1,21 @@
+package ravendb
+
+// OlapConnectionString represents connection string for OLAP ETL.
+// Exactly one of the destination settings should be set
+type OlapConnectionString struct {
+	ConnectionString
+	LocalSettings       *LocalSettings       `json:"LocalSettings,omitempty"`
+	S3Settings          *S3Settings          `json:"S3Settings,omitempty"`
+	AzureSettings       *AzureSettings       `json:"AzureSettings,omitempty"`
+	GlacierSettings     *GlacierSettings     `json:"GlacierSettings,omitempty"`
+	GoogleCloudSettings *GoogleCloudSettings `json:"GoogleCloudSettings,omitempty"`
+	FtpSettings         *FtpSettings         `json:"FtpSettings,omitempty"`
+}
+
+// NewOlapConnectionString returns new OlapConnectionString
+func NewOlapConnectionString(name string) *OlapConnectionString {
+	res := &OlapConnectionString{}
+	res.Name = name
+	res.Type = ConnectionStringTypeOlap
+	return res
+}
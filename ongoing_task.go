@@ -0,0 +1,163 @@
+package ravendb
+
+// OngoingTaskState describes whether a task is enabled
+type OngoingTaskState string
+
+const (
+	OngoingTaskStateEnabled          OngoingTaskState = "Enabled"
+	OngoingTaskStateDisabled         OngoingTaskState = "Disabled"
+	OngoingTaskStatePartiallyEnabled OngoingTaskState = "PartiallyEnabled"
+)
+
+var ongoingTaskStateValues = []string{
+	string(OngoingTaskStateEnabled),
+	string(OngoingTaskStateDisabled),
+	string(OngoingTaskStatePartiallyEnabled),
+}
+
+// MarshalJSON returns an error if OngoingTaskState is not one of the defined values
+func (o OngoingTaskState) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(o), "OngoingTaskState", ongoingTaskStateValues)
+}
+
+// OngoingTaskConnectionStatus describes connection of a task to its destination
+type OngoingTaskConnectionStatus string
+
+const (
+	OngoingTaskConnectionStatusNone          OngoingTaskConnectionStatus = "None"
+	OngoingTaskConnectionStatusActive        OngoingTaskConnectionStatus = "Active"
+	OngoingTaskConnectionStatusNotActive     OngoingTaskConnectionStatus = "NotActive"
+	OngoingTaskConnectionStatusReconnect     OngoingTaskConnectionStatus = "Reconnect"
+	OngoingTaskConnectionStatusNotOnThisNode OngoingTaskConnectionStatus = "NotOnThisNode"
+)
+
+var ongoingTaskConnectionStatusValues = []string{
+	string(OngoingTaskConnectionStatusNone),
+	string(OngoingTaskConnectionStatusActive),
+	string(OngoingTaskConnectionStatusNotActive),
+	string(OngoingTaskConnectionStatusReconnect),
+	string(OngoingTaskConnectionStatusNotOnThisNode),
+}
+
+// MarshalJSON returns an error if OngoingTaskConnectionStatus is not one of the defined values
+func (o OngoingTaskConnectionStatus) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(o), "OngoingTaskConnectionStatus", ongoingTaskConnectionStatusValues)
+}
+
+// IOngoingTask is implemented by all OngoingTask* types
+// returned by GetOngoingTaskInfoOperation
+type IOngoingTask interface {
+	GetTask() *OngoingTask
+}
+
+// OngoingTask describes properties common to all background tasks
+type OngoingTask struct {
+	TaskID               int64                       `json:"TaskId"`
+	TaskType             OngoingTaskType             `json:"TaskType"`
+	ResponsibleNode      *NodeID                     `json:"ResponsibleNode"`
+	TaskState            OngoingTaskState            `json:"TaskState"`
+	TaskConnectionStatus OngoingTaskConnectionStatus `json:"TaskConnectionStatus"`
+	TaskName             string                      `json:"TaskName"`
+	Error                string                      `json:"Error"`
+	MentorNode           string                      `json:"MentorNode"`
+}
+
+// GetTask returns properties common to all tasks
+func (t *OngoingTask) GetTask() *OngoingTask {
+	return t
+}
+
+// OngoingTaskReplication describes external replication task
+type OngoingTaskReplication struct {
+	OngoingTask
+	DestinationURL        string   `json:"DestinationUrl"`
+	TopologyDiscoveryUrls []string `json:"TopologyDiscoveryUrls"`
+	DestinationDatabase   string   `json:"DestinationDatabase"`
+	ConnectionStringName  string   `json:"ConnectionStringName"`
+	DelayReplicationFor   Duration `json:"DelayReplicationFor"`
+}
+
+// OngoingTaskPullReplicationAsSink describes pull replication sink task
+type OngoingTaskPullReplicationAsSink struct {
+	OngoingTask
+	HubName               string              `json:"HubName"`
+	Mode                  PullReplicationMode `json:"Mode"`
+	DestinationURL        string              `json:"DestinationUrl"`
+	TopologyDiscoveryUrls []string            `json:"TopologyDiscoveryUrls"`
+	DestinationDatabase   string              `json:"DestinationDatabase"`
+	ConnectionStringName  string              `json:"ConnectionStringName"`
+	CertificatePublicKey  string              `json:"CertificatePublicKey"`
+	AccessName            string              `json:"AccessName"`
+	AllowedHubToSinkPaths []string            `json:"AllowedHubToSinkPaths"`
+	AllowedSinkToHubPaths []string            `json:"AllowedSinkToHubPaths"`
+}
+
+// OngoingTaskRavenEtl describes Raven ETL task
+type OngoingTaskRavenEtl struct {
+	OngoingTask
+	Configuration *RavenEtlConfiguration `json:"Configuration"`
+}
+
+// OngoingTaskSQLEtl describes SQL ETL task
+type OngoingTaskSQLEtl struct {
+	OngoingTask
+	Configuration *SqlEtlConfiguration `json:"Configuration"`
+}
+
+// OngoingTaskOlapEtl describes OLAP ETL task
+type OngoingTaskOlapEtl struct {
+	OngoingTask
+	Configuration *OlapEtlConfiguration `json:"Configuration"`
+}
+
+// NextBackup describes when the next backup runs
+type NextBackup struct {
+	TimeSpan Duration `json:"TimeSpan"`
+	DateTime Time     `json:"DateTime"`
+	IsFull   bool     `json:"IsFull"`
+}
+
+// OngoingTaskBackup describes periodic backup task
+type OngoingTaskBackup struct {
+	OngoingTask
+	BackupType            BackupType       `json:"BackupType"`
+	BackupDestinations    []string         `json:"BackupDestinations"`
+	LastFullBackup        *Time            `json:"LastFullBackup"`
+	LastIncrementalBackup *Time            `json:"LastIncrementalBackup"`
+	NextBackup            *NextBackup      `json:"NextBackup"`
+	RetentionPolicy       *RetentionPolicy `json:"RetentionPolicy"`
+	IsEncrypted           bool             `json:"IsEncrypted"`
+}
+
+// OngoingTaskSubscription describes subscription task
+type OngoingTaskSubscription struct {
+	OngoingTask
+	Query                                 string `json:"Query"`
+	SubscriptionName                      string `json:"SubscriptionName"`
+	SubscriptionID                        int64  `json:"SubscriptionId"`
+	ChangeVectorForNextBatchStartingPoint string `json:"ChangeVectorForNextBatchStartingPoint"`
+	LastBatchAckTime                      *Time  `json:"LastBatchAckTime"`
+	Disabled                              bool   `json:"Disabled"`
+	LastClientConnectionTime              *Time  `json:"LastClientConnectionTime"`
+}
+
+// newOngoingTaskForType returns an empty task of a type matching taskType
+func newOngoingTaskForType(taskType OngoingTaskType) (IOngoingTask, error) {
+	switch taskType {
+	case OngoingTaskTypeReplication:
+		return &OngoingTaskReplication{}, nil
+	case OngoingTaskTypePullReplicationAsSink:
+		return &OngoingTaskPullReplicationAsSink{}, nil
+	case OngoingTaskTypeRavenEtl:
+		return &OngoingTaskRavenEtl{}, nil
+	case OngoingTaskTypeSQLEtl:
+		return &OngoingTaskSQLEtl{}, nil
+	case OngoingTaskTypeOlapEtl:
+		return &OngoingTaskOlapEtl{}, nil
+	case OngoingTaskTypeBackup:
+		return &OngoingTaskBackup{}, nil
+	case OngoingTaskTypeSubscription:
+		return &OngoingTaskSubscription{}, nil
+	}
+	return nil, newIllegalArgumentError("getting info of tasks of type '%s' is not supported", taskType)
+}
@@ -0,0 +1,31 @@
+package ravendb
+
+// OngoingTaskType describes a type of background database task
+type OngoingTaskType string
+
+const (
+	OngoingTaskTypeReplication           OngoingTaskType = "Replication"
+	OngoingTaskTypeRavenEtl              OngoingTaskType = "RavenEtl"
+	OngoingTaskTypeSQLEtl                OngoingTaskType = "SqlEtl"
+	OngoingTaskTypeOlapEtl               OngoingTaskType = "OlapEtl"
+	OngoingTaskTypeBackup                OngoingTaskType = "Backup"
+	OngoingTaskTypeSubscription          OngoingTaskType = "Subscription"
+	OngoingTaskTypePullReplicationAsHub  OngoingTaskType = "PullReplicationAsHub"
+	OngoingTaskTypePullReplicationAsSink OngoingTaskType = "PullReplicationAsSink"
+)
+
+var ongoingTaskTypeValues = []string{
+	string(OngoingTaskTypeReplication),
+	string(OngoingTaskTypeRavenEtl),
+	string(OngoingTaskTypeSQLEtl),
+	string(OngoingTaskTypeOlapEtl),
+	string(OngoingTaskTypeBackup),
+	string(OngoingTaskTypeSubscription),
+	string(OngoingTaskTypePullReplicationAsHub),
+	string(OngoingTaskTypePullReplicationAsSink),
+}
+
+// MarshalJSON returns an error if OngoingTaskType is not one of the defined values
+func (o OngoingTaskType) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(o), "OngoingTaskType", ongoingTaskTypeValues)
+}
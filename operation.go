@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"time"
 )
 
@@ -10,15 +11,26 @@ type Operation struct {
 	//TBD private readonly Func<DatabaseChanges> _changes;
 	conventions *DocumentConventions
 	id          int64
+	// tag of the node that runs the operation, if known
+	nodeTag string
 
 	// if true, this represents ServerWideOperation
 	IsServerWide bool
+
+	// OnProgress, if set, is called from WaitForCompletion* functions
+	// when the server reports a change in progress of the operation
+	OnProgress func(progress *OperationProgress)
+
+	// for operations driven by a long-running request, like smuggler export,
+	// receives the result of the request once it finishes
+	chRequestDone chan error
 }
 
 func (o *Operation) GetID() int64 {
 	return o.id
 }
 
+// Deprecated: use operations.NewOperation.
 func NewOperation(requestExecutor *RequestExecutor, changes func() *DatabaseChanges, conventions *DocumentConventions, id int64) *Operation {
 	return &Operation{
 		requestExecutor: requestExecutor,
@@ -28,9 +40,10 @@ func NewOperation(requestExecutor *RequestExecutor, changes func() *DatabaseChan
 	}
 }
 
-func (o *Operation) fetchOperationsStatus() (map[string]interface{}, error) {
+func (o *Operation) fetchOperationsStatus(ctx context.Context) (map[string]interface{}, error) {
 	command := o.getOperationStateCommand(o.conventions, o.id)
-	err := o.requestExecutor.ExecuteCommand(command, nil)
+	command.GetBase().SelectedNodeTag = o.nodeTag
+	err := o.requestExecutor.ExecuteCommandWithContext(ctx, command, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,8 +54,7 @@ func (o *Operation) fetchOperationsStatus() (map[string]interface{}, error) {
 	case *GetServerWideOperationStateCommand:
 		return cmd.Result, nil
 	}
-	panicIf(true, "Unexpected command type %T", command)
-	return nil, nil
+	return nil, newIllegalStateError("Unexpected command type %T", command)
 }
 
 func (o *Operation) getOperationStateCommand(conventions *DocumentConventions, id int64) RavenCommand {
@@ -52,35 +64,144 @@ func (o *Operation) getOperationStateCommand(conventions *DocumentConventions, i
 	return NewGetOperationStateCommand(o.conventions, o.id)
 }
 
+// WaitForCompletion waits until the operation is completed on the server
 func (o *Operation) WaitForCompletion() error {
+	return o.WaitForCompletionWithContext(context.Background())
+}
+
+// WaitForCompletionWithContext waits until the operation is completed on the server
+// or until ctx is done
+func (o *Operation) WaitForCompletionWithContext(ctx context.Context) error {
+	_, err := o.waitForCompletion(ctx)
+	return err
+}
+
+// WaitForCompletionResult waits until the operation is completed on the server
+// and decodes its result into result, which should be a pointer to a struct
+// like *BulkOperationResult
+func (o *Operation) WaitForCompletionResult(result interface{}) error {
+	return o.WaitForCompletionResultWithContext(context.Background(), result)
+}
+
+// WaitForCompletionResultWithContext is like WaitForCompletionResult
+// but gives up when ctx is done
+func (o *Operation) WaitForCompletionResultWithContext(ctx context.Context, result interface{}) error {
+	if result == nil {
+		return newIllegalArgumentError("result cannot be nil")
+	}
+	status, err := o.waitForCompletion(ctx)
+	if err != nil {
+		return err
+	}
+	res, ok := status["Result"].(map[string]interface{})
+	if !ok {
+		return newRavenError("status has no 'Result' object. Status: #%v", status)
+	}
+	return structFromJSONMap(res, result)
+}
+
+// Kill cancels the operation on the server. WaitForCompletion* functions
+// return OperationCancelledError for a killed operation
+func (o *Operation) Kill() error {
+	var command RavenCommand
+	var err error
+	if o.IsServerWide {
+		command, err = NewKillServerOperationCommand(i64toa(o.id))
+	} else {
+		command, err = NewKillOperationCommand(i64toa(o.id))
+	}
+	if err != nil {
+		return err
+	}
+	command.GetBase().SelectedNodeTag = o.nodeTag
+	return o.requestExecutor.ExecuteCommand(command, nil)
+}
+
+// waitForCompletion polls the operation status until the operation is completed
+// and returns the last status
+func (o *Operation) waitForCompletion(ctx context.Context) (map[string]interface{}, error) {
+	var lastProgress *OperationProgress
 	for {
-		status, err := o.fetchOperationsStatus()
+		if err := o.checkRequestDone(ctx, false); err != nil {
+			return nil, err
+		}
+		status, err := o.fetchOperationsStatus(ctx)
 		if err != nil {
-			return err
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, NewTimeoutError("timed out waiting for operation %d to complete", o.id)
+			}
+			return nil, err
+		}
+		if status == nil && o.chRequestDone != nil {
+			// the request didn't register the operation on the server yet
+			status = map[string]interface{}{"Status": string(OperationStatusInProgress)}
 		}
 
 		operationStatus, ok := jsonGetAsText(status, "Status")
 		if !ok {
-			return newRavenError("missing 'Status' field in response")
+			return nil, newRavenError("missing 'Status' field in response")
 		}
-		switch operationStatus {
-		case "Completed":
-			return nil
-		case "Cancelled":
-			return newOperationCancelledError("")
-		case "Faulted":
+		switch OperationStatus(operationStatus) {
+		case OperationStatusInProgress:
+			raw, ok := status["Progress"].(map[string]interface{})
+			if ok && o.OnProgress != nil {
+				progress := newOperationProgress(raw)
+				if lastProgress == nil || progress.Processed != lastProgress.Processed || progress.Total != lastProgress.Total {
+					lastProgress = progress
+					o.OnProgress(progress)
+				}
+			}
+		case OperationStatusCompleted:
+			if err = o.checkRequestDone(ctx, true); err != nil {
+				return nil, err
+			}
+			return status, nil
+		case OperationStatusCanceled:
+			return nil, newOperationCancelledError("")
+		case OperationStatusFaulted:
 			result, ok := status["Result"].(map[string]interface{})
 			if !ok {
-				return newRavenError("status has no 'Result' object. Status: #%v", status)
+				return nil, newRavenError("status has no 'Result' object. Status: #%v", status)
 			}
 			var exceptionResult OperationExceptionResult
 			err = structFromJSONMap(result, &exceptionResult)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			return exceptionDispatcherGet(exceptionResult.Message, exceptionResult.Error, exceptionResult.Type, exceptionResult.StatusCode, nil)
+			return nil, exceptionDispatcherGet(exceptionResult.Message, exceptionResult.Error, exceptionResult.Type, exceptionResult.StatusCode, nil)
 		}
 
-		time.Sleep(500 * time.Millisecond)
+		select {
+		case <-time.After(500 * time.Millisecond):
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, NewTimeoutError("timed out waiting for operation %d to complete", o.id)
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// checkRequestDone returns an error if the request driving the operation failed.
+// If wait is true, it waits for the request to finish
+func (o *Operation) checkRequestDone(ctx context.Context, wait bool) error {
+	if o.chRequestDone == nil {
+		return nil
+	}
+	var err error
+	if wait {
+		select {
+		case err = <-o.chRequestDone:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	} else {
+		select {
+		case err = <-o.chRequestDone:
+		default:
+			return nil
+		}
 	}
+	o.chRequestDone = nil
+	return err
 }
@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"net/http"
 	"strings"
 )
@@ -11,6 +12,7 @@ type OperationExecutor struct {
 	requestExecutor *RequestExecutor
 }
 
+// Deprecated: use operations.NewOperationExecutor.
 func NewOperationExecutor(store *DocumentStore, databaseName string) *OperationExecutor {
 	res := &OperationExecutor{
 		store:        store,
@@ -37,21 +39,33 @@ func (e *OperationExecutor) ForDatabase(databaseName string) *OperationExecutor
 // command and its result
 // sessionInfo can be nil
 func (e *OperationExecutor) Send(operation IOperation, sessionInfo *SessionInfo) error {
+	return e.SendWithContext(context.Background(), operation, sessionInfo)
+}
+
+// SendWithContext is like Send but gives up when ctx is cancelled
+// sessionInfo can be nil
+func (e *OperationExecutor) SendWithContext(ctx context.Context, operation IOperation, sessionInfo *SessionInfo) error {
 	command, err := operation.GetCommand(e.store, e.requestExecutor.GetConventions(), e.requestExecutor.Cache)
 	if err != nil {
 		return err
 	}
-	return e.requestExecutor.ExecuteCommand(command, sessionInfo)
+	return e.requestExecutor.ExecuteCommandWithContext(ctx, command, sessionInfo)
 }
 
 // sessionInfo can be nil
 func (e *OperationExecutor) SendAsync(operation IOperation, sessionInfo *SessionInfo) (*Operation, error) {
+	return e.SendAsyncWithContext(context.Background(), operation, sessionInfo)
+}
+
+// SendAsyncWithContext is like SendAsync but gives up when ctx is cancelled.
+// ctx only applies to starting the operation, not to waiting for its completion
+func (e *OperationExecutor) SendAsyncWithContext(ctx context.Context, operation IOperation, sessionInfo *SessionInfo) (*Operation, error) {
 	command, err := operation.GetCommand(e.store, e.requestExecutor.GetConventions(), e.requestExecutor.Cache)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = e.requestExecutor.ExecuteCommand(command, sessionInfo); err != nil {
+	if err = e.requestExecutor.ExecuteCommandWithContext(ctx, command, sessionInfo); err != nil {
 		return nil, err
 	}
 
@@ -60,7 +74,9 @@ func (e *OperationExecutor) SendAsync(operation IOperation, sessionInfo *Session
 	}
 	result := getCommandOperationIDResult(command)
 
-	return NewOperation(e.requestExecutor, changes, e.requestExecutor.GetConventions(), result.OperationID), nil
+	op := NewOperation(e.requestExecutor, changes, e.requestExecutor.GetConventions(), result.OperationID)
+	op.nodeTag = result.OperationNodeTag
+	return op, nil
 }
 
 // Note: use SendPatchOperation() instead and check PatchOperationResult.Status
@@ -78,10 +94,11 @@ func (e *OperationExecutor) SendPatchOperation(operation *PatchOperation, sessio
 		return nil, err
 	}
 
-	cmdResult := operation.Command.Result
-	result := &PatchOperationResult{
-		Status:   cmdResult.Status,
-		Document: cmdResult.ModifiedDocument,
+	result := &PatchOperationResult{}
+	// there's no response body when the document doesn't exist
+	if cmdResult := operation.Command.Result; cmdResult != nil {
+		result.Status = cmdResult.Status
+		result.Document = cmdResult.ModifiedDocument
 	}
 	switch operation.Command.StatusCode {
 	case http.StatusNotModified:
@@ -91,3 +108,32 @@ func (e *OperationExecutor) SendPatchOperation(operation *PatchOperation, sessio
 	}
 	return result, nil
 }
+
+// PutIfMatch stores entity as document with a given id only if the change vector
+// of the document on the server matches changeVector. Empty changeVector means
+// that the document must not exist yet.
+// Returns ConcurrencyError if the change vector doesn't match.
+func (e *OperationExecutor) PutIfMatch(id string, entity interface{}, changeVector string) (*PutResult, error) {
+	if id == "" {
+		return nil, newIllegalArgumentError("id cannot be empty")
+	}
+	if entity == nil {
+		return nil, newIllegalArgumentError("entity cannot be nil")
+	}
+
+	conventions := e.requestExecutor.GetConventions()
+	metadata := map[string]interface{}{}
+	if collectionName := conventions.getCollectionName(entity); collectionName != "" {
+		metadata[MetadataCollection] = collectionName
+	}
+	if goType := conventions.getGoTypeName(entity); goType != "" {
+		metadata[MetadataRavenGoType] = goType
+	}
+	document := convertEntityToJSON(entity, &documentInfo{metadata: metadata})
+
+	command := NewPutDocumentCommand(id, &changeVector, document)
+	if err := e.requestExecutor.ExecuteCommand(command, nil); err != nil {
+		return nil, err
+	}
+	return command.Result, nil
+}
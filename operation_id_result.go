@@ -3,4 +3,6 @@ package ravendb
 // OperationIDResult is a result of commands like CompactDatabaseCommand
 type OperationIDResult struct {
 	OperationID int64 `json:"OperationId"`
+	// OperationNodeTag is the tag of the node that runs the operation
+	OperationNodeTag string `json:"OperationNodeTag"`
 }
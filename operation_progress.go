@@ -0,0 +1,26 @@
+package ravendb
+
+// OperationProgress describes progress of an operation, as reported by the server
+// while the operation is in progress
+type OperationProgress struct {
+	Processed int64 `json:"Processed"`
+	// Total is 0 if the server doesn't know how much work is left
+	Total int64 `json:"Total"`
+
+	// Raw is the progress as sent by the server. Some operations,
+	// like smuggler imports, report operation-specific fields
+	Raw map[string]interface{} `json:"-"`
+}
+
+func newOperationProgress(raw map[string]interface{}) *OperationProgress {
+	res := &OperationProgress{
+		Raw: raw,
+	}
+	if v, ok := jsonGetAsInt64(raw, "Processed"); ok {
+		res.Processed = v
+	}
+	if v, ok := jsonGetAsInt64(raw, "Total"); ok {
+		res.Total = v
+	}
+	return res
+}
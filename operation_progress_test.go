@@ -0,0 +1,21 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOperationProgress(t *testing.T) {
+	var raw map[string]interface{}
+	err := jsonUnmarshal([]byte(`{"Processed":10,"Total":25,"$type":"DeterminateProgress"}`), &raw)
+	assert.NoError(t, err)
+	progress := newOperationProgress(raw)
+	assert.Equal(t, int64(10), progress.Processed)
+	assert.Equal(t, int64(25), progress.Total)
+	assert.Equal(t, "DeterminateProgress", progress.Raw["$type"])
+
+	progress = newOperationProgress(map[string]interface{}{"Processed": float64(3)})
+	assert.Equal(t, int64(3), progress.Processed)
+	assert.Equal(t, int64(0), progress.Total)
+}
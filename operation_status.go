@@ -0,0 +1,18 @@
+package ravendb
+
+// OperationStatus describes the status of a long-running server operation
+type OperationStatus string
+
+const (
+	OperationStatusInProgress OperationStatus = "InProgress"
+	OperationStatusCompleted  OperationStatus = "Completed"
+	OperationStatusFaulted    OperationStatus = "Faulted"
+	OperationStatusCanceled   OperationStatus = "Canceled"
+)
+
+var operationStatusValues = []string{string(OperationStatusInProgress), string(OperationStatusCompleted), string(OperationStatusFaulted), string(OperationStatusCanceled)}
+
+// MarshalJSON returns an error if OperationStatus is not one of the defined values
+func (s OperationStatus) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(s), "OperationStatus", operationStatusValues)
+}
@@ -0,0 +1,703 @@
+/*
+Package operations exposes operations that are sent to the server with
+DocumentStore.Operations() and DocumentStore.Maintenance(), outside of a session.
+
+This package is the curated surface for working with operations:
+
+  - operation types and their constructors, for documents, indexes,
+    counters, time series, attachments, revisions, compare exchange,
+    backups, ETL, replication and database configuration
+  - OperationExecutor, MaintenanceOperationExecutor and ServerOperationExecutor
+  - Operation, the handle of an operation running on the server, with its
+    status and progress types
+
+Cluster-wide operations are in serverwide/operations.
+
+The types are aliases of the types in package ravendb, so values can be
+used with either package. The constructors call the ones in package ravendb,
+which are deprecated:
+
+	op := operations.NewGetStatisticsOperation("")
+	err := store.Maintenance().Send(op)
+*/
+package operations
+
+import (
+	"io"
+	"reflect"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+)
+
+type (
+	AddEtlOperation                              = ravendb.AddEtlOperation
+	BulkOperationResult                          = ravendb.BulkOperationResult
+	CompactDatabaseOperation                     = ravendb.CompactDatabaseOperation
+	ConfigureRevisionsOperation                  = ravendb.ConfigureRevisionsOperation
+	ConfigureRevisionsOperationResult            = ravendb.ConfigureRevisionsOperationResult
+	ConfigureTimeSeriesOperation                 = ravendb.ConfigureTimeSeriesOperation
+	ConfigureTimeSeriesOperationResult           = ravendb.ConfigureTimeSeriesOperationResult
+	CounterBatchOperation                        = ravendb.CounterBatchOperation
+	CounterOperation                             = ravendb.CounterOperation
+	CounterOperationType                         = ravendb.CounterOperationType
+	CreateDatabaseOperation                      = ravendb.CreateDatabaseOperation
+	CreateSampleDataOperation                    = ravendb.CreateSampleDataOperation
+	DeleteAttachmentOperation                    = ravendb.DeleteAttachmentOperation
+	DeleteByQueryOperation                       = ravendb.DeleteByQueryOperation
+	DeleteCompareExchangeValueOperation          = ravendb.DeleteCompareExchangeValueOperation
+	DeleteDatabasesOperation                     = ravendb.DeleteDatabasesOperation
+	DeleteIndexOperation                         = ravendb.DeleteIndexOperation
+	DeleteOngoingTaskOperation                   = ravendb.DeleteOngoingTaskOperation
+	DeleteSorterOperation                        = ravendb.DeleteSorterOperation
+	DisableIndexOperation                        = ravendb.DisableIndexOperation
+	DocumentCountersOperation                    = ravendb.DocumentCountersOperation
+	EnableIndexOperation                         = ravendb.EnableIndexOperation
+	EtlOperationResult                           = ravendb.EtlOperationResult
+	GetAttachmentOperation                       = ravendb.GetAttachmentOperation
+	GetAttachmentsOperation                      = ravendb.GetAttachmentsOperation
+	GetClientConfigurationOperation              = ravendb.GetClientConfigurationOperation
+	GetCollectionStatisticsOperation             = ravendb.GetCollectionStatisticsOperation
+	GetCompareExchangeValueOperation             = ravendb.GetCompareExchangeValueOperation
+	GetCompareExchangeValuesOperation            = ravendb.GetCompareExchangeValuesOperation
+	GetConnectionStringsOperation                = ravendb.GetConnectionStringsOperation
+	GetCountersOperation                         = ravendb.GetCountersOperation
+	GetDatabaseNamesOperation                    = ravendb.GetDatabaseNamesOperation
+	GetDatabaseRecordOperation                   = ravendb.GetDatabaseRecordOperation
+	GetDetailedCollectionStatisticsOperation     = ravendb.GetDetailedCollectionStatisticsOperation
+	GetDetailedStatisticsOperation               = ravendb.GetDetailedStatisticsOperation
+	GetIdentitiesOperation                       = ravendb.GetIdentitiesOperation
+	GetIndexErrorsOperation                      = ravendb.GetIndexErrorsOperation
+	GetIndexNamesOperation                       = ravendb.GetIndexNamesOperation
+	GetIndexOperation                            = ravendb.GetIndexOperation
+	GetIndexPerformanceStatisticsOperation       = ravendb.GetIndexPerformanceStatisticsOperation
+	GetIndexStalenessOperation                   = ravendb.GetIndexStalenessOperation
+	GetIndexStatisticsOperation                  = ravendb.GetIndexStatisticsOperation
+	GetIndexesOperation                          = ravendb.GetIndexesOperation
+	GetIndexesStatisticsOperation                = ravendb.GetIndexesStatisticsOperation
+	GetIndexingStatusOperation                   = ravendb.GetIndexingStatusOperation
+	GetMultipleTimeSeriesOperation               = ravendb.GetMultipleTimeSeriesOperation
+	GetOngoingTaskInfoOperation                  = ravendb.GetOngoingTaskInfoOperation
+	GetPeriodicBackupStatusOperation             = ravendb.GetPeriodicBackupStatusOperation
+	GetPeriodicBackupStatusOperationResult       = ravendb.GetPeriodicBackupStatusOperationResult
+	GetReplicationHubAccessOperation             = ravendb.GetReplicationHubAccessOperation
+	GetReplicationPerformanceStatisticsOperation = ravendb.GetReplicationPerformanceStatisticsOperation
+	GetRevisionsConfigurationOperation           = ravendb.GetRevisionsConfigurationOperation
+	GetRevisionsOperation                        = ravendb.GetRevisionsOperation
+	GetStatisticsOperation                       = ravendb.GetStatisticsOperation
+	GetTermsOperation                            = ravendb.GetTermsOperation
+	GetTimeSeriesOperation                       = ravendb.GetTimeSeriesOperation
+	IMaintenanceOperation                        = ravendb.IMaintenanceOperation
+	IOperation                                   = ravendb.IOperation
+	IServerOperation                             = ravendb.IServerOperation
+	IVoidMaintenanceOperation                    = ravendb.IVoidMaintenanceOperation
+	MaintenanceOperationExecutor                 = ravendb.MaintenanceOperationExecutor
+	ModifyConflictSolverOperation                = ravendb.ModifyConflictSolverOperation
+	NextIdentityForOperation                     = ravendb.NextIdentityForOperation
+	Operation                                    = ravendb.Operation
+	OperationCancelledError                      = ravendb.OperationCancelledError
+	OperationExceptionResult                     = ravendb.OperationExceptionResult
+	OperationExecutor                            = ravendb.OperationExecutor
+	OperationIDResult                            = ravendb.OperationIDResult
+	OperationProgress                            = ravendb.OperationProgress
+	OperationStatus                              = ravendb.OperationStatus
+	OperationStatusChange                        = ravendb.OperationStatusChange
+	PatchByQueryOperation                        = ravendb.PatchByQueryOperation
+	PatchOperation                               = ravendb.PatchOperation
+	PatchOperationPayload                        = ravendb.PatchOperationPayload
+	PatchOperationResult                         = ravendb.PatchOperationResult
+	PutAttachmentOperation                       = ravendb.PutAttachmentOperation
+	PutClientConfigurationOperation              = ravendb.PutClientConfigurationOperation
+	PutCompareExchangeValueOperation             = ravendb.PutCompareExchangeValueOperation
+	PutConnectionStringOperation                 = ravendb.PutConnectionStringOperation
+	PutIndexesOperation                          = ravendb.PutIndexesOperation
+	PutPullReplicationAsHubOperation             = ravendb.PutPullReplicationAsHubOperation
+	PutSortersOperation                          = ravendb.PutSortersOperation
+	QueryOperationOptions                        = ravendb.QueryOperationOptions
+	RegisterReplicationHubAccessOperation        = ravendb.RegisterReplicationHubAccessOperation
+	RemoveConnectionStringOperation              = ravendb.RemoveConnectionStringOperation
+	ResetEtlOperation                            = ravendb.ResetEtlOperation
+	ResetIndexOperation                          = ravendb.ResetIndexOperation
+	RevertRevisionsOperation                     = ravendb.RevertRevisionsOperation
+	SeedIdentityForOperation                     = ravendb.SeedIdentityForOperation
+	ServerOperationExecutor                      = ravendb.ServerOperationExecutor
+	SetIndexesLockOperation                      = ravendb.SetIndexesLockOperation
+	SetIndexesPriorityOperation                  = ravendb.SetIndexesPriorityOperation
+	StartBackupOperation                         = ravendb.StartBackupOperation
+	StartBackupOperationResult                   = ravendb.StartBackupOperationResult
+	StartIndexOperation                          = ravendb.StartIndexOperation
+	StartIndexingOperation                       = ravendb.StartIndexingOperation
+	StopIndexOperation                           = ravendb.StopIndexOperation
+	StopIndexingOperation                        = ravendb.StopIndexingOperation
+	TestPatchOperation                           = ravendb.TestPatchOperation
+	TimeSeriesAppendOperation                    = ravendb.TimeSeriesAppendOperation
+	TimeSeriesBatchOperation                     = ravendb.TimeSeriesBatchOperation
+	TimeSeriesDeleteOperation                    = ravendb.TimeSeriesDeleteOperation
+	TimeSeriesIncrementOperation                 = ravendb.TimeSeriesIncrementOperation
+	TimeSeriesOperation                          = ravendb.TimeSeriesOperation
+	ToggleDatabasesStateOperation                = ravendb.ToggleDatabasesStateOperation
+	ToggleOngoingTaskStateOperation              = ravendb.ToggleOngoingTaskStateOperation
+	UnregisterReplicationHubAccessOperation      = ravendb.UnregisterReplicationHubAccessOperation
+	UpdateEtlOperation                           = ravendb.UpdateEtlOperation
+	UpdateExternalReplicationOperation           = ravendb.UpdateExternalReplicationOperation
+	UpdatePeriodicBackupOperation                = ravendb.UpdatePeriodicBackupOperation
+	UpdatePeriodicBackupOperationResult          = ravendb.UpdatePeriodicBackupOperationResult
+	UpdatePullReplicationAsSinkOperation         = ravendb.UpdatePullReplicationAsSinkOperation
+)
+
+// NewAddEtlOperation returns new AddEtlOperation. configuration is
+// *RavenEtlConfiguration, *SqlEtlConfiguration or *OlapEtlConfiguration
+func NewAddEtlOperation(configuration ravendb.IEtlConfiguration) (*AddEtlOperation, error) {
+	return ravendb.NewAddEtlOperation(configuration)
+}
+
+// NewCompactDatabaseOperation returns new CompactDatabaseOperation
+func NewCompactDatabaseOperation(compactSettings *ravendb.CompactSettings) *CompactDatabaseOperation {
+	return ravendb.NewCompactDatabaseOperation(compactSettings)
+}
+
+// NewConfigureRevisionsOperation returns new ConfigureRevisionsOperation
+func NewConfigureRevisionsOperation(configuration *ravendb.RevisionsConfiguration) *ConfigureRevisionsOperation {
+	return ravendb.NewConfigureRevisionsOperation(configuration)
+}
+
+// NewConfigureTimeSeriesOperation returns new ConfigureTimeSeriesOperation
+func NewConfigureTimeSeriesOperation(configuration *ravendb.TimeSeriesConfiguration) *ConfigureTimeSeriesOperation {
+	return ravendb.NewConfigureTimeSeriesOperation(configuration)
+}
+
+// NewCounterBatchOperation returns new CounterBatchOperation
+func NewCounterBatchOperation(counterBatch *ravendb.CounterBatch) *CounterBatchOperation {
+	return ravendb.NewCounterBatchOperation(counterBatch)
+}
+
+// NewCounterOperationDelete returns an operation that deletes counter name
+func NewCounterOperationDelete(name string) *CounterOperation {
+	return ravendb.NewCounterOperationDelete(name)
+}
+
+// NewCounterOperationGet returns an operation that gets value of counter name
+func NewCounterOperationGet(name string) *CounterOperation {
+	return ravendb.NewCounterOperationGet(name)
+}
+
+// NewCounterOperationIncrement returns an operation that increments counter name by delta
+func NewCounterOperationIncrement(name string, delta int64) *CounterOperation {
+	return ravendb.NewCounterOperationIncrement(name, delta)
+}
+
+// NewCreateDatabaseOperation returns CreateDatabaseOperation
+// replicationFactor is ignored if databaseRecord.DatabaseTopology specifies
+// ReplicationFactor or Members
+func NewCreateDatabaseOperation(databaseRecord *ravendb.DatabaseRecord, replicationFactor int) *CreateDatabaseOperation {
+	return ravendb.NewCreateDatabaseOperation(databaseRecord, replicationFactor)
+}
+
+// NewCreateSampleDataOperation is the same as ravendb.NewCreateSampleDataOperation
+func NewCreateSampleDataOperation() *CreateSampleDataOperation {
+	return ravendb.NewCreateSampleDataOperation()
+}
+
+// NewDeleteAttachmentOperation is the same as ravendb.NewDeleteAttachmentOperation
+func NewDeleteAttachmentOperation(documentID string, name string, changeVector *string) *DeleteAttachmentOperation {
+	return ravendb.NewDeleteAttachmentOperation(documentID, name, changeVector)
+}
+
+// NewDeleteByQueryOperation is the same as ravendb.NewDeleteByQueryOperation
+func NewDeleteByQueryOperation(queryToDelete *ravendb.IndexQuery, options *QueryOperationOptions) (*DeleteByQueryOperation, error) {
+	return ravendb.NewDeleteByQueryOperation(queryToDelete, options)
+}
+
+// NewDeleteCompareExchangeValueOperation is the same as ravendb.NewDeleteCompareExchangeValueOperation
+func NewDeleteCompareExchangeValueOperation(clazz reflect.Type, key string, index int64) (*DeleteCompareExchangeValueOperation, error) {
+	return ravendb.NewDeleteCompareExchangeValueOperation(clazz, key, index)
+}
+
+// NewDeleteDatabasesOperation is the same as ravendb.NewDeleteDatabasesOperation
+func NewDeleteDatabasesOperation(databaseName string, hardDelete bool) *DeleteDatabasesOperation {
+	return ravendb.NewDeleteDatabasesOperation(databaseName, hardDelete)
+}
+
+// NewDeleteDatabasesOperation2 is the same as ravendb.NewDeleteDatabasesOperation2
+func NewDeleteDatabasesOperation2(databaseName string, hardDelete bool, fromNode string, timeToWaitForConfirmation time.Duration) *DeleteDatabasesOperation {
+	return ravendb.NewDeleteDatabasesOperation2(databaseName, hardDelete, fromNode, timeToWaitForConfirmation)
+}
+
+// NewDeleteDatabasesOperationWithParameters is the same as ravendb.NewDeleteDatabasesOperationWithParameters
+func NewDeleteDatabasesOperationWithParameters(parameters *ravendb.DeleteDatabaseParameters) *DeleteDatabasesOperation {
+	return ravendb.NewDeleteDatabasesOperationWithParameters(parameters)
+}
+
+// NewDeleteIndexOperation returns DeleteIndexOperation
+func NewDeleteIndexOperation(indexName string) *DeleteIndexOperation {
+	return ravendb.NewDeleteIndexOperation(indexName)
+}
+
+// NewDeleteOngoingTaskOperation returns new DeleteOngoingTaskOperation
+func NewDeleteOngoingTaskOperation(taskID int64, taskType ravendb.OngoingTaskType) (*DeleteOngoingTaskOperation, error) {
+	return ravendb.NewDeleteOngoingTaskOperation(taskID, taskType)
+}
+
+// NewDeleteSorterOperation returns new DeleteSorterOperation
+func NewDeleteSorterOperation(sorterName string) (*DeleteSorterOperation, error) {
+	return ravendb.NewDeleteSorterOperation(sorterName)
+}
+
+// NewDisableIndexOperation returns DisableIndexOperation
+func NewDisableIndexOperation(indexName string) (*DisableIndexOperation, error) {
+	return ravendb.NewDisableIndexOperation(indexName)
+}
+
+// NewDisableIndexOperationClusterWide returns DisableIndexOperation that disables
+// an index on all nodes of the cluster. The change is persisted in
+// the database record
+func NewDisableIndexOperationClusterWide(indexName string) (*DisableIndexOperation, error) {
+	return ravendb.NewDisableIndexOperationClusterWide(indexName)
+}
+
+// NewEnableIndexOperation returns EnableIndexOperation
+func NewEnableIndexOperation(indexName string) (*EnableIndexOperation, error) {
+	return ravendb.NewEnableIndexOperation(indexName)
+}
+
+// NewEnableIndexOperationClusterWide returns EnableIndexOperation that enables
+// an index on all nodes of the cluster. The change is persisted in
+// the database record
+func NewEnableIndexOperationClusterWide(indexName string) (*EnableIndexOperation, error) {
+	return ravendb.NewEnableIndexOperationClusterWide(indexName)
+}
+
+// NewGetAttachmentIfModifiedOperation returns an operation that gets an attachment
+// only if its change vector is different than changeVector, e.g. the one
+// of a copy cached by the caller. If the attachment didn't change,
+// Command.Result.NotModified is true and no data is downloaded
+func NewGetAttachmentIfModifiedOperation(documentID string, name string, changeVector string) *GetAttachmentOperation {
+	return ravendb.NewGetAttachmentIfModifiedOperation(documentID, name, changeVector)
+}
+
+// NewGetAttachmentOperation is the same as ravendb.NewGetAttachmentOperation
+func NewGetAttachmentOperation(documentID string, name string, typ ravendb.AttachmentType, contentType string, changeVector *string) *GetAttachmentOperation {
+	return ravendb.NewGetAttachmentOperation(documentID, name, typ, contentType, changeVector)
+}
+
+// NewGetAttachmentRangeOperation returns an operation that gets only bytes
+// from from to to (inclusive) of an attachment's content. If to is negative,
+// the content is returned until its end. A negative from or to smaller
+// than from is reported as an error when the command is created
+func NewGetAttachmentRangeOperation(documentID string, name string, from int64, to int64) *GetAttachmentOperation {
+	return ravendb.NewGetAttachmentRangeOperation(documentID, name, from, to)
+}
+
+// NewGetAttachmentsOperation returns an operation that gets attachments.
+// The result is Command.Result, which must be closed
+func NewGetAttachmentsOperation(attachments []*ravendb.AttachmentRequest, typ ravendb.AttachmentType) *GetAttachmentsOperation {
+	return ravendb.NewGetAttachmentsOperation(attachments, typ)
+}
+
+// NewGetClientConfigurationOperation is the same as ravendb.NewGetClientConfigurationOperation
+func NewGetClientConfigurationOperation() *GetClientConfigurationOperation {
+	return ravendb.NewGetClientConfigurationOperation()
+}
+
+// NewGetCollectionStatisticsOperation is the same as ravendb.NewGetCollectionStatisticsOperation
+func NewGetCollectionStatisticsOperation() *GetCollectionStatisticsOperation {
+	return ravendb.NewGetCollectionStatisticsOperation()
+}
+
+// NewGetCompareExchangeValueOperation is the same as ravendb.NewGetCompareExchangeValueOperation
+func NewGetCompareExchangeValueOperation(clazz reflect.Type, key string) (*GetCompareExchangeValueOperation, error) {
+	return ravendb.NewGetCompareExchangeValueOperation(clazz, key)
+}
+
+// NewGetCompareExchangeValuesOperation is the same as ravendb.NewGetCompareExchangeValuesOperation
+func NewGetCompareExchangeValuesOperation(clazz reflect.Type, startWith string, start int, pageSize int) (*GetCompareExchangeValuesOperation, error) {
+	return ravendb.NewGetCompareExchangeValuesOperation(clazz, startWith, start, pageSize)
+}
+
+// NewGetCompareExchangeValuesOperationWithKeys is the same as ravendb.NewGetCompareExchangeValuesOperationWithKeys
+func NewGetCompareExchangeValuesOperationWithKeys(clazz reflect.Type, keys []string) (*GetCompareExchangeValuesOperation, error) {
+	return ravendb.NewGetCompareExchangeValuesOperationWithKeys(clazz, keys)
+}
+
+// NewGetConnectionStringOperation returns an operation that gets a connection
+// string with a given name and type
+func NewGetConnectionStringOperation(connectionStringName string, typ ravendb.ConnectionStringType) (*GetConnectionStringsOperation, error) {
+	return ravendb.NewGetConnectionStringOperation(connectionStringName, typ)
+}
+
+// NewGetConnectionStringsOperation returns an operation that gets all connection strings
+func NewGetConnectionStringsOperation() *GetConnectionStringsOperation {
+	return ravendb.NewGetConnectionStringsOperation()
+}
+
+// NewGetCountersOperation returns an operation that gets values of counters
+// of document docID. If counters is empty, all counters are returned.
+// If returnFullResults is true, values on each node are returned
+// in CounterDetail.CounterValues
+func NewGetCountersOperation(docID string, counters []string, returnFullResults bool) *GetCountersOperation {
+	return ravendb.NewGetCountersOperation(docID, counters, returnFullResults)
+}
+
+// NewGetDatabaseNamesOperation is the same as ravendb.NewGetDatabaseNamesOperation
+func NewGetDatabaseNamesOperation(_start int, _pageSize int) *GetDatabaseNamesOperation {
+	return ravendb.NewGetDatabaseNamesOperation(_start, _pageSize)
+}
+
+// NewGetDatabaseRecordOperation is the same as ravendb.NewGetDatabaseRecordOperation
+func NewGetDatabaseRecordOperation(database string) *GetDatabaseRecordOperation {
+	return ravendb.NewGetDatabaseRecordOperation(database)
+}
+
+// NewGetDetailedCollectionStatisticsOperation returns new GetDetailedCollectionStatisticsOperation
+func NewGetDetailedCollectionStatisticsOperation() *GetDetailedCollectionStatisticsOperation {
+	return ravendb.NewGetDetailedCollectionStatisticsOperation()
+}
+
+// NewGetDetailedStatisticsOperation returns new GetDetailedStatisticsOperation
+func NewGetDetailedStatisticsOperation(debugTag string) *GetDetailedStatisticsOperation {
+	return ravendb.NewGetDetailedStatisticsOperation(debugTag)
+}
+
+// NewGetIdentitiesOperation is the same as ravendb.NewGetIdentitiesOperation
+func NewGetIdentitiesOperation() *GetIdentitiesOperation {
+	return ravendb.NewGetIdentitiesOperation()
+}
+
+// NewGetIndexErrorsOperation is the same as ravendb.NewGetIndexErrorsOperation
+func NewGetIndexErrorsOperation(indexNames []string) *GetIndexErrorsOperation {
+	return ravendb.NewGetIndexErrorsOperation(indexNames)
+}
+
+// NewGetIndexNamesOperation is the same as ravendb.NewGetIndexNamesOperation
+func NewGetIndexNamesOperation(start int, pageSize int) *GetIndexNamesOperation {
+	return ravendb.NewGetIndexNamesOperation(start, pageSize)
+}
+
+// NewGetIndexOperation returns GetIndexOperation
+func NewGetIndexOperation(indexName string) *GetIndexOperation {
+	return ravendb.NewGetIndexOperation(indexName)
+}
+
+// NewGetIndexPerformanceStatisticsOperation returns GetIndexPerformanceStatisticsOperation
+func NewGetIndexPerformanceStatisticsOperation(indexNames ...string) *GetIndexPerformanceStatisticsOperation {
+	return ravendb.NewGetIndexPerformanceStatisticsOperation(indexNames...)
+}
+
+// NewGetIndexStalenessOperation returns GetIndexStalenessOperation
+func NewGetIndexStalenessOperation(indexName string) *GetIndexStalenessOperation {
+	return ravendb.NewGetIndexStalenessOperation(indexName)
+}
+
+// NewGetIndexStatisticsOperation is the same as ravendb.NewGetIndexStatisticsOperation
+func NewGetIndexStatisticsOperation(indexName string) *GetIndexStatisticsOperation {
+	return ravendb.NewGetIndexStatisticsOperation(indexName)
+}
+
+// NewGetIndexesOperation is the same as ravendb.NewGetIndexesOperation
+func NewGetIndexesOperation(_start int, _pageSize int) *GetIndexesOperation {
+	return ravendb.NewGetIndexesOperation(_start, _pageSize)
+}
+
+// NewGetIndexesStatisticsOperation is the same as ravendb.NewGetIndexesStatisticsOperation
+func NewGetIndexesStatisticsOperation() *GetIndexesStatisticsOperation {
+	return ravendb.NewGetIndexesStatisticsOperation()
+}
+
+// NewGetIndexingStatusOperation is the same as ravendb.NewGetIndexingStatusOperation
+func NewGetIndexingStatusOperation() *GetIndexingStatusOperation {
+	return ravendb.NewGetIndexingStatusOperation()
+}
+
+// NewGetMultipleTimeSeriesOperation returns an operation that gets entries
+// in ranges, skipping start entries and returning at most pageSize
+// entries (0 means no limit)
+func NewGetMultipleTimeSeriesOperation(docID string, ranges []*ravendb.TimeSeriesRange, start int, pageSize int) *GetMultipleTimeSeriesOperation {
+	return ravendb.NewGetMultipleTimeSeriesOperation(docID, ranges, start, pageSize)
+}
+
+// NewGetOngoingTaskInfoOperation returns an operation that gets a task by id
+func NewGetOngoingTaskInfoOperation(taskID int64, taskType ravendb.OngoingTaskType) (*GetOngoingTaskInfoOperation, error) {
+	return ravendb.NewGetOngoingTaskInfoOperation(taskID, taskType)
+}
+
+// NewGetOngoingTaskInfoOperationByName returns an operation that gets a task by name
+func NewGetOngoingTaskInfoOperationByName(taskName string, taskType ravendb.OngoingTaskType) (*GetOngoingTaskInfoOperation, error) {
+	return ravendb.NewGetOngoingTaskInfoOperationByName(taskName, taskType)
+}
+
+// NewGetPeriodicBackupStatusOperation returns new GetPeriodicBackupStatusOperation
+func NewGetPeriodicBackupStatusOperation(taskID int64) *GetPeriodicBackupStatusOperation {
+	return ravendb.NewGetPeriodicBackupStatusOperation(taskID)
+}
+
+// NewGetReplicationHubAccessOperation returns new GetReplicationHubAccessOperation.
+// pageSize of 0 means server's default
+func NewGetReplicationHubAccessOperation(hubName string, start int, pageSize int) (*GetReplicationHubAccessOperation, error) {
+	return ravendb.NewGetReplicationHubAccessOperation(hubName, start, pageSize)
+}
+
+// NewGetReplicationPerformanceStatisticsOperation returns new GetReplicationPerformanceStatisticsOperation
+func NewGetReplicationPerformanceStatisticsOperation() *GetReplicationPerformanceStatisticsOperation {
+	return ravendb.NewGetReplicationPerformanceStatisticsOperation()
+}
+
+// NewGetRevisionsConfigurationOperation returns new GetRevisionsConfigurationOperation
+func NewGetRevisionsConfigurationOperation() *GetRevisionsConfigurationOperation {
+	return ravendb.NewGetRevisionsConfigurationOperation()
+}
+
+// NewGetRevisionsOperation returns an operation that gets at most pageSize
+// revisions of document id, skipping start most recent revisions.
+// pageSize of 0 means the server's default
+func NewGetRevisionsOperation(id string, start int, pageSize int) *GetRevisionsOperation {
+	return ravendb.NewGetRevisionsOperation(id, start, pageSize)
+}
+
+// NewGetStatisticsOperation is the same as ravendb.NewGetStatisticsOperation
+func NewGetStatisticsOperation(debugTag string) *GetStatisticsOperation {
+	return ravendb.NewGetStatisticsOperation(debugTag)
+}
+
+// NewGetTermsOperation returns GetTermsOperation. pageSize 0 means default size
+func NewGetTermsOperation(indexName string, field string, fromValue string, pageSize int) (*GetTermsOperation, error) {
+	return ravendb.NewGetTermsOperation(indexName, field, fromValue, pageSize)
+}
+
+// NewGetTimeSeriesOperation returns an operation that gets entries of time series
+// name between from and to (inclusive, nil means unbounded), skipping start
+// entries and returning at most pageSize entries (0 means no limit)
+func NewGetTimeSeriesOperation(docID string, name string, from *time.Time, to *time.Time, start int, pageSize int) *GetTimeSeriesOperation {
+	return ravendb.NewGetTimeSeriesOperation(docID, name, from, to, start, pageSize)
+}
+
+// NewMaintenanceOperationExecutor is the same as ravendb.NewMaintenanceOperationExecutor
+func NewMaintenanceOperationExecutor(store *ravendb.DocumentStore, databaseName string) *MaintenanceOperationExecutor {
+	return ravendb.NewMaintenanceOperationExecutor(store, databaseName)
+}
+
+// NewModifyConflictSolverOperation returns new ModifyConflictSolverOperation.
+// collectionByScript maps collection names to resolver scripts and can be nil
+func NewModifyConflictSolverOperation(database string, collectionByScript map[string]*ravendb.ScriptResolver, resolveToLatest bool) (*ModifyConflictSolverOperation, error) {
+	return ravendb.NewModifyConflictSolverOperation(database, collectionByScript, resolveToLatest)
+}
+
+// NewNextIdentityForOperation returns new NextIdentityForOperation
+func NewNextIdentityForOperation(name string) (*NextIdentityForOperation, error) {
+	return ravendb.NewNextIdentityForOperation(name)
+}
+
+// NewOperation is the same as ravendb.NewOperation
+func NewOperation(requestExecutor *ravendb.RequestExecutor, changes func() *ravendb.DatabaseChanges, conventions *ravendb.DocumentConventions, id int64) *Operation {
+	return ravendb.NewOperation(requestExecutor, changes, conventions, id)
+}
+
+// NewOperationExecutor is the same as ravendb.NewOperationExecutor
+func NewOperationExecutor(store *ravendb.DocumentStore, databaseName string) *OperationExecutor {
+	return ravendb.NewOperationExecutor(store, databaseName)
+}
+
+// NewPatchByQueryOperation is the same as ravendb.NewPatchByQueryOperation
+func NewPatchByQueryOperation(queryToUpdate string) *PatchByQueryOperation {
+	return ravendb.NewPatchByQueryOperation(queryToUpdate)
+}
+
+// NewPatchOperation returns new PatchOperation
+func NewPatchOperation(id string, changeVector *string, patch *ravendb.PatchRequest, patchIfMissing *ravendb.PatchRequest, skipPatchIfChangeVectorMismatch bool) (*PatchOperation, error) {
+	return ravendb.NewPatchOperation(id, changeVector, patch, patchIfMissing, skipPatchIfChangeVectorMismatch)
+}
+
+// NewPutAttachmentOperation is the same as ravendb.NewPutAttachmentOperation
+func NewPutAttachmentOperation(documentID string, name string, stream io.Reader, contentType string, changeVector *string) *PutAttachmentOperation {
+	return ravendb.NewPutAttachmentOperation(documentID, name, stream, contentType, changeVector)
+}
+
+// NewPutClientConfigurationOperation is the same as ravendb.NewPutClientConfigurationOperation
+func NewPutClientConfigurationOperation(configuration *ravendb.ClientConfiguration) (*PutClientConfigurationOperation, error) {
+	return ravendb.NewPutClientConfigurationOperation(configuration)
+}
+
+// NewPutCompareExchangeValueOperation is the same as ravendb.NewPutCompareExchangeValueOperation
+func NewPutCompareExchangeValueOperation(key string, value interface{}, index int64) (*PutCompareExchangeValueOperation, error) {
+	return ravendb.NewPutCompareExchangeValueOperation(key, value, index)
+}
+
+// NewPutCompareExchangeValueOperationWithMetadata is like NewPutCompareExchangeValueOperation
+// but also stores metadata with the value. Setting MetadataExpires metadata
+// to a time formatted with RFC3339 makes the value expire
+func NewPutCompareExchangeValueOperationWithMetadata(key string, value interface{}, index int64, metadata map[string]interface{}) (*PutCompareExchangeValueOperation, error) {
+	return ravendb.NewPutCompareExchangeValueOperationWithMetadata(key, value, index, metadata)
+}
+
+// NewPutConnectionStringOperation returns new PutConnectionStringOperation.
+// connectionString should be *RavenConnectionString, *SqlConnectionString
+// or *OlapConnectionString
+func NewPutConnectionStringOperation(connectionString interface{}) *PutConnectionStringOperation {
+	return ravendb.NewPutConnectionStringOperation(connectionString)
+}
+
+// NewPutIndexesOperation returns new PutIndexesOperation
+func NewPutIndexesOperation(indexToAdd ...*ravendb.IndexDefinition) *PutIndexesOperation {
+	return ravendb.NewPutIndexesOperation(indexToAdd...)
+}
+
+// NewPutPullReplicationAsHubOperation returns new PutPullReplicationAsHubOperation
+func NewPutPullReplicationAsHubOperation(definition *ravendb.PullReplicationDefinition) (*PutPullReplicationAsHubOperation, error) {
+	return ravendb.NewPutPullReplicationAsHubOperation(definition)
+}
+
+// NewPutSortersOperation returns new PutSortersOperation
+func NewPutSortersOperation(sortersToAdd ...*ravendb.SorterDefinition) (*PutSortersOperation, error) {
+	return ravendb.NewPutSortersOperation(sortersToAdd...)
+}
+
+// NewRegisterReplicationHubAccessOperation returns new RegisterReplicationHubAccessOperation
+func NewRegisterReplicationHubAccessOperation(hubName string, access *ravendb.ReplicationHubAccess) (*RegisterReplicationHubAccessOperation, error) {
+	return ravendb.NewRegisterReplicationHubAccessOperation(hubName, access)
+}
+
+// NewRemoveConnectionStringOperation returns new RemoveConnectionStringOperation
+func NewRemoveConnectionStringOperation(connectionStringName string, typ ravendb.ConnectionStringType) (*RemoveConnectionStringOperation, error) {
+	return ravendb.NewRemoveConnectionStringOperation(connectionStringName, typ)
+}
+
+// NewResetEtlOperation returns new ResetEtlOperation
+func NewResetEtlOperation(configurationName string, transformationName string) (*ResetEtlOperation, error) {
+	return ravendb.NewResetEtlOperation(configurationName, transformationName)
+}
+
+// NewResetIndexOperation returns ResetIndexOperation
+func NewResetIndexOperation(indexName string) (*ResetIndexOperation, error) {
+	return ravendb.NewResetIndexOperation(indexName)
+}
+
+// NewRevertRevisionsOperation returns an operation reverting documents
+// of collections (all if empty) to their state at pointInTime
+func NewRevertRevisionsOperation(pointInTime time.Time, window time.Duration, collections ...string) *RevertRevisionsOperation {
+	return ravendb.NewRevertRevisionsOperation(pointInTime, window, collections...)
+}
+
+// NewSeedIdentityForOperation returns new SeedIdentityForOperation
+func NewSeedIdentityForOperation(name string, value int64, forceUpdate bool) (*SeedIdentityForOperation, error) {
+	return ravendb.NewSeedIdentityForOperation(name, value, forceUpdate)
+}
+
+// NewServerOperationExecutor is the same as ravendb.NewServerOperationExecutor
+func NewServerOperationExecutor(store *ravendb.DocumentStore) *ServerOperationExecutor {
+	return ravendb.NewServerOperationExecutor(store)
+}
+
+// NewServerWideOperation is the same as ravendb.NewServerWideOperation
+func NewServerWideOperation(requestExecutor *ravendb.RequestExecutor, conventions *ravendb.DocumentConventions, id int64) *Operation {
+	return ravendb.NewServerWideOperation(requestExecutor, conventions, id)
+}
+
+// NewSetIndexesLockOperation returns SetIndexesLockOperation for a single index
+func NewSetIndexesLockOperation(indexName string, mode ravendb.IndexLockMode) (*SetIndexesLockOperation, error) {
+	return ravendb.NewSetIndexesLockOperation(indexName, mode)
+}
+
+// NewSetIndexesLockOperationWithParameters returns SetIndexesLockOperation
+// for multiple indexes
+func NewSetIndexesLockOperationWithParameters(parameters *ravendb.SetIndexesLockParameters) (*SetIndexesLockOperation, error) {
+	return ravendb.NewSetIndexesLockOperationWithParameters(parameters)
+}
+
+// NewSetIndexesPriorityOperation returns new SetIndexesPriorityParameters
+func NewSetIndexesPriorityOperation(indexName string, priority ravendb.IndexPriority) (*SetIndexesPriorityOperation, error) {
+	return ravendb.NewSetIndexesPriorityOperation(indexName, priority)
+}
+
+// NewSetIndexesPriorityOperationWithParameters returns SetIndexesPriorityOperation
+// for multiple indexes
+func NewSetIndexesPriorityOperationWithParameters(parameters *ravendb.SetIndexesPriorityParameters) (*SetIndexesPriorityOperation, error) {
+	return ravendb.NewSetIndexesPriorityOperationWithParameters(parameters)
+}
+
+// NewStartBackupOperation returns new StartBackupOperation
+func NewStartBackupOperation(isFullBackup bool, taskID int64) *StartBackupOperation {
+	return ravendb.NewStartBackupOperation(isFullBackup, taskID)
+}
+
+// NewStartIndexOperation is the same as ravendb.NewStartIndexOperation
+func NewStartIndexOperation(indexName string) (*StartIndexOperation, error) {
+	return ravendb.NewStartIndexOperation(indexName)
+}
+
+// NewStartIndexingOperation is the same as ravendb.NewStartIndexingOperation
+func NewStartIndexingOperation() *StartIndexingOperation {
+	return ravendb.NewStartIndexingOperation()
+}
+
+// NewStopIndexOperation is the same as ravendb.NewStopIndexOperation
+func NewStopIndexOperation(indexName string) (*StopIndexOperation, error) {
+	return ravendb.NewStopIndexOperation(indexName)
+}
+
+// NewStopIndexingOperation is the same as ravendb.NewStopIndexingOperation
+func NewStopIndexingOperation() *StopIndexingOperation {
+	return ravendb.NewStopIndexingOperation()
+}
+
+// NewTestPatchOperation returns new TestPatchOperation. patchIfMissing can be nil
+func NewTestPatchOperation(id string, patch *ravendb.PatchRequest, patchIfMissing *ravendb.PatchRequest) (*TestPatchOperation, error) {
+	return ravendb.NewTestPatchOperation(id, patch, patchIfMissing)
+}
+
+// NewTimeSeriesBatchOperation returns new TimeSeriesBatchOperation
+func NewTimeSeriesBatchOperation(documentID string, operation *TimeSeriesOperation) *TimeSeriesBatchOperation {
+	return ravendb.NewTimeSeriesBatchOperation(documentID, operation)
+}
+
+// NewTimeSeriesOperation returns an operation on time series name
+func NewTimeSeriesOperation(name string) *TimeSeriesOperation {
+	return ravendb.NewTimeSeriesOperation(name)
+}
+
+// NewToggleDatabasesStateOperation returns new ToggleDatabasesStateOperation
+func NewToggleDatabasesStateOperation(databaseNames []string, disable bool) (*ToggleDatabasesStateOperation, error) {
+	return ravendb.NewToggleDatabasesStateOperation(databaseNames, disable)
+}
+
+// NewToggleOngoingTaskStateOperation returns new ToggleOngoingTaskStateOperation
+func NewToggleOngoingTaskStateOperation(taskID int64, taskType ravendb.OngoingTaskType, disable bool) (*ToggleOngoingTaskStateOperation, error) {
+	return ravendb.NewToggleOngoingTaskStateOperation(taskID, taskType, disable)
+}
+
+// NewToggleOngoingTaskStateOperationByName returns an operation that
+// enables or disables a task with a given name
+func NewToggleOngoingTaskStateOperationByName(taskName string, taskType ravendb.OngoingTaskType, disable bool) (*ToggleOngoingTaskStateOperation, error) {
+	return ravendb.NewToggleOngoingTaskStateOperationByName(taskName, taskType, disable)
+}
+
+// NewUnregisterReplicationHubAccessOperation returns new UnregisterReplicationHubAccessOperation
+func NewUnregisterReplicationHubAccessOperation(hubName string, thumbprint string) (*UnregisterReplicationHubAccessOperation, error) {
+	return ravendb.NewUnregisterReplicationHubAccessOperation(hubName, thumbprint)
+}
+
+// NewUpdateEtlOperation returns new UpdateEtlOperation. configuration is
+// *RavenEtlConfiguration, *SqlEtlConfiguration or *OlapEtlConfiguration
+func NewUpdateEtlOperation(taskID int64, configuration ravendb.IEtlConfiguration) (*UpdateEtlOperation, error) {
+	return ravendb.NewUpdateEtlOperation(taskID, configuration)
+}
+
+// NewUpdateExternalReplicationOperation returns new UpdateExternalReplicationOperation
+func NewUpdateExternalReplicationOperation(newWatcher *ravendb.ExternalReplication) *UpdateExternalReplicationOperation {
+	return ravendb.NewUpdateExternalReplicationOperation(newWatcher)
+}
+
+// NewUpdatePeriodicBackupOperation returns new UpdatePeriodicBackupOperation
+func NewUpdatePeriodicBackupOperation(configuration *ravendb.PeriodicBackupConfiguration) *UpdatePeriodicBackupOperation {
+	return ravendb.NewUpdatePeriodicBackupOperation(configuration)
+}
+
+// NewUpdatePullReplicationAsSinkOperation returns new UpdatePullReplicationAsSinkOperation
+func NewUpdatePullReplicationAsSinkOperation(sink *ravendb.PullReplicationAsSink) (*UpdatePullReplicationAsSinkOperation, error) {
+	return ravendb.NewUpdatePullReplicationAsSinkOperation(sink)
+}
+
+const (
+	OperationStatusInProgress = ravendb.OperationStatusInProgress
+	OperationStatusCompleted  = ravendb.OperationStatusCompleted
+	OperationStatusFaulted    = ravendb.OperationStatusFaulted
+	OperationStatusCanceled   = ravendb.OperationStatusCanceled
+)
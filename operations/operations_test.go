@@ -0,0 +1,28 @@
+package operations
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasesAreInterchangeable(t *testing.T) {
+	var op ravendb.IMaintenanceOperation = NewGetStatisticsOperation("")
+	_, ok := op.(*GetStatisticsOperation)
+	assert.True(t, ok)
+
+	var status OperationStatus = ravendb.OperationStatusCompleted
+	assert.Equal(t, OperationStatusCompleted, status)
+}
+
+func TestConstructorsMatchRootPackage(t *testing.T) {
+	assert.Equal(t, ravendb.NewGetStatisticsOperation("tag"), NewGetStatisticsOperation("tag"))
+	assert.Equal(t, ravendb.NewCounterOperationIncrement("likes", 2), NewCounterOperationIncrement("likes", 2))
+
+	op, err := NewDeleteByQueryOperation(ravendb.NewIndexQuery("from Users"), nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, op)
+	_, err = NewDeleteByQueryOperation(nil, nil)
+	assert.Error(t, err)
+}
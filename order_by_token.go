@@ -14,6 +14,8 @@ type orderByToken struct {
 	fieldName  string
 	descending bool
 	ordering   OrderingType
+	// name of a custom sorter registered on the server
+	sorterName string
 }
 
 func newOrderByToken(fieldName string, descending bool, ordering OrderingType) *orderByToken {
@@ -57,7 +59,31 @@ func orderByTokenCreateDescending(fieldName string, ordering OrderingType) *orde
 	return newOrderByToken(fieldName, true, ordering)
 }
 
+func orderByTokenCreateAscendingWithSorter(fieldName string, sorterName string) *orderByToken {
+	res := newOrderByToken(fieldName, false, OrderingTypeString)
+	res.sorterName = sorterName
+	return res
+}
+
+func orderByTokenCreateDescendingWithSorter(fieldName string, sorterName string) *orderByToken {
+	res := newOrderByToken(fieldName, true, OrderingTypeString)
+	res.sorterName = sorterName
+	return res
+}
+
 func (t *orderByToken) writeTo(writer *strings.Builder) error {
+	if t.sorterName != "" {
+		writer.WriteString("custom(")
+		writeQueryTokenField(writer, t.fieldName)
+		writer.WriteString(", '")
+		writer.WriteString(strings.Replace(t.sorterName, "'", "''", -1))
+		writer.WriteString("')")
+		if t.descending {
+			writer.WriteString(" desc")
+		}
+		return nil
+	}
+
 	writeQueryTokenField(writer, t.fieldName)
 
 	switch t.ordering {
@@ -0,0 +1,170 @@
+package ravendb
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// OutboxMessage is an event stored in the same transaction as the domain
+// documents it describes and later delivered by OutboxDispatcher.
+// Outbox messages are stored in the OutboxMessages collection
+type OutboxMessage struct {
+	ID        string
+	Type      string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// GetPayload decodes payload of the message into v
+func (m *OutboxMessage) GetPayload(v interface{}) error {
+	return jsonUnmarshal(m.Payload, v)
+}
+
+// StoreOutboxMessage adds an outbox message with a given type and payload
+// to the session. The message is saved by the next SaveChanges, in the same
+// transaction as all other changes made in the session, so it's only
+// dispatched if the domain changes are saved
+//
+// Deprecated: use subscriptions.StoreOutboxMessage.
+func StoreOutboxMessage(session *DocumentSession, messageType string, payload interface{}) (*OutboxMessage, error) {
+	if session == nil {
+		return nil, newIllegalArgumentError("session cannot be nil")
+	}
+	if messageType == "" {
+		return nil, newIllegalArgumentError("messageType cannot be empty")
+	}
+	d, err := jsonMarshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	msg := &OutboxMessage{
+		Type:      messageType,
+		Payload:   d,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err = session.Store(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// OutboxDispatcher delivers outbox messages to a handler using a data
+// subscription. A message is deleted after the handler successfully processes
+// it, so delivery is at-least-once and handlers should be idempotent.
+// If the handler returns an error, the dispatcher stops and the error
+// is available via Err()
+type OutboxDispatcher struct {
+	store            *DocumentStore
+	subscriptionName string
+	database         string
+
+	mu     sync.Mutex
+	worker *SubscriptionWorker
+}
+
+// NewOutboxDispatcher returns a dispatcher which uses subscription with a given
+// name, creating it if it doesn't exist. Empty database means store's database
+//
+// Deprecated: use subscriptions.NewOutboxDispatcher.
+func NewOutboxDispatcher(store *DocumentStore, subscriptionName string, database string) (*OutboxDispatcher, error) {
+	if store == nil {
+		return nil, newIllegalArgumentError("store cannot be nil")
+	}
+	if subscriptionName == "" {
+		return nil, newIllegalArgumentError("subscriptionName cannot be empty")
+	}
+	return &OutboxDispatcher{
+		store:            store,
+		subscriptionName: subscriptionName,
+		database:         database,
+	}, nil
+}
+
+func (d *OutboxDispatcher) ensureSubscription() error {
+	state, err := d.store.Subscriptions().GetSubscriptionState(d.subscriptionName, d.database)
+	if err != nil {
+		if _, ok := err.(*SubscriptionDoesNotExistError); !ok {
+			return err
+		}
+	}
+	if state != nil {
+		return nil
+	}
+	options := &SubscriptionCreationOptions{
+		Name: d.subscriptionName,
+	}
+	_, err = d.store.Subscriptions().CreateForType(reflect.TypeOf(&OutboxMessage{}), options, d.database)
+	return err
+}
+
+// Start starts delivering outbox messages to handler in the background
+func (d *OutboxDispatcher) Start(handler func(*OutboxMessage) error) error {
+	if handler == nil {
+		return newIllegalArgumentError("handler cannot be nil")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.worker != nil {
+		return newIllegalStateError("The dispatcher is already running")
+	}
+	if err := d.ensureSubscription(); err != nil {
+		return err
+	}
+
+	options := NewSubscriptionWorkerOptions(d.subscriptionName)
+	worker, err := d.store.Subscriptions().GetSubscriptionWorker(reflect.TypeOf(&OutboxMessage{}), options, d.database)
+	if err != nil {
+		return err
+	}
+	cb := func(batch *SubscriptionBatch) error {
+		session, err := batch.OpenSession()
+		if err != nil {
+			return err
+		}
+		defer session.Close()
+		for _, item := range batch.Items {
+			var msg *OutboxMessage
+			if err = item.GetResult(&msg); err != nil {
+				return err
+			}
+			msg.ID = item.ID
+			if err = handler(msg); err != nil {
+				return err
+			}
+			if err = session.DeleteByID(item.ID, item.ChangeVector); err != nil {
+				return err
+			}
+		}
+		return session.SaveChanges()
+	}
+	if err = worker.Run(cb); err != nil {
+		_ = worker.Close()
+		return err
+	}
+	d.worker = worker
+	return nil
+}
+
+// Err returns the error that stopped the dispatcher, if any
+func (d *OutboxDispatcher) Err() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.worker == nil {
+		return nil
+	}
+	return d.worker.Err()
+}
+
+// Close stops the dispatcher
+func (d *OutboxDispatcher) Close() error {
+	d.mu.Lock()
+	worker := d.worker
+	d.worker = nil
+	d.mu.Unlock()
+	if worker == nil {
+		return nil
+	}
+	return worker.Close()
+}
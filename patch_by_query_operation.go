@@ -17,6 +17,7 @@ type PatchByQueryOperation struct {
 	_options       *QueryOperationOptions
 }
 
+// Deprecated: use operations.NewPatchByQueryOperation.
 func NewPatchByQueryOperation(queryToUpdate string) *PatchByQueryOperation {
 	return &PatchByQueryOperation{
 		_queryToUpdate: NewIndexQuery(queryToUpdate),
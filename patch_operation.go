@@ -23,7 +23,13 @@ type PatchOperationResult struct {
 	Document map[string]interface{} `json:"Document"`
 }
 
+// GetResult deserializes the patched document into result, which should be
+// a pointer to a pointer to struct. Does nothing if the document wasn't returned
+// e.g. because it doesn't exist
 func (r *PatchOperationResult) GetResult(result interface{}) error {
+	if r.Document == nil {
+		return nil
+	}
 	entityType := reflect.TypeOf(result)
 	entity, err := makeStructFromJSONMap(entityType, r.Document)
 	if err != nil {
@@ -44,6 +50,8 @@ type PatchOperation struct {
 }
 
 // NewPatchOperation returns new PatchOperation
+//
+// Deprecated: use operations.NewPatchOperation.
 func NewPatchOperation(id string, changeVector *string, patch *PatchRequest, patchIfMissing *PatchRequest, skipPatchIfChangeVectorMismatch bool) (*PatchOperation, error) {
 	if patch == nil {
 		return nil, newIllegalArgumentError("Patch cannot be null")
@@ -165,7 +173,9 @@ func (c *PatchCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 		"PatchIfMissing": patchIfMissing,
 	}
 	d, err := jsonMarshal(m)
-	panicIf(err != nil, "jsonMarshal failed with %s", err)
+	if err != nil {
+		return nil, err
+	}
 
 	request, err := newHttpPatch(url, d)
 	if err != nil {
@@ -11,3 +11,19 @@ type PatchResult struct {
 	ChangeVector *string `json:"ChangeVector"`
 	Collection   string  `json:"Collection"`
 }
+
+// GetDebugOutput returns lines written by output() calls in the patch script.
+// Only available if the patch was executed with debug information
+func (r *PatchResult) GetDebugOutput() []string {
+	info, ok := r.Debug["Info"].([]interface{})
+	if !ok {
+		return nil
+	}
+	var res []string
+	for _, v := range info {
+		if s, ok := v.(string); ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
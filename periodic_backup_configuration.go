@@ -0,0 +1,68 @@
+package ravendb
+
+// EncryptionMode describes how a backup is encrypted
+type EncryptionMode string
+
+const (
+	EncryptionModeNone           EncryptionMode = "None"
+	EncryptionModeUseDatabaseKey EncryptionMode = "UseDatabaseKey"
+	EncryptionModeUseProvidedKey EncryptionMode = "UseProvidedKey"
+)
+
+var encryptionModeValues = []string{string(EncryptionModeNone), string(EncryptionModeUseDatabaseKey), string(EncryptionModeUseProvidedKey)}
+
+// MarshalJSON returns an error if EncryptionMode is not one of the defined values
+func (e EncryptionMode) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(e), "EncryptionMode", encryptionModeValues)
+}
+
+// BackupEncryptionSettings describes encryption of a backup.
+// Key is only used with EncryptionModeUseProvidedKey
+type BackupEncryptionSettings struct {
+	Key            string         `json:"Key,omitempty"`
+	EncryptionMode EncryptionMode `json:"EncryptionMode"`
+}
+
+// RetentionPolicy describes how long backups are kept
+type RetentionPolicy struct {
+	Disabled               bool      `json:"Disabled"`
+	MinimumBackupAgeToKeep *Duration `json:"MinimumBackupAgeToKeep,omitempty"`
+}
+
+// BackupConfiguration describes a backup type and its destinations.
+// A nil destination is not used
+type BackupConfiguration struct {
+	BackupType               BackupType                `json:"BackupType"`
+	BackupEncryptionSettings *BackupEncryptionSettings `json:"BackupEncryptionSettings,omitempty"`
+	LocalSettings            *LocalSettings            `json:"LocalSettings,omitempty"`
+	S3Settings               *S3Settings               `json:"S3Settings,omitempty"`
+	GlacierSettings          *GlacierSettings          `json:"GlacierSettings,omitempty"`
+	AzureSettings            *AzureSettings            `json:"AzureSettings,omitempty"`
+	FtpSettings              *FtpSettings              `json:"FtpSettings,omitempty"`
+	GoogleCloudSettings      *GoogleCloudSettings      `json:"GoogleCloudSettings,omitempty"`
+}
+
+// PeriodicBackupConfiguration describes a scheduled backup task.
+// Frequencies are cron expressions e.g. "0 2 * * *". TaskID is 0
+// when creating a new task
+type PeriodicBackupConfiguration struct {
+	BackupConfiguration
+
+	Name                       string           `json:"Name,omitempty"`
+	TaskID                     int64            `json:"TaskId"`
+	Disabled                   bool             `json:"Disabled"`
+	MentorNode                 string           `json:"MentorNode,omitempty"`
+	FullBackupFrequency        string           `json:"FullBackupFrequency,omitempty"`
+	IncrementalBackupFrequency string           `json:"IncrementalBackupFrequency,omitempty"`
+	RetentionPolicy            *RetentionPolicy `json:"RetentionPolicy,omitempty"`
+}
+
+func (c *PeriodicBackupConfiguration) validate() error {
+	if c.BackupType != BackupTypeBackup && c.BackupType != BackupTypeSnapshot {
+		return newIllegalArgumentError("BackupType must be '%s' or '%s'", BackupTypeBackup, BackupTypeSnapshot)
+	}
+	if c.FullBackupFrequency == "" && c.IncrementalBackupFrequency == "" {
+		return newIllegalArgumentError("Either FullBackupFrequency or IncrementalBackupFrequency must be set")
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package ravendb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodicBackupConfigurationSerialization(t *testing.T) {
+	minAge := Duration(48 * time.Hour)
+	config := &PeriodicBackupConfiguration{
+		BackupConfiguration: BackupConfiguration{
+			BackupType: BackupTypeBackup,
+			LocalSettings: &LocalSettings{
+				FolderPath: "/backups",
+			},
+			S3Settings: &S3Settings{
+				AmazonSettings: AmazonSettings{
+					AwsAccessKey:  "key",
+					AwsSecretKey:  "secret",
+					AwsRegionName: "us-east-1",
+				},
+				BucketName: "bucket",
+			},
+		},
+		Name:                "nightly",
+		FullBackupFrequency: "0 2 * * *",
+		RetentionPolicy: &RetentionPolicy{
+			MinimumBackupAgeToKeep: &minAge,
+		},
+	}
+	cmd, err := NewUpdatePeriodicBackupCommand(config)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/admin/periodic-backup", req.URL.String())
+
+	d, err := jsonMarshal(config)
+	assert.NoError(t, err)
+	var m map[string]interface{}
+	err = jsonUnmarshal(d, &m)
+	assert.NoError(t, err)
+	assert.Equal(t, "Backup", m["BackupType"])
+	assert.Equal(t, "nightly", m["Name"])
+	assert.Equal(t, "/backups", m["LocalSettings"].(map[string]interface{})["FolderPath"])
+	s3 := m["S3Settings"].(map[string]interface{})
+	assert.Equal(t, "key", s3["AwsAccessKey"])
+	assert.Equal(t, "bucket", s3["BucketName"])
+	assert.Equal(t, false, s3["Disabled"])
+	assert.Nil(t, m["AzureSettings"])
+	assert.Equal(t, "2.00:00:00", m["RetentionPolicy"].(map[string]interface{})["MinimumBackupAgeToKeep"])
+}
+
+func TestPeriodicBackupConfigurationValidation(t *testing.T) {
+	_, err := NewUpdatePeriodicBackupCommand(nil)
+	assert.Error(t, err)
+	_, err = NewUpdatePeriodicBackupCommand(&PeriodicBackupConfiguration{FullBackupFrequency: "* * * * *"})
+	assert.Error(t, err)
+	config := &PeriodicBackupConfiguration{}
+	config.BackupType = BackupTypeSnapshot
+	_, err = NewUpdatePeriodicBackupCommand(config)
+	assert.Error(t, err)
+
+	cmd := NewStartBackupCommand(true, 12)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/admin/backup/database?isFullBackup=true&taskId=12", req.URL.String())
+}
@@ -0,0 +1,61 @@
+package ravendb
+
+// BackupError describes the last error of a backup task
+type BackupError struct {
+	Exception string `json:"Exception"`
+	At        *Time  `json:"At"`
+}
+
+// LocalBackup describes the status of backup to a local folder
+type LocalBackup struct {
+	BackupDirectory               string `json:"BackupDirectory"`
+	FileName                      string `json:"FileName"`
+	TempFolderUsed                bool   `json:"TempFolderUsed"`
+	LastFullBackup                *Time  `json:"LastFullBackup"`
+	LastIncrementalBackup         *Time  `json:"LastIncrementalBackup"`
+	FullBackupDurationInMs        *int64 `json:"FullBackupDurationInMs"`
+	IncrementalBackupDurationInMs *int64 `json:"IncrementalBackupDurationInMs"`
+	Exception                     string `json:"Exception"`
+}
+
+// UploadStatus describes the status of backup upload to a remote destination
+type UploadStatus struct {
+	Skipped                       bool   `json:"Skipped"`
+	LastFullBackup                *Time  `json:"LastFullBackup"`
+	LastIncrementalBackup         *Time  `json:"LastIncrementalBackup"`
+	FullBackupDurationInMs        *int64 `json:"FullBackupDurationInMs"`
+	IncrementalBackupDurationInMs *int64 `json:"IncrementalBackupDurationInMs"`
+	Exception                     string `json:"Exception"`
+}
+
+// PeriodicBackupStatus describes the status of a periodic backup task
+type PeriodicBackupStatus struct {
+	TaskID                        int64          `json:"TaskId"`
+	BackupType                    BackupType     `json:"BackupType"`
+	IsFull                        bool           `json:"IsFull"`
+	NodeTag                       string         `json:"NodeTag"`
+	LastFullBackup                *Time          `json:"LastFullBackup"`
+	LastIncrementalBackup         *Time          `json:"LastIncrementalBackup"`
+	LastFullBackupInternal        *Time          `json:"LastFullBackupInternal"`
+	LastIncrementalBackupInternal *Time          `json:"LastIncrementalBackupInternal"`
+	LocalBackup                   *LocalBackup   `json:"LocalBackup"`
+	UploadToS3                    *UploadStatus  `json:"UploadToS3"`
+	UploadToGlacier               *UploadStatus  `json:"UploadToGlacier"`
+	UploadToAzure                 *UploadStatus  `json:"UploadToAzure"`
+	UploadToFtp                   *UploadStatus  `json:"UploadToFtp"`
+	UploadToGoogleCloud           *UploadStatus  `json:"UploadToGoogleCloud"`
+	LastEtag                      *int64         `json:"LastEtag"`
+	LastDatabaseChangeVector      string         `json:"LastDatabaseChangeVector"`
+	LastRaftIndex                 *LastRaftIndex `json:"LastRaftIndex"`
+	FolderName                    string         `json:"FolderName"`
+	DurationInMs                  *int64         `json:"DurationInMs"`
+	Version                       int64          `json:"Version"`
+	Error                         *BackupError   `json:"Error"`
+	LastOperationID               *int64         `json:"LastOperationId"`
+	IsEncrypted                   bool           `json:"IsEncrypted"`
+}
+
+// LastRaftIndex describes the last cluster transaction included in a backup
+type LastRaftIndex struct {
+	LastEtag *int64 `json:"LastEtag"`
+}
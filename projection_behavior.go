@@ -0,0 +1,30 @@
+package ravendb
+
+// ProjectionBehavior controls where the values of projected fields are taken from
+type ProjectionBehavior string
+
+const (
+	// try to take values from index (stored fields), fall back to document
+	ProjectionBehaviorDefault ProjectionBehavior = "Default"
+	// take values from index, use null if field is not stored in index
+	ProjectionBehaviorFromIndex ProjectionBehavior = "FromIndex"
+	// take values from index, return an error if field is not stored in index
+	ProjectionBehaviorFromIndexOrThrow ProjectionBehavior = "FromIndexOrThrow"
+	// take values from document, use null if field doesn't exist in document
+	ProjectionBehaviorFromDocument ProjectionBehavior = "FromDocument"
+	// take values from document, return an error if field doesn't exist in document
+	ProjectionBehaviorFromDocumentOrThrow ProjectionBehavior = "FromDocumentOrThrow"
+)
+
+var projectionBehaviorValues = []string{
+	string(ProjectionBehaviorDefault),
+	string(ProjectionBehaviorFromIndex),
+	string(ProjectionBehaviorFromIndexOrThrow),
+	string(ProjectionBehaviorFromDocument),
+	string(ProjectionBehaviorFromDocumentOrThrow),
+}
+
+// MarshalJSON returns an error if ProjectionBehavior is not one of the defined values
+func (p ProjectionBehavior) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(p), "ProjectionBehavior", projectionBehaviorValues)
+}
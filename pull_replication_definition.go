@@ -0,0 +1,149 @@
+package ravendb
+
+// PullReplicationMode describes the direction of pull replication.
+// Modes can be combined with PullReplicationModeHubToSink + ", " + PullReplicationModeSinkToHub
+type PullReplicationMode string
+
+const (
+	PullReplicationModeNone      PullReplicationMode = "None"
+	PullReplicationModeHubToSink PullReplicationMode = "HubToSink"
+	PullReplicationModeSinkToHub PullReplicationMode = "SinkToHub"
+)
+
+var pullReplicationModeValues = []string{string(PullReplicationModeNone), string(PullReplicationModeHubToSink), string(PullReplicationModeSinkToHub)}
+
+// MarshalJSON returns an error if PullReplicationMode is not one of the defined values
+func (p PullReplicationMode) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(p), "PullReplicationMode", pullReplicationModeValues)
+}
+
+// PreventDeletionsMode describes how a hub handles deletions coming from sinks
+type PreventDeletionsMode string
+
+const (
+	PreventDeletionsModeNone                      PreventDeletionsMode = "None"
+	PreventDeletionsModePreventSinkToHubDeletions PreventDeletionsMode = "PreventSinkToHubDeletions"
+)
+
+var preventDeletionsModeValues = []string{string(PreventDeletionsModeNone), string(PreventDeletionsModePreventSinkToHubDeletions)}
+
+// MarshalJSON returns an error if PreventDeletionsMode is not one of the defined values
+func (p PreventDeletionsMode) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(p), "PreventDeletionsMode", preventDeletionsModeValues)
+}
+
+// PullReplicationDefinition describes a pull replication hub task.
+// Sinks connect to the hub and replicate to (and/or from) it
+type PullReplicationDefinition struct {
+	TaskID     int64  `json:"TaskId,omitempty"`
+	Name       string `json:"Name"`
+	MentorNode string `json:"MentorNode,omitempty"`
+	Disabled   bool   `json:"Disabled"`
+	// DelayReplicationFor delays replicating changes to sinks
+	DelayReplicationFor Duration `json:"DelayReplicationFor"`
+	// Mode defaults to PullReplicationModeHubToSink
+	Mode PullReplicationMode `json:"Mode"`
+	// if true, each sink only gets documents matching paths allowed
+	// by its access registered with RegisterReplicationHubAccessOperation
+	WithFiltering        bool                 `json:"WithFiltering"`
+	PreventDeletionsMode PreventDeletionsMode `json:"PreventDeletionsMode,omitempty"`
+}
+
+// NewPullReplicationDefinition returns a hub definition with a given name
+func NewPullReplicationDefinition(name string) *PullReplicationDefinition {
+	return &PullReplicationDefinition{
+		Name: name,
+		Mode: PullReplicationModeHubToSink,
+	}
+}
+
+func (d *PullReplicationDefinition) validate() error {
+	if d.Name == "" {
+		return newIllegalArgumentError("Name cannot be empty")
+	}
+	if d.Mode == PullReplicationModeNone {
+		return newIllegalArgumentError("Pull replication Mode cannot be %s", PullReplicationModeNone)
+	}
+	return nil
+}
+
+// PullReplicationAsSink describes a pull replication sink task, which connects
+// to a hub described by a Raven connection string
+type PullReplicationAsSink struct {
+	ReplicationNode
+	TaskID               int64  `json:"TaskId,omitempty"`
+	Name                 string `json:"Name"`
+	ConnectionStringName string `json:"ConnectionStringName"`
+	MentorNode           string `json:"MentorNode,omitempty"`
+	// HubName is the name of PullReplicationDefinition on the hub
+	HubName string `json:"HubName"`
+	// Mode defaults to PullReplicationModeHubToSink
+	Mode PullReplicationMode `json:"Mode"`
+	// AccessName is a name of access registered on the hub, required with certificates
+	AccessName            string   `json:"AccessName,omitempty"`
+	AllowedHubToSinkPaths []string `json:"AllowedHubToSinkPaths"`
+	AllowedSinkToHubPaths []string `json:"AllowedSinkToHubPaths"`
+	// base64 encoded certificate with a private key used to authenticate
+	// with the hub. Needed when the hub runs on a secured server
+	CertificateWithPrivateKey string `json:"CertificateWithPrivateKey,omitempty"`
+	CertificatePassword       string `json:"CertificatePassword,omitempty"`
+}
+
+// NewPullReplicationAsSink returns a sink of a hub with a given name, which
+// connects to it using connection string with a given name
+func NewPullReplicationAsSink(database string, connectionStringName string, hubName string) *PullReplicationAsSink {
+	return &PullReplicationAsSink{
+		ReplicationNode: ReplicationNode{
+			Database: database,
+		},
+		ConnectionStringName: connectionStringName,
+		HubName:              hubName,
+		Mode:                 PullReplicationModeHubToSink,
+	}
+}
+
+func (s *PullReplicationAsSink) validate() error {
+	if s.ConnectionStringName == "" {
+		return newIllegalArgumentError("ConnectionStringName cannot be empty")
+	}
+	if s.HubName == "" {
+		return newIllegalArgumentError("HubName cannot be empty")
+	}
+	if s.Mode == PullReplicationModeNone {
+		return newIllegalArgumentError("Pull replication Mode cannot be %s", PullReplicationModeNone)
+	}
+	return nil
+}
+
+// ReplicationHubAccess allows a sink authenticating with a given certificate
+// to connect to a hub
+type ReplicationHubAccess struct {
+	Name string `json:"Name"`
+	// base64 encoded public part of the certificate
+	CertificateBase64     string   `json:"CertificateBase64"`
+	AllowedHubToSinkPaths []string `json:"AllowedHubToSinkPaths"`
+	AllowedSinkToHubPaths []string `json:"AllowedSinkToHubPaths"`
+}
+
+func (a *ReplicationHubAccess) validate() error {
+	if a.Name == "" {
+		return newIllegalArgumentError("Name cannot be empty")
+	}
+	if a.CertificateBase64 == "" {
+		return newIllegalArgumentError("CertificateBase64 cannot be empty")
+	}
+	return nil
+}
+
+// DetailedReplicationHubAccess describes access registered on a hub
+type DetailedReplicationHubAccess struct {
+	Name                  string   `json:"Name"`
+	Thumbprint            string   `json:"Thumbprint"`
+	Certificate           string   `json:"Certificate"`
+	NotBefore             Time     `json:"NotBefore"`
+	NotAfter              Time     `json:"NotAfter"`
+	Subject               string   `json:"Subject"`
+	Issuer                string   `json:"Issuer"`
+	AllowedHubToSinkPaths []string `json:"AllowedHubToSinkPaths"`
+	AllowedSinkToHubPaths []string `json:"AllowedSinkToHubPaths"`
+}
@@ -0,0 +1,47 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullReplicationValidation(t *testing.T) {
+	_, err := NewPutPullReplicationAsHubOperation(nil)
+	assert.Error(t, err)
+	_, err = NewPutPullReplicationAsHubOperation(NewPullReplicationDefinition(""))
+	assert.Error(t, err)
+	_, err = NewPutPullReplicationAsHubOperation(NewPullReplicationDefinition("hub"))
+	assert.NoError(t, err)
+
+	_, err = NewUpdatePullReplicationAsSinkOperation(NewPullReplicationAsSink("db", "", "hub"))
+	assert.Error(t, err)
+	_, err = NewUpdatePullReplicationAsSinkOperation(NewPullReplicationAsSink("db", "cs", ""))
+	assert.Error(t, err)
+
+	_, err = NewRegisterReplicationHubAccessOperation("hub", &ReplicationHubAccess{Name: "sink"})
+	assert.Error(t, err)
+	_, err = NewUnregisterReplicationHubAccessOperation("hub", "")
+	assert.Error(t, err)
+}
+
+func TestUpdatePullReplicationAsSinkCommand(t *testing.T) {
+	sink := NewPullReplicationAsSink("db", "cs", "hub")
+	sink.Name = "sink"
+	op, err := NewUpdatePullReplicationAsSinkOperation(sink)
+	assert.NoError(t, err)
+	cmd, err := op.GetCommand(NewDocumentConventions())
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://localhost:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/databases/db/admin/tasks/sink-pull-replication", req.URL.String())
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	var m map[string]map[string]interface{}
+	assert.NoError(t, jsonUnmarshal(body, &m))
+	got := m["PullReplicationAsSink"]
+	assert.Equal(t, "hub", got["HubName"])
+	assert.Equal(t, "cs", got["ConnectionStringName"])
+	assert.Equal(t, string(PullReplicationModeHubToSink), got["Mode"])
+}
@@ -1,8 +1,8 @@
 package ravendb
 
 import (
-	"bytes"
 	"io"
+	"io/ioutil"
 	"net/http"
 )
 
@@ -20,6 +20,7 @@ type PutAttachmentOperation struct {
 	_changeVector *string
 }
 
+// Deprecated: use operations.NewPutAttachmentOperation.
 func NewPutAttachmentOperation(documentID string, name string, stream io.Reader, contentType string, changeVector *string) *PutAttachmentOperation {
 	return &PutAttachmentOperation{
 		_documentID:   documentID,
@@ -47,10 +48,18 @@ type PutAttachmentCommand struct {
 	_contentType  string
 	_changeVector *string
 
+	// position of seekable _stream when the command was first sent, -1 before
+	streamStart int64
+	sent        bool
+
 	Result *AttachmentDetails
 }
 
-// TODO: should stream be io.ReadCloser? Who owns closing the attachment
+// NewPutAttachmentCommand returns a command that stores an attachment,
+// streaming its content from stream. The caller owns the stream.
+// If stream is also an io.Seeker, the request can be retried on another node.
+// Otherwise a failed request is not sent again, because part of the stream
+// might already be consumed
 func NewPutAttachmentCommand(documentID string, name string, stream io.Reader, contentType string, changeVector *string) (*PutAttachmentCommand, error) {
 	if stringIsBlank(documentID) {
 		return nil, newIllegalArgumentError("documentId cannot be null")
@@ -60,6 +69,10 @@ func NewPutAttachmentCommand(documentID string, name string, stream io.Reader, c
 		return nil, newIllegalArgumentError("name cannot be null")
 	}
 
+	if stream == nil {
+		return nil, newIllegalArgumentError("stream cannot be nil")
+	}
+
 	cmd := &PutAttachmentCommand{
 		RavenCommandBase: NewRavenCommandBase(),
 
@@ -68,12 +81,11 @@ func NewPutAttachmentCommand(documentID string, name string, stream io.Reader, c
 		_stream:       stream,
 		_contentType:  contentType,
 		_changeVector: changeVector,
+		streamStart:   -1,
 	}
 	return cmd, nil
 }
 
-var noReader = true
-
 func (c *PutAttachmentCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	url := node.URL + "/databases/" + node.Database + "/attachments?id=" + urlUtilsEscapeDataString(c._documentID) + "&name=" + urlUtilsEscapeDataString(c._name)
 
@@ -81,28 +93,55 @@ func (c *PutAttachmentCommand) CreateRequest(node *ServerNode) (*http.Request, e
 		url += "&contentType=" + urlUtilsEscapeDataString(c._contentType)
 	}
 
-	if noReader {
-		var buf bytes.Buffer
-		_, err := io.Copy(&buf, c._stream)
-		if err != nil {
+	// when retried on another node, the content is sent again
+	seeker, canRewind := c._stream.(io.Seeker)
+	if !canRewind && c.sent {
+		return nil, newIllegalStateError("Attachment request can't be retried because the stream can't be rewound")
+	}
+	c.sent = true
+	contentLength := int64(-1)
+	if canRewind {
+		if err := c.rewindStream(seeker); err != nil {
 			return nil, err
 		}
-		req, err := newHttpPut(url, buf.Bytes())
-		if err != nil {
+		if end, err := seeker.Seek(0, io.SeekEnd); err == nil {
+			contentLength = end - c.streamStart
+		}
+		if err := c.rewindStream(seeker); err != nil {
 			return nil, err
 		}
-		req.Header.Del("Content-Type")
-		addChangeVectorIfNotNull(c._changeVector, req)
-		return req, nil
 	}
 
 	req, err := newHttpPutReader(url, c._stream)
 	if err != nil {
 		return nil, err
 	}
+	if canRewind {
+		if contentLength >= 0 {
+			req.ContentLength = contentLength
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			if err := c.rewindStream(seeker); err != nil {
+				return nil, err
+			}
+			return ioutil.NopCloser(c._stream), nil
+		}
+	}
 	addChangeVectorIfNotNull(c._changeVector, req)
 	return req, nil
+}
 
+// rewindStream moves seekable stream to where it was when the command was first sent
+func (c *PutAttachmentCommand) rewindStream(seeker io.Seeker) error {
+	if c.streamStart < 0 {
+		pos, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		c.streamStart = pos
+	}
+	_, err := seeker.Seek(c.streamStart, io.SeekStart)
+	return err
 }
 
 func (c *PutAttachmentCommand) SetResponse(response []byte, fromCache bool) error {
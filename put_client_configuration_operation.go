@@ -11,6 +11,7 @@ type PutClientConfigurationOperation struct {
 	Command       *PutClientConfigurationCommand
 }
 
+// Deprecated: use operations.NewPutClientConfigurationOperation.
 func NewPutClientConfigurationOperation(configuration *ClientConfiguration) (*PutClientConfigurationOperation, error) {
 	if configuration == nil {
 		return nil, newIllegalArgumentError("Configuration cannot be null")
@@ -4,6 +4,8 @@ package ravendb
 type PutCommandDataWithJSON struct {
 	*CommandData
 	document map[string]interface{}
+
+	forceRevisionCreationStrategy ForceRevisionStrategy
 }
 
 var _ ICommandData = &PutCommandDataWithJSON{} // verify interface match
@@ -26,5 +28,8 @@ func newPutCommandDataWithJSON(id string, changeVector *string, document map[str
 func (d *PutCommandDataWithJSON) serialize(conventions *DocumentConventions) (interface{}, error) {
 	js := d.baseJSON()
 	js["Document"] = d.document
+	if d.forceRevisionCreationStrategy != "" && d.forceRevisionCreationStrategy != ForceRevisionStrategyNone {
+		js["ForceRevisionCreationStrategy"] = d.forceRevisionCreationStrategy
+	}
 	return js, nil
 }
@@ -12,11 +12,13 @@ var (
 type PutCompareExchangeValueOperation struct {
 	Command *PutCompareExchangeValueCommand
 
-	_key   string
-	_value interface{}
-	_index int64
+	_key      string
+	_value    interface{}
+	_index    int64
+	_metadata map[string]interface{}
 }
 
+// Deprecated: use operations.NewPutCompareExchangeValueOperation.
 func NewPutCompareExchangeValueOperation(key string, value interface{}, index int64) (*PutCompareExchangeValueOperation, error) {
 	if stringIsEmpty(key) {
 		return nil, newIllegalArgumentError("The key argument must have value")
@@ -33,10 +35,28 @@ func NewPutCompareExchangeValueOperation(key string, value interface{}, index in
 	}, nil
 }
 
+// NewPutCompareExchangeValueOperationWithMetadata is like NewPutCompareExchangeValueOperation
+// but also stores metadata with the value. Setting MetadataExpires metadata
+// to a time formatted with RFC3339 makes the value expire
+//
+// Deprecated: use operations.NewPutCompareExchangeValueOperationWithMetadata.
+func NewPutCompareExchangeValueOperationWithMetadata(key string, value interface{}, index int64, metadata map[string]interface{}) (*PutCompareExchangeValueOperation, error) {
+	res, err := NewPutCompareExchangeValueOperation(key, value, index)
+	if err != nil {
+		return nil, err
+	}
+	res._metadata = metadata
+	return res, nil
+}
+
 func (o *PutCompareExchangeValueOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
 	var err error
 	o.Command, err = NewPutCompareExchangeValueCommand(o._key, o._value, o._index, conventions)
-	return o.Command, err
+	if err != nil {
+		return nil, err
+	}
+	o.Command._metadata = o._metadata
+	return o.Command, nil
 }
 
 var _ RavenCommand = &PutCompareExchangeValueCommand{}
@@ -47,6 +67,7 @@ type PutCompareExchangeValueCommand struct {
 	_key         string
 	_value       interface{}
 	_index       int64
+	_metadata    map[string]interface{}
 	_conventions *DocumentConventions
 
 	Result *CompareExchangeResult
@@ -72,17 +93,19 @@ func NewPutCompareExchangeValueCommand(key string, value interface{}, index int6
 }
 
 func (c *PutCompareExchangeValueCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
-	url := node.URL + "/databases/" + node.Database + "/cmpxchg?key=" + c._key + "&index=" + i64toa(c._index)
+	url := node.URL + "/databases/" + node.Database + "/cmpxchg?key=" + urlUtilsEscapeDataString(c._key) + "&index=" + i64toa(c._index)
 
 	m := map[string]interface{}{
 		"Object": c._value,
 	}
+	if len(c._metadata) > 0 {
+		m[MetadataKey] = c._metadata
+	}
 	d, err := jsonMarshal(m)
 	if err != nil {
 		return nil, err
 	}
 	return newHttpPut(url, d)
-
 }
 
 func (c *PutCompareExchangeValueCommand) SetResponse(response []byte, fromCache bool) error {
@@ -8,12 +8,19 @@ var (
 	_ IMaintenanceOperation = &PutConnectionStringOperation{}
 )
 
+// PutConnectionStringOperation creates or replaces a connection string
+// used by ETL and external replication
 type PutConnectionStringOperation struct {
 	connectionString interface{}
 
 	Command *PutConnectionStringCommand
 }
 
+// NewPutConnectionStringOperation returns new PutConnectionStringOperation.
+// connectionString should be *RavenConnectionString, *SqlConnectionString
+// or *OlapConnectionString
+//
+// Deprecated: use operations.NewPutConnectionStringOperation.
 func NewPutConnectionStringOperation(connectionString interface{}) *PutConnectionStringOperation {
 	return &PutConnectionStringOperation{
 		connectionString: connectionString,
@@ -21,6 +28,9 @@ func NewPutConnectionStringOperation(connectionString interface{}) *PutConnectio
 }
 
 func (o *PutConnectionStringOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	if o.connectionString == nil {
+		return nil, newIllegalArgumentError("connectionString cannot be nil")
+	}
 	o.Command = NewPutConnectionStringCommand(o.connectionString)
 	return o.Command, nil
 }
@@ -35,7 +45,8 @@ type PutConnectionStringCommand struct {
 	Result *PutConnectionStringResult
 }
 
-// connectionString should be ConnectionString or RavenConnectionString
+// connectionString should be *RavenConnectionString, *SqlConnectionString
+// or *OlapConnectionString
 func NewPutConnectionStringCommand(connectionString interface{}) *PutConnectionStringCommand {
 	return &PutConnectionStringCommand{
 		RavenCommandBase: NewRavenCommandBase(),
@@ -16,6 +16,8 @@ type PutIndexesOperation struct {
 }
 
 // NewPutIndexesOperation returns new PutIndexesOperation
+//
+// Deprecated: use operations.NewPutIndexesOperation.
 func NewPutIndexesOperation(indexToAdd ...*IndexDefinition) *PutIndexesOperation {
 	return &PutIndexesOperation{
 		indexToAdd: indexToAdd,
@@ -57,12 +59,17 @@ func NewPutIndexesCommand(conventions *DocumentConventions, indexesToAdd []*Inde
 	}
 
 	for _, indexToAdd := range indexesToAdd {
+		if indexToAdd == nil {
+			return nil, newIllegalArgumentError("indexesToAdd cannot contain nil")
+		}
+		if indexToAdd.Name == "" {
+			return nil, newIllegalArgumentError("Index name cannot be empty")
+		}
 		// Note: unlike java, Type is not calculated on demand. This is a decent
 		// place to ensure it. Assumes that indexToAdd will not be modified
 		// between now an CreateRequest()
 		indexToAdd.updateIndexTypeAndMaps()
 
-		panicIf(indexToAdd.Name == "", "Index name cannot be empty")
 		objectNode := convertEntityToJSON(indexToAdd, nil)
 		cmd.indexToAdd = append(cmd.indexToAdd, objectNode)
 	}
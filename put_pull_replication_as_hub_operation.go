@@ -0,0 +1,75 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &PutPullReplicationAsHubOperation{}
+)
+
+// PutPullReplicationAsHubOperation creates or updates (if TaskID is set)
+// a pull replication hub
+type PutPullReplicationAsHubOperation struct {
+	definition *PullReplicationDefinition
+
+	Command *PutPullReplicationAsHubCommand
+}
+
+// NewPutPullReplicationAsHubOperation returns new PutPullReplicationAsHubOperation
+//
+// Deprecated: use operations.NewPutPullReplicationAsHubOperation.
+func NewPutPullReplicationAsHubOperation(definition *PullReplicationDefinition) (*PutPullReplicationAsHubOperation, error) {
+	if definition == nil {
+		return nil, newIllegalArgumentError("definition cannot be nil")
+	}
+	if err := definition.validate(); err != nil {
+		return nil, err
+	}
+	return &PutPullReplicationAsHubOperation{
+		definition: definition,
+	}, nil
+}
+
+func (o *PutPullReplicationAsHubOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewPutPullReplicationAsHubCommand(o.definition)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &PutPullReplicationAsHubCommand{}
+
+// PutPullReplicationAsHubCommand describes "put pull replication hub" command
+type PutPullReplicationAsHubCommand struct {
+	RavenCommandBase
+
+	definition *PullReplicationDefinition
+
+	Result *ModifyOngoingTaskResult
+}
+
+// NewPutPullReplicationAsHubCommand returns new PutPullReplicationAsHubCommand
+func NewPutPullReplicationAsHubCommand(definition *PullReplicationDefinition) *PutPullReplicationAsHubCommand {
+	return &PutPullReplicationAsHubCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		definition: definition,
+	}
+}
+
+func (c *PutPullReplicationAsHubCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/tasks/pull-replication/hub"
+
+	d, err := jsonMarshal(c.definition)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpPut(url, d)
+}
+
+func (c *PutPullReplicationAsHubCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -0,0 +1,82 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IVoidMaintenanceOperation = &PutSortersOperation{}
+
+// PutSortersOperation registers custom sorters in a database.
+// Sorters can be used in queries with DocumentQuery.OrderByWithSorter
+type PutSortersOperation struct {
+	sortersToAdd []*SorterDefinition
+
+	Command *PutSortersCommand
+}
+
+// NewPutSortersOperation returns new PutSortersOperation
+//
+// Deprecated: use operations.NewPutSortersOperation.
+func NewPutSortersOperation(sortersToAdd ...*SorterDefinition) (*PutSortersOperation, error) {
+	if len(sortersToAdd) == 0 {
+		return nil, newIllegalArgumentError("sortersToAdd cannot be empty")
+	}
+	for _, sorter := range sortersToAdd {
+		if sorter == nil {
+			return nil, newIllegalArgumentError("sorter cannot be nil")
+		}
+		if sorter.Name == "" {
+			return nil, newIllegalArgumentError("Sorter name cannot be empty")
+		}
+	}
+	return &PutSortersOperation{
+		sortersToAdd: sortersToAdd,
+	}, nil
+}
+
+// GetCommand returns a command for this operation
+func (o *PutSortersOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewPutSortersCommand(o.sortersToAdd)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var (
+	_ RavenCommand = &PutSortersCommand{}
+)
+
+// PutSortersCommand represents a command for registering custom sorters
+type PutSortersCommand struct {
+	RavenCommandBase
+
+	sorters []byte
+}
+
+// NewPutSortersCommand returns new PutSortersCommand
+func NewPutSortersCommand(sortersToAdd []*SorterDefinition) (*PutSortersCommand, error) {
+	if len(sortersToAdd) == 0 {
+		return nil, newIllegalArgumentError("sortersToAdd cannot be empty")
+	}
+	d, err := jsonMarshal(map[string]interface{}{
+		"Sorters": sortersToAdd,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmd := &PutSortersCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		sorters: d,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+// CreateRequest creates http request for the command
+func (c *PutSortersCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/sorters"
+	return newHttpPut(url, c.sorters)
+}
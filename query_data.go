@@ -6,6 +6,9 @@ type QueryData struct {
 	Fields []string
 	// Projections lists fields in the result entity
 	Projections []string
+	// ProjectionBehavior controls whether values are taken from index or document.
+	// Empty means server default
+	ProjectionBehavior ProjectionBehavior
 
 	// TODO: should those be exposed as well?
 	fromAlias        string
@@ -0,0 +1,107 @@
+package ravendb
+
+import "time"
+
+// QueryIncludeBuilder describes data to include in query results in addition
+// to the results themselves. Included data is stored in the session and
+// can be accessed later without additional requests to the server
+type QueryIncludeBuilder struct {
+	documentsToInclude             []string
+	countersToInclude              []string
+	includeAllCounters             bool
+	timeSeriesToInclude            []*timeSeriesRangeInclude
+	compareExchangeValuesToInclude []string
+	revisionsToInclude             []string
+}
+
+type timeSeriesRangeInclude struct {
+	name string
+	from *time.Time
+	to   *time.Time
+}
+
+// IncludeDocuments includes documents whose ids are at a given path of the results
+func (b *QueryIncludeBuilder) IncludeDocuments(path string) *QueryIncludeBuilder {
+	b.documentsToInclude = append(b.documentsToInclude, path)
+	return b
+}
+
+// IncludeCounter includes a counter of the results
+func (b *QueryIncludeBuilder) IncludeCounter(name string) *QueryIncludeBuilder {
+	return b.IncludeCounters(name)
+}
+
+// IncludeCounters includes counters of the results
+func (b *QueryIncludeBuilder) IncludeCounters(names ...string) *QueryIncludeBuilder {
+	b.countersToInclude = append(b.countersToInclude, names...)
+	return b
+}
+
+// IncludeAllCounters includes all counters of the results
+func (b *QueryIncludeBuilder) IncludeAllCounters() *QueryIncludeBuilder {
+	b.includeAllCounters = true
+	return b
+}
+
+// IncludeTimeSeries includes all entries of a time series of the results
+func (b *QueryIncludeBuilder) IncludeTimeSeries(name string) *QueryIncludeBuilder {
+	b.timeSeriesToInclude = append(b.timeSeriesToInclude, &timeSeriesRangeInclude{name: name})
+	return b
+}
+
+// IncludeTimeSeriesRange includes entries of a time series of the results
+// between from and to
+func (b *QueryIncludeBuilder) IncludeTimeSeriesRange(name string, from time.Time, to time.Time) *QueryIncludeBuilder {
+	b.timeSeriesToInclude = append(b.timeSeriesToInclude, &timeSeriesRangeInclude{
+		name: name,
+		from: &from,
+		to:   &to,
+	})
+	return b
+}
+
+// IncludeCompareExchangeValue includes compare exchange values whose keys
+// are at a given path of the results
+func (b *QueryIncludeBuilder) IncludeCompareExchangeValue(path string) *QueryIncludeBuilder {
+	b.compareExchangeValuesToInclude = append(b.compareExchangeValuesToInclude, path)
+	return b
+}
+
+// IncludeRevisions includes revisions whose change vectors are at a given
+// path of the results
+func (b *QueryIncludeBuilder) IncludeRevisions(changeVectorPath string) *QueryIncludeBuilder {
+	b.revisionsToInclude = append(b.revisionsToInclude, changeVectorPath)
+	return b
+}
+
+func (b *QueryIncludeBuilder) validate() error {
+	if b.includeAllCounters && len(b.countersToInclude) > 0 {
+		return newIllegalStateError("You cannot use IncludeAllCounters after using IncludeCounter or IncludeCounters")
+	}
+	for _, name := range b.countersToInclude {
+		if stringIsBlank(name) {
+			return newIllegalArgumentError("Counter name cannot be empty")
+		}
+	}
+	for _, ts := range b.timeSeriesToInclude {
+		if stringIsBlank(ts.name) {
+			return newIllegalArgumentError("Time series name cannot be empty")
+		}
+	}
+	for _, path := range b.documentsToInclude {
+		if stringIsBlank(path) {
+			return newIllegalArgumentError("Include path cannot be empty")
+		}
+	}
+	for _, path := range b.compareExchangeValuesToInclude {
+		if stringIsBlank(path) {
+			return newIllegalArgumentError("Compare exchange include path cannot be empty")
+		}
+	}
+	for _, path := range b.revisionsToInclude {
+		if stringIsBlank(path) {
+			return newIllegalArgumentError("Revisions include path cannot be empty")
+		}
+	}
+	return nil
+}
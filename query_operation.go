@@ -118,6 +118,7 @@ func (o *queryOperation) complete(results interface{}) error {
 
 	if !o.disableEntitiesTracking {
 		o.session.registerIncludes(queryResult.Includes)
+		o.session.registerQueryIncludes(queryResult)
 	}
 
 	slice, err := makeSliceForResults(results)
@@ -204,7 +205,8 @@ func queryOperationDeserialize(result interface{}, id string, document map[strin
 			return nil
 		}
 
-		if fieldsToFetch.fieldsToFetch != nil && fieldsToFetch.fieldsToFetch[0] == fieldsToFetch.projections[0] {
+		isTimeSeries := fieldsToFetch.projections[0] == timeSeriesQueryFunction
+		if isTimeSeries || (fieldsToFetch.fieldsToFetch != nil && fieldsToFetch.fieldsToFetch[0] == fieldsToFetch.projections[0]) {
 			doc, ok := inner.(map[string]interface{})
 			if ok {
 				// extraction from original type
@@ -3,6 +3,13 @@ package ravendb
 // QueryResults represents results of a query
 type QueryResult struct {
 	GenericQueryResult
+
+	// data requested with DocumentQuery.IncludeWithBuilder
+	CounterIncludes              map[string][]*CounterDetail                    `json:"CounterIncludes"`
+	IncludedCounterNames         map[string][]string                            `json:"IncludedCounterNames"`
+	TimeSeriesIncludes           map[string]map[string][]*TimeSeriesRangeResult `json:"TimeSeriesIncludes"`
+	CompareExchangeValueIncludes map[string]map[string]interface{}              `json:"CompareExchangeValueIncludes"`
+	RevisionIncludes             []map[string]interface{}                       `json:"RevisionIncludes"`
 }
 
 func (r *QueryResult) createSnapshot() *QueryResult {
@@ -8,6 +8,7 @@ type QueryStatistics struct {
 	IsStale           bool
 	DurationInMs      int64
 	TotalResults      int
+	LongTotalResults  int64
 	SkippedResults    int
 	Timestamp         time.Time
 	IndexName         string
@@ -29,6 +30,11 @@ func (s *QueryStatistics) UpdateQueryStats(qr *QueryResult) {
 	s.IsStale = qr.IsStale
 	s.DurationInMs = qr.DurationInMs
 	s.TotalResults = qr.TotalResults
+	s.LongTotalResults = qr.LongTotalResults
+	// older servers only send TotalResults
+	if s.LongTotalResults == 0 {
+		s.LongTotalResults = int64(qr.TotalResults)
+	}
 	s.SkippedResults = qr.SkippedResults
 	s.Timestamp = qr.IndexTimestamp.toTime()
 	s.IndexName = qr.IndexName
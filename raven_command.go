@@ -32,6 +32,9 @@ type RavenCommandBase struct {
 	// if true, can be cached
 	IsReadRequest bool
 
+	// if set, the command is sent only to the node with this cluster tag
+	SelectedNodeTag string
+
 	FailedNodes map[*ServerNode]error
 }
 
@@ -110,6 +113,10 @@ func ravenCommand_processResponse(cmd RavenCommand, cache *httpCache, response *
 		return cmdGet.processResponse(cache, response, url)
 	}
 
+	if cmdGet, ok := cmd.(*GetAttachmentsCommand); ok {
+		return cmdGet.processResponse(cache, response, url)
+	}
+
 	if cmdQuery, ok := cmd.(*QueryStreamCommand); ok {
 		return cmdQuery.processResponse(cache, response, url)
 	}
@@ -189,6 +196,10 @@ func getCommandOperationIDResult(cmd RavenCommand) *OperationIDResult {
 		return c.Result
 	case *DeleteByIndexCommand:
 		return c.Result
+	case *RevertRevisionsCommand:
+		return c.Result
+	case *StartBackupCommand:
+		return &OperationIDResult{OperationID: c.Result.OperationID, OperationNodeTag: c.Result.ResponsibleNode}
 	}
 
 	panicIf(true, "called on a command %T that doesn't return OperationIDResult", cmd)
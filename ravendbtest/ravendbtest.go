@@ -0,0 +1,113 @@
+/*
+Package ravendbtest provides helpers for writing integration tests
+against a RavenDB server.
+
+	store, err := server.GetDocumentStore("Test")
+	...
+	session.SaveChanges()
+	err = ravendbtest.WaitForIndexing(store, "", 0)
+*/
+package ravendbtest
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+)
+
+const (
+	defaultWaitForIndexingTimeout = time.Minute
+
+	// WaitForUserToContinueTheTest stops waiting once this document exists
+	debugDoneDocumentID = "Debug/Done"
+)
+
+// WaitForIndexing waits until no index in database is stale.
+// If database is empty, store's default database is used.
+// If timeout is 0, it defaults to 1 minute.
+// Disabled indexes are ignored. Returns IndexInvalidError if an index
+// is in error state and TimeoutError describing index errors if indexes
+// are still stale after timeout.
+func WaitForIndexing(store *ravendb.DocumentStore, database string, timeout time.Duration) error {
+	if timeout == 0 {
+		timeout = defaultWaitForIndexingTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := store.Maintenance().WaitForIndexing(ctx, database)
+	if _, ok := err.(*ravendb.TimeoutError); !ok {
+		return err
+	}
+
+	op := ravendb.NewGetIndexErrorsOperation(nil)
+	if err := store.Maintenance().ForDatabase(database).Send(op); err != nil {
+		return err
+	}
+	return ravendb.NewTimeoutError("The indexes stayed stale for more than %s.%s", timeout, formatIndexErrors(op.Command.Result))
+}
+
+func formatIndexErrors(allErrors []*ravendb.IndexErrors) string {
+	var sb strings.Builder
+	for _, indexErrors := range allErrors {
+		if indexErrors == nil || len(indexErrors.Errors) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\nIndex %s (%d errors):", indexErrors.Name, len(indexErrors.Errors))
+		for _, e := range indexErrors.Errors {
+			sb.WriteString("\n-" + e.String())
+		}
+	}
+	return sb.String()
+}
+
+// StudioDocumentsURL returns url of the documents page of RavenDB Studio
+// for store's default database
+func StudioDocumentsURL(store *ravendb.DocumentStore) string {
+	return store.GetUrls()[0] + "/studio/index.html#databases/documents?&database=" + url.QueryEscape(store.GetDatabase()) + "&withStop=true"
+}
+
+// WaitForUserToContinueTheTest opens RavenDB Studio in a browser and blocks
+// until a document with id "Debug/Done" is created in store's default database.
+// It's meant for inspecting the state of the database while debugging a test.
+func WaitForUserToContinueTheTest(store *ravendb.DocumentStore) error {
+	uri := StudioDocumentsURL(store)
+	fmt.Printf("Waiting for document %s to be created. Studio: %s\n", debugDoneDocumentID, uri)
+	_ = openBrowser(uri)
+
+	for {
+		time.Sleep(500 * time.Millisecond)
+		session, err := store.OpenSession("")
+		if err != nil {
+			return err
+		}
+		var doc map[string]interface{}
+		err = session.Load(&doc, debugDoneDocumentID)
+		session.Close()
+		if err != nil {
+			return err
+		}
+		if doc != nil {
+			return nil
+		}
+	}
+}
+
+func openBrowser(uri string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", uri)
+	case "darwin":
+		cmd = exec.Command("open", uri)
+	default:
+		cmd = exec.Command("xdg-open", uri)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,24 @@
+package ravendbtest
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatIndexErrors(t *testing.T) {
+	assert.Equal(t, "", formatIndexErrors(nil))
+
+	allErrors := []*ravendb.IndexErrors{
+		{Name: "Users/ByName"},
+		{
+			Name: "Orders/Totals",
+			Errors: []*ravendb.IndexingError{
+				{Error: "boom", Document: "orders/1", Action: "Map"},
+			},
+		},
+	}
+	exp := "\nIndex Orders/Totals (1 errors):\n-Error: boom, Document: orders/1, Action: Map"
+	assert.Equal(t, exp, formatIndexErrors(allErrors))
+}
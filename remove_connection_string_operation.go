@@ -0,0 +1,79 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &RemoveConnectionStringOperation{}
+)
+
+// RemoveConnectionStringOperation removes a connection string from a database
+type RemoveConnectionStringOperation struct {
+	connectionStringName string
+	typ                  ConnectionStringType
+
+	Command *RemoveConnectionStringCommand
+}
+
+// NewRemoveConnectionStringOperation returns new RemoveConnectionStringOperation
+//
+// Deprecated: use operations.NewRemoveConnectionStringOperation.
+func NewRemoveConnectionStringOperation(connectionStringName string, typ ConnectionStringType) (*RemoveConnectionStringOperation, error) {
+	if connectionStringName == "" {
+		return nil, newIllegalArgumentError("connectionStringName cannot be empty")
+	}
+	if typ == "" || typ == ConnectionStringTypeNone {
+		return nil, newIllegalArgumentError("type must be specified")
+	}
+	return &RemoveConnectionStringOperation{
+		connectionStringName: connectionStringName,
+		typ:                  typ,
+	}, nil
+}
+
+func (o *RemoveConnectionStringOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewRemoveConnectionStringCommand(o.connectionStringName, o.typ)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &RemoveConnectionStringCommand{}
+
+// RemoveConnectionStringCommand describes "remove connection string" command
+type RemoveConnectionStringCommand struct {
+	RavenCommandBase
+
+	connectionStringName string
+	typ                  ConnectionStringType
+
+	Result *RemoveConnectionStringResult
+}
+
+// NewRemoveConnectionStringCommand returns new RemoveConnectionStringCommand
+func NewRemoveConnectionStringCommand(connectionStringName string, typ ConnectionStringType) *RemoveConnectionStringCommand {
+	return &RemoveConnectionStringCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		connectionStringName: connectionStringName,
+		typ:                  typ,
+	}
+}
+
+func (c *RemoveConnectionStringCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/connection-strings?connectionString=" + urlUtilsEscapeDataString(c.connectionStringName) + "&type=" + c.typ
+
+	return newHttpDelete(url, nil)
+}
+
+func (c *RemoveConnectionStringCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
+
+// RemoveConnectionStringResult describes result of RemoveConnectionStringOperation
+type RemoveConnectionStringResult struct {
+	RaftCommandIndex int64 `json:"RaftCommandIndex"`
+}
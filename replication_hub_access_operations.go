@@ -0,0 +1,217 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var (
+	_ IMaintenanceOperation = &RegisterReplicationHubAccessOperation{}
+	_ IMaintenanceOperation = &UnregisterReplicationHubAccessOperation{}
+	_ IMaintenanceOperation = &GetReplicationHubAccessOperation{}
+)
+
+// RegisterReplicationHubAccessOperation allows sinks authenticating with
+// a certificate to connect to a pull replication hub with a given name
+type RegisterReplicationHubAccessOperation struct {
+	hubName string
+	access  *ReplicationHubAccess
+
+	Command *RegisterReplicationHubAccessCommand
+}
+
+// NewRegisterReplicationHubAccessOperation returns new RegisterReplicationHubAccessOperation
+//
+// Deprecated: use operations.NewRegisterReplicationHubAccessOperation.
+func NewRegisterReplicationHubAccessOperation(hubName string, access *ReplicationHubAccess) (*RegisterReplicationHubAccessOperation, error) {
+	if hubName == "" {
+		return nil, newIllegalArgumentError("hubName cannot be empty")
+	}
+	if access == nil {
+		return nil, newIllegalArgumentError("access cannot be nil")
+	}
+	if err := access.validate(); err != nil {
+		return nil, err
+	}
+	return &RegisterReplicationHubAccessOperation{
+		hubName: hubName,
+		access:  access,
+	}, nil
+}
+
+func (o *RegisterReplicationHubAccessOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewRegisterReplicationHubAccessCommand(o.hubName, o.access)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &RegisterReplicationHubAccessCommand{}
+
+// RegisterReplicationHubAccessCommand describes "register replication hub access" command
+type RegisterReplicationHubAccessCommand struct {
+	RavenCommandBase
+
+	hubName string
+	access  *ReplicationHubAccess
+}
+
+// NewRegisterReplicationHubAccessCommand returns new RegisterReplicationHubAccessCommand
+func NewRegisterReplicationHubAccessCommand(hubName string, access *ReplicationHubAccess) *RegisterReplicationHubAccessCommand {
+	cmd := &RegisterReplicationHubAccessCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		hubName: hubName,
+		access:  access,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd
+}
+
+func (c *RegisterReplicationHubAccessCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/tasks/pull-replication/hub/access?name=" + urlUtilsEscapeDataString(c.hubName)
+
+	d, err := jsonMarshal(c.access)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpPut(url, d)
+}
+
+// UnregisterReplicationHubAccessOperation removes access of a certificate
+// with a given thumbprint to a pull replication hub
+type UnregisterReplicationHubAccessOperation struct {
+	hubName    string
+	thumbprint string
+
+	Command *UnregisterReplicationHubAccessCommand
+}
+
+// NewUnregisterReplicationHubAccessOperation returns new UnregisterReplicationHubAccessOperation
+//
+// Deprecated: use operations.NewUnregisterReplicationHubAccessOperation.
+func NewUnregisterReplicationHubAccessOperation(hubName string, thumbprint string) (*UnregisterReplicationHubAccessOperation, error) {
+	if hubName == "" {
+		return nil, newIllegalArgumentError("hubName cannot be empty")
+	}
+	if thumbprint == "" {
+		return nil, newIllegalArgumentError("thumbprint cannot be empty")
+	}
+	return &UnregisterReplicationHubAccessOperation{
+		hubName:    hubName,
+		thumbprint: thumbprint,
+	}, nil
+}
+
+func (o *UnregisterReplicationHubAccessOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewUnregisterReplicationHubAccessCommand(o.hubName, o.thumbprint)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &UnregisterReplicationHubAccessCommand{}
+
+// UnregisterReplicationHubAccessCommand describes "unregister replication hub access" command
+type UnregisterReplicationHubAccessCommand struct {
+	RavenCommandBase
+
+	hubName    string
+	thumbprint string
+}
+
+// NewUnregisterReplicationHubAccessCommand returns new UnregisterReplicationHubAccessCommand
+func NewUnregisterReplicationHubAccessCommand(hubName string, thumbprint string) *UnregisterReplicationHubAccessCommand {
+	cmd := &UnregisterReplicationHubAccessCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		hubName:    hubName,
+		thumbprint: thumbprint,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd
+}
+
+func (c *UnregisterReplicationHubAccessCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/tasks/pull-replication/hub/access?name=" + urlUtilsEscapeDataString(c.hubName) + "&thumbprint=" + urlUtilsEscapeDataString(c.thumbprint)
+
+	return newHttpDelete(url, nil)
+}
+
+// GetReplicationHubAccessOperation returns accesses registered on
+// a pull replication hub
+type GetReplicationHubAccessOperation struct {
+	hubName  string
+	start    int
+	pageSize int
+
+	Command *GetReplicationHubAccessCommand
+}
+
+// NewGetReplicationHubAccessOperation returns new GetReplicationHubAccessOperation.
+// pageSize of 0 means server's default
+//
+// Deprecated: use operations.NewGetReplicationHubAccessOperation.
+func NewGetReplicationHubAccessOperation(hubName string, start int, pageSize int) (*GetReplicationHubAccessOperation, error) {
+	if hubName == "" {
+		return nil, newIllegalArgumentError("hubName cannot be empty")
+	}
+	if start < 0 || pageSize < 0 {
+		return nil, newIllegalArgumentError("start and pageSize cannot be negative")
+	}
+	return &GetReplicationHubAccessOperation{
+		hubName:  hubName,
+		start:    start,
+		pageSize: pageSize,
+	}, nil
+}
+
+func (o *GetReplicationHubAccessOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetReplicationHubAccessCommand(o.hubName, o.start, o.pageSize)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetReplicationHubAccessCommand{}
+
+// GetReplicationHubAccessCommand describes "get replication hub access" command
+type GetReplicationHubAccessCommand struct {
+	RavenCommandBase
+
+	hubName  string
+	start    int
+	pageSize int
+
+	Result []*DetailedReplicationHubAccess
+}
+
+// NewGetReplicationHubAccessCommand returns new GetReplicationHubAccessCommand
+func NewGetReplicationHubAccessCommand(hubName string, start int, pageSize int) *GetReplicationHubAccessCommand {
+	cmd := &GetReplicationHubAccessCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		hubName:  hubName,
+		start:    start,
+		pageSize: pageSize,
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetReplicationHubAccessCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/tasks/pull-replication/hub/access?name=" + urlUtilsEscapeDataString(c.hubName) + "&start=" + strconv.Itoa(c.start)
+	if c.pageSize > 0 {
+		url += "&pageSize=" + strconv.Itoa(c.pageSize)
+	}
+	return newHttpGet(url)
+}
+
+func (c *GetReplicationHubAccessCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	var res struct {
+		Results []*DetailedReplicationHubAccess `json:"Results"`
+	}
+	if err := jsonUnmarshal(response, &res); err != nil {
+		return err
+	}
+	c.Result = res.Results
+	return nil
+}
@@ -0,0 +1,45 @@
+package ravendb
+
+// ReplicationPerformance describes recent replication batches of a database
+type ReplicationPerformance struct {
+	Incoming []*IncomingReplicationPerformanceStats `json:"Incoming"`
+	Outgoing []*OutgoingReplicationPerformanceStats `json:"Outgoing"`
+}
+
+// IncomingReplicationPerformanceStats describes batches received from a source
+type IncomingReplicationPerformanceStats struct {
+	Source      string                           `json:"Source"`
+	Performance []*IncomingReplicationBatchStats `json:"Performance"`
+}
+
+// OutgoingReplicationPerformanceStats describes batches sent to a destination
+type OutgoingReplicationPerformanceStats struct {
+	Destination string                           `json:"Destination"`
+	Performance []*OutgoingReplicationBatchStats `json:"Performance"`
+}
+
+// IncomingReplicationBatchStats describes a single received batch
+type IncomingReplicationBatchStats struct {
+	ID               int                            `json:"Id"`
+	Started          Time                           `json:"Started"`
+	Completed        *Time                          `json:"Completed"`
+	DurationInMs     float64                        `json:"DurationInMs"`
+	ReceivedLastEtag int64                          `json:"ReceivedLastEtag"`
+	Errors           []*ReplicationPerformanceError `json:"Errors"`
+}
+
+// OutgoingReplicationBatchStats describes a single sent batch
+type OutgoingReplicationBatchStats struct {
+	ID           int                            `json:"Id"`
+	Started      Time                           `json:"Started"`
+	Completed    *Time                          `json:"Completed"`
+	DurationInMs float64                        `json:"DurationInMs"`
+	SendLastEtag int64                          `json:"SendLastEtag"`
+	Errors       []*ReplicationPerformanceError `json:"Errors"`
+}
+
+// ReplicationPerformanceError describes an error that happened during a batch
+type ReplicationPerformanceError struct {
+	Timestamp Time   `json:"Timestamp"`
+	Error     string `json:"Error"`
+}
@@ -1,13 +1,16 @@
 package ravendb
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
-	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	"net/http"
+	urlpkg "net/url"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,6 +33,10 @@ var (
 
 const (
 	goClientVersion = "4.0.0"
+
+	// the shortest delay before retrying a request to a busy server,
+	// used when the server doesn't send Retry-After or asks for less
+	minServerBusyRetryDelay = 500 * time.Millisecond
 )
 
 func redbg(format string, args ...interface{}) {
@@ -64,7 +71,7 @@ type RequestExecutor struct {
 	nodeSelector        atomic.Value // atomic to avoid data races
 
 	NumberOfServerRequests  atomicInteger
-	TopologyEtag            int64
+	TopologyEtag            int64 // atomic
 	ClientConfigurationEtag int64
 	conventions             *DocumentConventions
 
@@ -73,7 +80,9 @@ type RequestExecutor struct {
 
 	firstTopologyUpdateFuture *completableFuture
 
-	readBalanceBehavior ReadBalanceBehavior
+	// updated from client configuration sent by the server
+	readBalanceBehavior atomic.Value // ReadBalanceBehavior
+	loadBalanceBehavior atomic.Value // LoadBalanceBehavior
 	// TODO: mulit-threaded access, protect
 	Cache                 *httpCache
 	httpClient            *http.Client
@@ -84,6 +93,9 @@ type RequestExecutor struct {
 	mu sync.Mutex
 
 	disposed int32 // atomic
+	// topology updates run with closeCtx so that Close can abandon them
+	closeCtx    context.Context
+	cancelClose context.CancelFunc
 
 	// those are needed to implement ClusterRequestExecutor logic
 	isCluster                bool
@@ -119,6 +131,8 @@ func (re *RequestExecutor) isDisposed() bool {
 	return v > 0
 }
 
+// GetTopology returns the current topology of the database, nil if it
+// wasn't fetched yet
 func (re *RequestExecutor) GetTopology() *Topology {
 	nodeSelector := re.getNodeSelector()
 	if nodeSelector != nil {
@@ -166,15 +180,17 @@ func NewRequestExecutor(databaseName string, certificate *tls.Certificate, trust
 		updateDatabaseTopologySemaphore:    NewSemaphore(1),
 		updateClientConfigurationSemaphore: NewSemaphore(1),
 
-		Cache:               newHttpCache(conventions.getMaxHttpCacheSize()),
-		readBalanceBehavior: conventions.ReadBalanceBehavior,
-		databaseName:        databaseName,
-		Certificate:         certificate,
-		TrustStore:          trustStore,
+		Cache:        newHttpCache(conventions.MaxHttpCacheSize),
+		databaseName: databaseName,
+		Certificate:  certificate,
+		TrustStore:   trustStore,
 
 		conventions: conventions.Clone(),
 	}
+	res.closeCtx, res.cancelClose = context.WithCancel(context.Background())
 	res.lastReturnedResponse.Store(time.Now())
+	res.readBalanceBehavior.Store(conventions.ReadBalanceBehavior)
+	res.loadBalanceBehavior.Store(conventions.LoadBalanceBehavior)
 	res.setNodeSelector(nil)
 	// TODO: handle an error
 	// TODO: java globally caches http clients
@@ -219,7 +235,7 @@ func RequestExecutorCreateForSingleNodeWithConfigurationUpdates(url string, data
 }
 
 func RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(url string, databaseName string, certificate *tls.Certificate, trustStore *x509.Certificate, conventions *DocumentConventions) *RequestExecutor {
-	initialUrls := requestExecutorValidateUrls([]string{url}, certificate)
+	initialUrls := []string{url}
 	executor := NewRequestExecutor(databaseName, certificate, trustStore, conventions, initialUrls)
 
 	topology := &Topology{
@@ -243,7 +259,6 @@ func RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(url string, d
 func ClusterRequestExecutorCreateForSingleNode(url string, certificate *tls.Certificate, trustStore *x509.Certificate, conventions *DocumentConventions) *RequestExecutor {
 
 	initialUrls := []string{url}
-	url = requestExecutorValidateUrls(initialUrls, certificate)[0]
 
 	if conventions == nil {
 		conventions = getDefaultConventions()
@@ -343,6 +358,8 @@ func (re *RequestExecutor) updateClientConfigurationAsync() *completableFuture {
 
 		re.conventions.UpdateFrom(result.Configuration)
 		re.ClientConfigurationEtag = result.Etag
+		re.readBalanceBehavior.Store(re.conventions.ReadBalanceBehavior)
+		re.loadBalanceBehavior.Store(re.conventions.LoadBalanceBehavior)
 
 		if re.isDisposed() {
 			return
@@ -369,58 +386,6 @@ func dbgPrintTopology(t *Topology) {
 	}
 }
 
-// used by the request executer, so we are handling this internally
-type clusterTopologyCommand struct {
-	RavenCommandBase
-	Response struct {
-		Topology struct {
-			TopologyId string            `json:"TopologyId"`
-			AllNodes   map[string]string `json:"AllNodes"`
-			Members     map[string]string `json:"Members"`
-			Promotables map[string]string `json:"Promotables"`
-			Watchers    map[string]string `json:"Watchers"`
-			LastNodeId  string            `json:"LastNodeId"`
-			Etag        int               `json:"Etag"`
-		}
-		Etag               int    `json:"Etag"`
-		Leader             string `json:"Leader"`
-		LeaderShipDuration int    `json:"LeaderShipDuration"`
-		CurrentState       string `json:"CurrentState"`
-		NodeTag            string `json:"NodeTag"`
-		CurrentTerm        int    `json:"CurrentTerm"`
-		NodeLicenseDetails struct {
-			UtilizedCores       int         `json:"UtilizedCores"`
-			MaxUtilizedCores    interface{} `json:"MaxUtilizedCores"`
-			NumberOfCores       int         `json:"NumberOfCores"`
-			InstalledMemoryInGb float64     `json:"InstalledMemoryInGb"`
-			UsableMemoryInGb    float64     `json:"UsableMemoryInGb"`
-			BuildInfo           struct {
-				ProductVersion string `json:"ProductVersion"`
-				BuildVersion   int    `json:"BuildVersion"`
-				CommitHash     string `json:"CommitHash"`
-				FullVersion    string `json:"FullVersion"`
-			} `json:"BuildInfo"`
-			OsInfo struct {
-				Type         string `json:"Type"`
-				FullName     string `json:"FullName"`
-				Version      string `json:"Version"`
-				BuildVersion string `json:"BuildVersion"`
-				Is64Bit      bool   `json:"Is64Bit"`
-			} `json:"OsInfo"`
-		}
-		LastStateChangeReason string   `json:"LastStateChangeReason"`
-		Status                struct{} `json:"Status"`
-	}
-}
-
-func (c *clusterTopologyCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
-	url := node.URL + "/cluster/topology"
-	return http.NewRequest(http.MethodGet, url, nil)
-}
-func (c *clusterTopologyCommand) SetResponse(response []byte, fromCache bool) error {
-	return json.Unmarshal(response, &c.Response)
-}
-
 func (re *RequestExecutor) clusterUpdateTopologyAsyncWithForceUpdate(node *ServerNode, timeout int, forceUpdate bool) chan *clusterUpdateAsyncResult {
 	panicIf(!re.isCluster, "clusterUpdateTopologyAsyncWithForceUpdate() called on non-cluster RequestExecutor")
 
@@ -454,18 +419,17 @@ func (re *RequestExecutor) clusterUpdateTopologyAsyncWithForceUpdate(node *Serve
 			return
 		}
 
-		command := clusterTopologyCommand{
-			RavenCommandBase: RavenCommandBase{
-				ResponseType: RavenCommandResponseTypeObject,
-			},
+		command := NewGetClusterTopologyCommand()
+		err = re.ExecuteWithContext(re.closeCtx, node, -1, command, false, nil)
+		if err != nil || re.isDisposed() {
+			return
 		}
-		err = re.Execute(node, -1, &command, false, nil)
-		if err != nil {
+		if command.Result.Topology == nil {
+			err = newIllegalStateError("Response is invalid. Topology is missing.")
 			return
 		}
-		members := command.Response.Topology.Members
 		var nodes []*ServerNode
-		for key, value := range members {
+		for key, value := range command.Result.Topology.Members {
 			serverNode := NewServerNode()
 			serverNode.URL = value
 			serverNode.ClusterTag = key
@@ -480,13 +444,13 @@ func (re *RequestExecutor) clusterUpdateTopologyAsyncWithForceUpdate(node *Serve
 			nodeSelector = NewNodeSelector(newTopology)
 			re.setNodeSelector(nodeSelector)
 
-			if re.readBalanceBehavior == ReadBalanceBehaviorFastestNode {
+			if re.getReadBalanceBehavior() == ReadBalanceBehaviorFastestNode {
 				nodeSelector.scheduleSpeedTest()
 			}
 		} else if nodeSelector.onUpdateTopology(newTopology, forceUpdate) {
 			re.disposeAllFailedNodesTimers()
 
-			if re.readBalanceBehavior == ReadBalanceBehaviorFastestNode {
+			if re.getReadBalanceBehavior() == ReadBalanceBehaviorFastestNode {
 				nodeSelector.scheduleSpeedTest()
 			}
 		}
@@ -520,9 +484,13 @@ func (re *RequestExecutor) updateTopologyAsyncWithForceUpdate(node *ServerNode,
 		}
 		re.updateDatabaseTopologySemaphore.acquire()
 		defer re.updateDatabaseTopologySemaphore.release()
+		if re.isDisposed() {
+			return
+		}
 		command := NewGetDatabaseTopologyCommand()
-		err = re.Execute(node, 0, command, false, nil)
-		if err != nil {
+		// node might not be part of the topology, so no failover
+		err = re.ExecuteWithContext(re.closeCtx, node, -1, command, false, nil)
+		if err != nil || re.isDisposed() {
 			return
 		}
 		result := command.Result
@@ -531,16 +499,16 @@ func (re *RequestExecutor) updateTopologyAsyncWithForceUpdate(node *ServerNode,
 		if nodeSelector == nil {
 			nodeSelector = NewNodeSelector(result)
 			re.setNodeSelector(nodeSelector)
-			if re.readBalanceBehavior == ReadBalanceBehaviorFastestNode {
+			if re.getReadBalanceBehavior() == ReadBalanceBehaviorFastestNode {
 				nodeSelector.scheduleSpeedTest()
 			}
 		} else if nodeSelector.onUpdateTopology(result, forceUpdate) {
 			re.disposeAllFailedNodesTimers()
-			if re.readBalanceBehavior == ReadBalanceBehaviorFastestNode {
+			if re.getReadBalanceBehavior() == ReadBalanceBehaviorFastestNode {
 				nodeSelector.scheduleSpeedTest()
 			}
 		}
-		re.TopologyEtag = nodeSelector.getTopology().Etag
+		atomic.StoreInt64(&re.TopologyEtag, nodeSelector.getTopology().Etag)
 		res = true
 	}
 
@@ -555,16 +523,30 @@ func (re *RequestExecutor) disposeAllFailedNodesTimers() {
 		return true
 	}
 	re.failedNodesTimers.Range(f)
-	re.failedNodesTimers = sync.Map{}
+	// delete instead of re-assigning the map which would race with its users
+	re.failedNodesTimers.Range(func(key, _ interface{}) bool {
+		re.failedNodesTimers.Delete(key)
+		return true
+	})
 }
 
 // sessionInfo can be nil
 func (re *RequestExecutor) ExecuteCommand(command RavenCommand, sessionInfo *SessionInfo) error {
+	return re.ExecuteCommandWithContext(context.Background(), command, sessionInfo)
+}
+
+// ExecuteCommandWithContext is like ExecuteCommand but the request (including
+// retries and failover to other nodes) is abandoned when ctx is cancelled or
+// its deadline passes. In that case the returned error wraps ctx.Err()
+func (re *RequestExecutor) ExecuteCommandWithContext(ctx context.Context, command RavenCommand, sessionInfo *SessionInfo) error {
 	redbg("RequestExector.ExecuteCommand: %T\n", command)
 	if re.isDisposed() {
 		// can happen if e.g. we create BulkInsertOperation, close the store and then call Close() on BulkInsertOperation
 		return newIllegalStateError("RequestExecutor has been disposed")
 	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	topologyUpdate := re.firstTopologyUpdateFuture
 	isDone := topologyUpdate != nil && topologyUpdate.IsDone() && !topologyUpdate.IsCompletedExceptionally() && !topologyUpdate.isCancelled()
 	if isDone || re.disableTopologyUpdates {
@@ -572,35 +554,54 @@ func (re *RequestExecutor) ExecuteCommand(command RavenCommand, sessionInfo *Ses
 		if err != nil {
 			return err
 		}
-		return re.Execute(currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, true, sessionInfo)
+		return re.ExecuteWithContext(ctx, currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, true, sessionInfo)
 	} else {
-		return re.unlikelyExecute(command, topologyUpdate, sessionInfo)
+		return re.unlikelyExecute(ctx, command, topologyUpdate, sessionInfo)
 	}
 }
 
+func (re *RequestExecutor) getReadBalanceBehavior() ReadBalanceBehavior {
+	return re.readBalanceBehavior.Load().(ReadBalanceBehavior)
+}
+
+func (re *RequestExecutor) getLoadBalanceBehavior() LoadBalanceBehavior {
+	return re.loadBalanceBehavior.Load().(LoadBalanceBehavior)
+}
+
 func (re *RequestExecutor) chooseNodeForRequest(cmd RavenCommand, sessionInfo *SessionInfo) (*CurrentIndexAndNode, error) {
+	if nodeTag := cmd.GetBase().SelectedNodeTag; nodeTag != "" {
+		return re.getRequestedNode(nodeTag)
+	}
+
+	// sessions with a context stick to one node for both reads and writes
+	if re.getLoadBalanceBehavior() == LoadBalanceBehaviorUseSessionContext &&
+		sessionInfo != nil && sessionInfo.canUseLoadBalanceBehavior {
+		return re.getNodeBySessionID(sessionInfo.getSessionID())
+	}
+
 	if !cmd.GetBase().IsReadRequest {
 		return re.getPreferredNode()
 	}
 
-	switch re.readBalanceBehavior {
+	readBalanceBehavior := re.getReadBalanceBehavior()
+	switch readBalanceBehavior {
 	case ReadBalanceBehaviorNone:
 		return re.getPreferredNode()
 	case ReadBalanceBehaviorRoundRobin:
 		sessionID := 0
 		if sessionInfo != nil {
-			sessionID = sessionInfo.SessionID
+			sessionID = sessionInfo.getSessionID()
 		}
 		return re.getNodeBySessionID(sessionID)
 	case ReadBalanceBehaviorFastestNode:
 		return re.getFastestNode()
 	default:
-		panicIf(true, "Unknown re.ReadBalanceBehavior: '%s'", re.readBalanceBehavior)
+		panicIf(true, "Unknown re.ReadBalanceBehavior: '%s'", readBalanceBehavior)
 	}
 	return nil, nil
 }
 
-func (re *RequestExecutor) unlikelyExecuteInner(command RavenCommand, topologyUpdate *completableFuture, sessionInfo *SessionInfo) (*completableFuture, error) {
+func (re *RequestExecutor) unlikelyExecuteInner(ctx context.Context, command RavenCommand, topologyUpdate *completableFuture, sessionInfo *SessionInfo) (*completableFuture, error) {
 
 	if topologyUpdate == nil {
 		re.mu.Lock()
@@ -616,13 +617,17 @@ func (re *RequestExecutor) unlikelyExecuteInner(command RavenCommand, topologyUp
 		re.mu.Unlock()
 	}
 
-	_, err := topologyUpdate.Get()
+	_, err := topologyUpdate.GetWithContext(ctx)
 	return topologyUpdate, err
 }
 
-func (re *RequestExecutor) unlikelyExecute(command RavenCommand, topologyUpdate *completableFuture, sessionInfo *SessionInfo) error {
+func (re *RequestExecutor) unlikelyExecute(ctx context.Context, command RavenCommand, topologyUpdate *completableFuture, sessionInfo *SessionInfo) error {
 	var err error
-	topologyUpdate, err = re.unlikelyExecuteInner(command, topologyUpdate, sessionInfo)
+	topologyUpdate, err = re.unlikelyExecuteInner(ctx, command, topologyUpdate, sessionInfo)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		// the topology update itself is still running
+		return ctxErr
+	}
 	if err != nil {
 		re.mu.Lock()
 		if re.firstTopologyUpdateFuture == topologyUpdate {
@@ -636,14 +641,17 @@ func (re *RequestExecutor) unlikelyExecute(command RavenCommand, topologyUpdate
 	if err != nil {
 		return err
 	}
-	err = re.Execute(currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, true, sessionInfo)
+	err = re.ExecuteWithContext(ctx, currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, true, sessionInfo)
 	return err
 }
 
+// updateTopologyCallback refreshes the topology if we haven't talked to
+// the server for a while. Otherwise the server would have told us about
+// topology changes with Refresh-Topology header
 func (re *RequestExecutor) updateTopologyCallback() {
 	last := re.lastReturnedResponse.Load().(time.Time)
 	dur := time.Since(last)
-	if dur < time.Minute {
+	if dur < topologyUpdatePeriod {
 		return
 	}
 
@@ -668,9 +676,13 @@ type tupleStringError struct {
 }
 
 func (re *RequestExecutor) firstTopologyUpdate(inputUrls []string) *completableFuture {
-	initialUrls := requestExecutorValidateUrls(inputUrls, re.Certificate)
-
 	future := newCompletableFuture()
+	if err := requestExecutorValidateUrls(inputUrls, re.Certificate); err != nil {
+		future.completeWithError(err)
+		return future
+	}
+	initialUrls := inputUrls
+
 	var list []*tupleStringError
 	f := func() {
 		var err error
@@ -713,7 +725,7 @@ func (re *RequestExecutor) firstTopologyUpdate(inputUrls []string) *completableF
 			list = append(list, &tupleStringError{url, err})
 		}
 		topology := &Topology{
-			Etag: re.TopologyEtag,
+			Etag: atomic.LoadInt64(&re.TopologyEtag),
 		}
 		topologyNodes := re.GetTopologyNodes()
 		if len(topologyNodes) == 0 {
@@ -752,11 +764,49 @@ func (re *RequestExecutor) throwError(details string) error {
 	return err
 }
 
-func requestExecutorValidateUrls(initialUrls []string, certificate *tls.Certificate) []string {
-	// TODO: implement me
-	return initialUrls
+// requestExecutorValidateUrls checks that urls are valid and that all of them
+// use the same scheme. https is required when a certificate is used
+func requestExecutorValidateUrls(initialUrls []string, certificate *tls.Certificate) error {
+	var httpURL, httpsURL string
+	for _, uri := range initialUrls {
+		parsed, err := urlpkg.Parse(uri)
+		if err != nil || parsed.Host == "" {
+			return newIllegalArgumentError("The url '%s' is not valid", uri)
+		}
+		switch parsed.Scheme {
+		case "http":
+			httpURL = uri
+		case "https":
+			httpsURL = uri
+		default:
+			return newIllegalArgumentError("The url '%s' must use http or https scheme", uri)
+		}
+	}
+	if httpURL == "" {
+		return nil
+	}
+	if certificate != nil {
+		return newIllegalArgumentError("The url %s is using HTTP, but a certificate is specified, which require us to use HTTPS", httpURL)
+	}
+	if httpsURL != "" {
+		return newIllegalArgumentError("The url %s is using HTTP, but other urls are using HTTPS, and mixing of HTTP and HTTPS is not allowed", httpURL)
+	}
+	return nil
+}
+
+func newForbiddenAccessError(node *ServerNode, certificate *tls.Certificate, request string) *AuthorizationError {
+	msg := "Forbidden access to " + node.Database + "@" + node.URL + ", "
+	if certificate == nil {
+		msg += "a certificate is required. "
+	} else {
+		msg += "certificate does not have permission to access it or is unknown. "
+	}
+	return newAuthorizationError(msg + request)
 }
 
+// how often we check if the topology needs to be refreshed
+var topologyUpdatePeriod = time.Minute
+
 func (re *RequestExecutor) initializeUpdateTopologyTimer() {
 	re.mu.Lock()
 	defer re.mu.Unlock()
@@ -764,8 +814,13 @@ func (re *RequestExecutor) initializeUpdateTopologyTimer() {
 	if re.updateTopologyTimer != nil {
 		return
 	}
-	// TODO: make it into an infinite goroutine instead
+	if re.isDisposed() {
+		return
+	}
 	f := func() {
+		if re.isDisposed() {
+			return
+		}
 		re.updateTopologyCallback()
 		// Go doesn't have repeatable timer, so re-trigger ourselves
 		re.mu.Lock()
@@ -773,7 +828,7 @@ func (re *RequestExecutor) initializeUpdateTopologyTimer() {
 		re.mu.Unlock()
 		re.initializeUpdateTopologyTimer()
 	}
-	re.updateTopologyTimer = time.AfterFunc(time.Minute, f)
+	re.updateTopologyTimer = time.AfterFunc(topologyUpdatePeriod, f)
 }
 
 func isNetworkTimeoutError(err error) bool {
@@ -785,23 +840,34 @@ func isNetworkTimeoutError(err error) bool {
 // Execute executes a command on a given node
 // If nodeIndex is -1, we don't know the index
 func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, command RavenCommand, shouldRetry bool, sessionInfo *SessionInfo) error {
+	return re.ExecuteWithContext(context.Background(), chosenNode, nodeIndex, command, shouldRetry, sessionInfo)
+}
+
+// ExecuteWithContext is like Execute but sends the request with ctx
+func (re *RequestExecutor) ExecuteWithContext(ctx context.Context, chosenNode *ServerNode, nodeIndex int, command RavenCommand, shouldRetry bool, sessionInfo *SessionInfo) error {
 	// nodeIndex -1 is equivalent to Java's null
 	request, err := re.createRequest(chosenNode, command)
 	if err != nil {
 		return err
 	}
+	request = request.WithContext(ctx)
 	urlRef := request.URL.String()
 
-	cachedItem, cachedChangeVector, cachedValue := re.getFromCache(command, urlRef)
+	noCaching := sessionInfo != nil && sessionInfo.noCaching
+	cachedItem, cachedChangeVector, cachedValue := re.getFromCache(command, noCaching, urlRef)
 	defer cachedItem.close()
 
 	if cachedChangeVector != nil {
 		aggressiveCacheOptions := re.aggressiveCaching
+		if sessionInfo != nil && sessionInfo.aggressiveCaching != nil {
+			aggressiveCacheOptions = sessionInfo.aggressiveCaching
+		}
 		if aggressiveCacheOptions != nil {
 			expired := cachedItem.getAge() > aggressiveCacheOptions.Duration
 			if !expired &&
 				!cachedItem.getMightHaveBeenModified() &&
 				command.GetBase().CanCacheAggressively {
+				re.Cache.recordHit()
 				return command.SetResponse(cachedValue, true)
 			}
 		}
@@ -815,7 +881,7 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 	}
 
 	if !re.disableTopologyUpdates {
-		etag := `"` + i64toa(re.TopologyEtag) + `"`
+		etag := `"` + i64toa(atomic.LoadInt64(&re.TopologyEtag)) + `"`
 		request.Header.Set(headersTopologyEtag, etag)
 	}
 
@@ -823,12 +889,16 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 	var response *http.Response
 	re.NumberOfServerRequests.incrementAndGet()
 	if re.shouldExecuteOnAll(chosenNode, command) {
-		response, err = re.executeOnAllToFigureOutTheFastest(chosenNode, command)
+		response, err = re.executeOnAllToFigureOutTheFastest(chosenNode, command, request)
 	} else {
 		response, err = command.Send(re.httpClient, request)
 	}
 
 	if err != nil {
+		if ctx.Err() != nil {
+			// the caller gave up, the node is not to blame
+			return err
+		}
 		if !shouldRetry && isNetworkTimeoutError(err) {
 			return err
 		}
@@ -836,7 +906,7 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 		// but for us that propagates the wrong error to RequestExecutorTest_failsWhenServerIsOffline
 		urlRef = request.URL.String()
 		var ok bool
-		ok, err = re.handleServerDown(urlRef, chosenNode, nodeIndex, command, request, response, err, sessionInfo)
+		ok, err = re.handleServerDown(ctx, urlRef, chosenNode, nodeIndex, command, request, response, err, sessionInfo)
 		if err != nil {
 			return err
 		}
@@ -853,6 +923,7 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 
 	if response.StatusCode == http.StatusNotModified {
 		cachedItem.notModified()
+		re.Cache.recordHit()
 
 		if command.GetBase().ResponseType == RavenCommandResponseTypeObject {
 			err = command.SetResponse(cachedValue, true)
@@ -860,9 +931,13 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 		return err
 	}
 
+	if re.isCacheable(command, noCaching) {
+		re.Cache.recordMiss()
+	}
+
 	var ok bool
 	if response.StatusCode >= 400 {
-		ok, err = re.handleUnsuccessfulResponse(chosenNode, nodeIndex, command, request, response, urlRef, sessionInfo, shouldRetry)
+		ok, err = re.handleUnsuccessfulResponse(ctx, chosenNode, nodeIndex, command, request, response, urlRef, sessionInfo, shouldRetry)
 		if err != nil {
 			return err
 		}
@@ -886,13 +961,19 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 				}
 			}
 
-			return newAllTopologyNodesDownError("Received unsuccessful response from all servers and couldn't recover from it.")
+			err := newAllTopologyNodesDownError("Received unsuccessful response from all servers and couldn't recover from it.%s", failedNodesDetails(command.GetBase().FailedNodes))
+			err.FailedNodes = copyFailedNodes(command.GetBase().FailedNodes)
+			return err
 		}
 		return nil // we either handled this already in the unsuccessful response or we are throwing
 	}
 
+	cache := re.Cache
+	if noCaching {
+		cache = nil
+	}
 	var responseDispose responseDisposeHandling
-	responseDispose, err = ravenCommand_processResponse(command, re.Cache, response, urlRef)
+	responseDispose, err = ravenCommand_processResponse(command, cache, response, urlRef)
 	re.lastReturnedResponse.Store(time.Now())
 	if err != nil {
 		return err
@@ -961,8 +1042,32 @@ func (re *RequestExecutor) throwFailedToContactAllNodes(command RavenCommand, re
 
 		message += "\nI was able to fetch " + re.topologyTakenFromNode.Database + " topology from " + re.topologyTakenFromNode.URL + ".\n" + "Fetched topology: " + nodesStr
 	}
+	message += failedNodesDetails(command.GetBase().FailedNodes)
+
+	err := newAllTopologyNodesDownError("%s", message)
+	err.FailedNodes = copyFailedNodes(command.GetBase().FailedNodes)
+	return err
+}
+
+// failedNodesDetails describes errors of failed nodes, sorted by url
+func failedNodesDetails(failedNodes map[*ServerNode]error) string {
+	if len(failedNodes) == 0 {
+		return ""
+	}
+	var a []string
+	for node, err := range failedNodes {
+		a = append(a, node.URL+" -> "+err.Error())
+	}
+	sort.Strings(a)
+	return "\nErrors of failed nodes:\n" + strings.Join(a, "\n")
+}
 
-	return newAllTopologyNodesDownError("%s", message)
+func copyFailedNodes(failedNodes map[*ServerNode]error) map[*ServerNode]error {
+	res := make(map[*ServerNode]error, len(failedNodes))
+	for node, err := range failedNodes {
+		res[node] = err
+	}
+	return res
 }
 
 func (re *RequestExecutor) inSpeedTestPhase() bool {
@@ -975,7 +1080,7 @@ func (re *RequestExecutor) shouldExecuteOnAll(chosenNode *ServerNode, command Ra
 	multipleNodes := (nodeSelector != nil) && (len(nodeSelector.getTopology().Nodes) > 1)
 
 	cmd := command.GetBase()
-	return re.readBalanceBehavior == ReadBalanceBehaviorFastestNode &&
+	return re.getReadBalanceBehavior() == ReadBalanceBehaviorFastestNode &&
 		nodeSelector != nil &&
 		nodeSelector.inSpeedTestPhase() &&
 		multipleNodes &&
@@ -989,45 +1094,63 @@ type responseAndError struct {
 	err      error
 }
 
-func (re *RequestExecutor) executeOnAllToFigureOutTheFastest(chosenNode *ServerNode, command RavenCommand) (*http.Response, error) {
+// executeOnAllToFigureOutTheFastest races the request against all nodes in
+// the topology. The first node to respond successfully is recorded as the
+// fastest and response times of all nodes are recorded as their latency.
+// The response of the chosen node is returned, others are discarded
+func (re *RequestExecutor) executeOnAllToFigureOutTheFastest(chosenNode *ServerNode, command RavenCommand, originalRequest *http.Request) (*http.Response, error) {
 	// note: implementation is intentionally different than Java
+	nodeSelector := re.getNodeSelector()
+	nodes := nodeSelector.getTopology().Nodes
+
+	preferredIndex := -1
+	for idx, node := range nodes {
+		if node == chosenNode {
+			preferredIndex = idx
+			break
+		}
+	}
+	if preferredIndex == -1 {
+		// the topology has changed since the node was chosen
+		return command.Send(re.httpClient, originalRequest)
+	}
 
 	var fastestWasRecorded int32 // atomic
 	chanPreferredResponse := make(chan *responseAndError, 1)
 
-	nPreferred := 0
-	nodes := re.getNodeSelector().getTopology().Nodes
 	for idx, node := range nodes {
-		re.NumberOfServerRequests.incrementAndGet()
-
-		isPreferred := node.ClusterTag == chosenNode.ClusterTag
-		if isPreferred {
-			nPreferred++
-			panicIf(nPreferred > 1, "nPreferred is %d, should not be > 1", nPreferred)
+		if idx != preferredIndex {
+			re.NumberOfServerRequests.incrementAndGet()
 		}
 
 		go func(nodeIndex int, node *ServerNode) {
 			var response *http.Response
 			request, err := re.createRequest(node, command)
 			if err == nil {
+				if nodeIndex == preferredIndex {
+					request = request.WithContext(originalRequest.Context())
+				}
+				copySpeedTestHeaders(request, originalRequest)
+				start := time.Now()
 				response, err = command.Send(re.httpClient, request)
-				n := atomic.AddInt32(&fastestWasRecorded, 1)
-				if n == 1 {
-					// this is the first one, so record as fastest
-					re.getNodeSelector().recordFastest(nodeIndex, node)
+				// a node that quickly fails doesn't count as fast
+				if err == nil && response.StatusCode < 500 {
+					nodeSelector.recordLatency(nodeIndex, node, time.Since(start))
+					if atomic.AddInt32(&fastestWasRecorded, 1) == 1 {
+						nodeSelector.recordFastest(nodeIndex, node)
+					}
 				}
 			}
 			// we return http response of the preferred node and close
 			// all others
-			if isPreferred {
+			if nodeIndex == preferredIndex {
 				chanPreferredResponse <- &responseAndError{
 					response: response,
 					err:      err,
 				}
-			} else {
-				if response != nil && err == nil {
-					_ = response.Body.Close()
-				}
+			} else if response != nil && err == nil {
+				_, _ = io.Copy(ioutil.Discard, response.Body)
+				_ = response.Body.Close()
 			}
 		}(idx, node)
 	}
@@ -1038,12 +1161,44 @@ func (re *RequestExecutor) executeOnAllToFigureOutTheFastest(chosenNode *ServerN
 		return ret.response, ret.err
 	case <-time.After(time.Second * 15):
 		return nil, fmt.Errorf("request timed out")
+	case <-originalRequest.Context().Done():
+		return nil, originalRequest.Context().Err()
+	}
+}
+
+// copySpeedTestHeaders copies headers set by Execute (e.g. topology etag)
+// to a request sent to another node during a speed test
+func copySpeedTestHeaders(request *http.Request, originalRequest *http.Request) {
+	for name, values := range originalRequest.Header {
+		if _, ok := request.Header[name]; !ok {
+			request.Header[name] = values
+		}
+	}
+}
+
+// GetNodeLatencies returns average response times of topology nodes measured
+// by speed tests, which run with ReadBalanceBehaviorFastestNode
+func (re *RequestExecutor) GetNodeLatencies() map[*ServerNode]time.Duration {
+	nodeSelector := re.getNodeSelector()
+	if nodeSelector == nil {
+		return map[*ServerNode]time.Duration{}
 	}
+	return nodeSelector.getLatencies()
 }
 
-func (re *RequestExecutor) getFromCache(command RavenCommand, url string) (*releaseCacheItem, *string, []byte) {
+// GetHttpCacheStats returns the state of the cache of GET responses, which
+// are revalidated with If-None-Match header
+func (re *RequestExecutor) GetHttpCacheStats() HttpCacheStats {
+	return re.Cache.GetStats()
+}
+
+func (re *RequestExecutor) isCacheable(command RavenCommand, noCaching bool) bool {
 	cmd := command.GetBase()
-	if cmd.CanCache && cmd.IsReadRequest && cmd.ResponseType == RavenCommandResponseTypeObject {
+	return !noCaching && cmd.CanCache && cmd.IsReadRequest && cmd.ResponseType == RavenCommandResponseTypeObject
+}
+
+func (re *RequestExecutor) getFromCache(command RavenCommand, noCaching bool, url string) (*releaseCacheItem, *string, []byte) {
+	if re.isCacheable(command, noCaching) {
 		return re.Cache.get(url)
 	}
 
@@ -1059,7 +1214,7 @@ func (re *RequestExecutor) createRequest(node *ServerNode, command RavenCommand)
 	return request, err
 }
 
-func (re *RequestExecutor) handleUnsuccessfulResponse(chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, url string, sessionInfo *SessionInfo, shouldRetry bool) (bool, error) {
+func (re *RequestExecutor) handleUnsuccessfulResponse(ctx context.Context, chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, url string, sessionInfo *SessionInfo, shouldRetry bool) (bool, error) {
 	var err error
 	switch response.StatusCode {
 	case http.StatusNotFound:
@@ -1076,7 +1231,7 @@ func (re *RequestExecutor) handleUnsuccessfulResponse(chosenNode *ServerNode, no
 		}
 		return true, nil
 	case http.StatusForbidden:
-		err = newAuthorizationError("Forbidden access to " + chosenNode.Database + "@" + chosenNode.URL + ", " + request.Method + " " + request.URL.String())
+		err = newForbiddenAccessError(chosenNode, re.Certificate, request.Method+" "+request.URL.String())
 	case http.StatusGone: // request not relevant for the chosen node - the database has been moved to a different one
 		if !shouldRetry {
 			return false, nil
@@ -1093,11 +1248,19 @@ func (re *RequestExecutor) handleUnsuccessfulResponse(chosenNode *ServerNode, no
 		if err != nil {
 			return false, err
 		}
-		err = re.Execute(currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, false, sessionInfo)
+		err = re.ExecuteWithContext(ctx, currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, false, sessionInfo)
 		return false, err
+	case http.StatusTooManyRequests:
+		return re.handleServerBusy(ctx, chosenNode, nodeIndex, command, request, response, sessionInfo, shouldRetry)
+	case http.StatusServiceUnavailable:
+		if _, ok := httpExtensionsGetRetryAfter(response, time.Now()); ok {
+			return re.handleServerBusy(ctx, chosenNode, nodeIndex, command, request, response, sessionInfo, shouldRetry)
+		}
+		ok, err := re.handleServerDown(ctx, url, chosenNode, nodeIndex, command, request, response, nil, sessionInfo)
+		return ok, err
 	case http.StatusGatewayTimeout, http.StatusRequestTimeout,
-		http.StatusBadGateway, http.StatusServiceUnavailable:
-		ok, err := re.handleServerDown(url, chosenNode, nodeIndex, command, request, response, nil, sessionInfo)
+		http.StatusBadGateway:
+		ok, err := re.handleServerDown(ctx, url, chosenNode, nodeIndex, command, request, response, nil, sessionInfo)
 		return ok, err
 	case http.StatusConflict:
 		err = requestExecutorHandleConflict(response)
@@ -1108,11 +1271,38 @@ func (re *RequestExecutor) handleUnsuccessfulResponse(chosenNode *ServerNode, no
 	return false, err
 }
 
+// server is alive but asked us to slow down. Instead of failing over to other
+// nodes (which would only add to the load) we wait for the delay suggested
+// in Retry-After header and retry once on the same node
+func (re *RequestExecutor) handleServerBusy(ctx context.Context, chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, sessionInfo *SessionInfo, shouldRetry bool) (bool, error) {
+	retryAfter, _ := httpExtensionsGetRetryAfter(response, time.Now())
+	maxDelay := re.conventions.MaxRetryAfterDelay
+	if !shouldRetry || maxDelay <= 0 || retryAfter > maxDelay {
+		return false, newServerBusyError(retryAfter, "Server %s is too busy to handle %s %s (status code: %d), retry after %s", chosenNode.URL, request.Method, request.URL.String(), response.StatusCode, retryAfter)
+	}
+	if retryAfter < minServerBusyRetryDelay {
+		retryAfter = minServerBusyRetryDelay
+	}
+
+	timer := time.NewTimer(retryAfter)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		timer.Stop()
+		return false, ctx.Err()
+	}
+	err := re.ExecuteWithContext(ctx, chosenNode, nodeIndex, command, false, sessionInfo)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func requestExecutorHandleConflict(response *http.Response) error {
 	return exceptionDispatcherThrowError(response)
 }
 
-func (re *RequestExecutor) handleServerDown(url string, chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, e error, sessionInfo *SessionInfo) (bool, error) {
+func (re *RequestExecutor) handleServerDown(ctx context.Context, url string, chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, e error, sessionInfo *SessionInfo) (bool, error) {
 	if command.GetBase().FailedNodes == nil {
 		command.GetBase().FailedNodes = map[*ServerNode]error{}
 	}
@@ -1133,6 +1323,11 @@ func (re *RequestExecutor) handleServerDown(url string, chosenNode *ServerNode,
 
 	nodeSelector.onFailedRequest(nodeIndex)
 
+	if command.GetBase().SelectedNodeTag != "" {
+		// the command must run on the selected node, no failover
+		return false, nil
+	}
+
 	currentIndexAndNode, err := re.getPreferredNode()
 	if err != nil {
 		return false, err
@@ -1143,7 +1338,10 @@ func (re *RequestExecutor) handleServerDown(url string, chosenNode *ServerNode,
 		return false, nil
 	}
 
-	err = re.Execute(currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, false, sessionInfo)
+	if err = ctx.Err(); err != nil {
+		return false, err
+	}
+	err = re.ExecuteWithContext(ctx, currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, false, sessionInfo)
 	if err != nil {
 		return false, err
 	}
@@ -1201,7 +1399,7 @@ func (re *RequestExecutor) checkNodeStatusCallback(nodeStatus *NodeStatus) {
 func (re *RequestExecutor) clusterPerformHealthCheck(serverNode *ServerNode, nodeIndex int) error {
 	panicIf(!re.isCluster, "clusterPerformHealthCheck() called on non-cluster RequestExector")
 	command := NewGetTcpInfoCommand("health-check", "")
-	return re.Execute(serverNode, nodeIndex, command, false, nil)
+	return re.executeHealthCheck(serverNode, command)
 }
 
 func (re *RequestExecutor) performHealthCheck(serverNode *ServerNode, nodeIndex int) error {
@@ -1215,7 +1413,26 @@ func (re *RequestExecutor) performHealthCheck(serverNode *ServerNode, nodeIndex
 	if err != nil {
 		return err
 	}
-	return re.Execute(serverNode, nodeIndex, command, false, nil)
+	return re.executeHealthCheck(serverNode, command)
+}
+
+// executeHealthCheck sends the command only to serverNode. Unlike Execute it
+// never fails over to other nodes, which would make a failed node look healthy
+func (re *RequestExecutor) executeHealthCheck(serverNode *ServerNode, command RavenCommand) error {
+	request, err := re.createRequest(serverNode, command)
+	if err != nil {
+		return err
+	}
+	response, err := command.Send(re.httpClient, request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 400 {
+		return exceptionDispatcherThrowError(response)
+	}
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	return nil
 }
 
 // note: static
@@ -1267,13 +1484,10 @@ func (re *RequestExecutor) Close() {
 		return
 	}
 
-	if re.isCluster {
-		// make sure that a potentially pending UpdateTopologyAsync() has
-		// finished
-		re.clusterTopologySemaphore.acquire()
-	}
-
+	// abandon a pending topology update instead of waiting for it,
+	// which could take as long as the http client allows
 	re.markDisposed()
+	re.cancelClose()
 	re.Cache.close()
 
 	re.mu.Lock()
@@ -1289,17 +1503,27 @@ func (re *RequestExecutor) Close() {
 // TODO: create a different client if settings like compression
 // or certificate differ
 func (re *RequestExecutor) createClient() (*http.Client, error) {
+	if re.conventions.HTTPClient != nil {
+		return re.conventions.HTTPClient, nil
+	}
+	transport := re.conventions.HTTPTransport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
 	client := &http.Client{
 		Timeout:   time.Second * 30,
-		Transport: http.DefaultTransport,
+		Transport: transport,
 	}
 	if re.Certificate != nil || re.TrustStore != nil {
-		tlsConfig, err := newTLSConfig(re.Certificate, re.TrustStore)
-		if err != nil {
-			return nil, err
-		}
-		client.Transport = &http.Transport{
-			TLSClientConfig: tlsConfig,
+		// other transports are responsible for their own TLS configuration
+		if t, ok := transport.(*http.Transport); ok {
+			tlsConfig, err := newTLSConfig(re.Certificate, re.TrustStore)
+			if err != nil {
+				return nil, err
+			}
+			t = t.Clone()
+			t.TLSClientConfig = mergeTLSConfig(t.TLSClientConfig, tlsConfig)
+			client.Transport = t
 		}
 	}
 	if HTTPClientPostProcessor != nil {
@@ -1308,6 +1532,50 @@ func (re *RequestExecutor) createClient() (*http.Client, error) {
 	return client, nil
 }
 
+// mergeTLSConfig returns a copy of config (which can be nil) that presents
+// client certificate and verifies server certificate like tlsConfig, unless
+// config already does that
+func mergeTLSConfig(config *tls.Config, tlsConfig *tls.Config) *tls.Config {
+	if config == nil {
+		return tlsConfig
+	}
+	config = config.Clone()
+	if len(config.Certificates) == 0 {
+		config.Certificates = tlsConfig.Certificates
+	}
+	if config.RootCAs == nil && config.VerifyPeerCertificate == nil && tlsConfig.RootCAs != nil {
+		config.RootCAs = tlsConfig.RootCAs
+		config.InsecureSkipVerify = tlsConfig.InsecureSkipVerify
+		config.VerifyPeerCertificate = tlsConfig.VerifyPeerCertificate
+	}
+	return config
+}
+
+// getProxy returns the proxy used by http client, if it's known
+func (re *RequestExecutor) getProxy() (func(*http.Request) (*urlpkg.URL, error), bool) {
+	if re.httpClient == nil {
+		return nil, false
+	}
+	transport := re.httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if t, ok := transport.(*http.Transport); ok {
+		return t.Proxy, true
+	}
+	return nil, false
+}
+
+// GetPreferredNode returns the node requests are sent to when no read
+// balancing applies i.e. the first node of the topology that isn't failing
+func (re *RequestExecutor) GetPreferredNode() (*ServerNode, error) {
+	n, err := re.getPreferredNode()
+	if err != nil {
+		return nil, err
+	}
+	return n.currentNode, nil
+}
+
 func (re *RequestExecutor) getPreferredNode() (*CurrentIndexAndNode, error) {
 	ns, err := re.ensureNodeSelector()
 	if err != nil {
@@ -1317,6 +1585,15 @@ func (re *RequestExecutor) getPreferredNode() (*CurrentIndexAndNode, error) {
 	return ns.getPreferredNode()
 }
 
+func (re *RequestExecutor) getRequestedNode(nodeTag string) (*CurrentIndexAndNode, error) {
+	ns, err := re.ensureNodeSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.getRequestedNode(nodeTag)
+}
+
 func (re *RequestExecutor) getNodeBySessionID(sessionID int) (*CurrentIndexAndNode, error) {
 	ns, err := re.ensureNodeSelector()
 	if err != nil {
@@ -1351,7 +1628,7 @@ func (re *RequestExecutor) ensureNodeSelector() (*NodeSelector, error) {
 	if nodeSelector == nil {
 		topology := &Topology{
 			Nodes: re.GetTopologyNodes(),
-			Etag:  re.TopologyEtag,
+			Etag:  atomic.LoadInt64(&re.TopologyEtag),
 		}
 
 		nodeSelector = NewNodeSelector(topology)
@@ -1394,8 +1671,10 @@ func (s *NodeStatus) startTimer() {
 }
 
 func (s *NodeStatus) updateTimer() {
-	// TODO: not sure if Reset
-	s.timer.Reset(s.nextTimerPeriod())
+	// the timer has fired so Reset schedules the next check
+	if s.timer != nil {
+		s.timer.Reset(s.nextTimerPeriod())
+	}
 }
 
 func (s *NodeStatus) timerCallback() {
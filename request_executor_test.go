@@ -0,0 +1,677 @@
+package ravendb
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeNode is a server node that can be taken down and brought back up
+type fakeNode struct {
+	server   *httptest.Server
+	down     int32 // atomic
+	requests int32 // atomic, requests other than health checks
+	delay    int64 // atomic, time.Duration the node waits before responding
+}
+
+func newFakeNode() *fakeNode {
+	n := &fakeNode{}
+	n.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&n.down) != 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		time.Sleep(time.Duration(atomic.LoadInt64(&n.delay)))
+		if !strings.Contains(r.URL.RawQuery, "failure=check") {
+			atomic.AddInt32(&n.requests, 1)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	return n
+}
+
+func (n *fakeNode) setDown(down bool) {
+	var v int32
+	if down {
+		v = 1
+	}
+	atomic.StoreInt32(&n.down, v)
+}
+
+func newFailoverTestExecutor(nodes ...*fakeNode) *RequestExecutor {
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(nodes[0].server.URL, "db", nil, nil, nil)
+	topology := &Topology{Etag: -1}
+	for _, n := range nodes {
+		serverNode := NewServerNode()
+		serverNode.URL = n.server.URL
+		serverNode.Database = "db"
+		topology.Nodes = append(topology.Nodes, serverNode)
+	}
+	re.setNodeSelector(NewNodeSelector(topology))
+	return re
+}
+
+func waitFor(cond func() bool) bool {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return true
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	return false
+}
+
+func TestRequestExecutorFailover(t *testing.T) {
+	nodeA, nodeB := newFakeNode(), newFakeNode()
+	defer nodeA.server.Close()
+	defer nodeB.server.Close()
+	re := newFailoverTestExecutor(nodeA, nodeB)
+	defer re.Close()
+	topologyNodes := re.GetTopologyNodes()
+	isFailed := func(idx int) bool {
+		return re.getNodeSelector().getState().failures[idx].get() > 0
+	}
+
+	nodeA.setDown(true)
+	cmd, err := NewHiLoReturnCommand("users", 1, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&nodeB.requests))
+	assert.Equal(t, 1, len(cmd.FailedNodes))
+	assert.NotNil(t, cmd.FailedNodes[topologyNodes[0]])
+	assert.True(t, isFailed(0))
+
+	// health checks of a failed node must not be answered by other nodes
+	time.Sleep(300 * time.Millisecond)
+	assert.True(t, isFailed(0))
+
+	// the next request goes straight to the healthy node
+	cmd, _ = NewHiLoReturnCommand("users", 1, 2)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, 0, len(cmd.FailedNodes))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&nodeB.requests))
+
+	nodeA.setDown(false)
+	assert.True(t, waitFor(func() bool { return !isFailed(0) }))
+	cmd, _ = NewHiLoReturnCommand("users", 1, 2)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&nodeA.requests))
+}
+
+func TestRequestExecutorExecuteWithContext(t *testing.T) {
+	nodeA, nodeB := newFakeNode(), newFakeNode()
+	defer nodeA.server.Close()
+	defer nodeB.server.Close()
+	re := newFailoverTestExecutor(nodeA, nodeB)
+	defer re.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cmd, _ := NewHiLoReturnCommand("users", 1, 2)
+	err := re.ExecuteCommandWithContext(ctx, cmd, nil)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&nodeA.requests))
+
+	// a request that takes too long is abandoned without failing over
+	atomic.StoreInt64(&nodeA.delay, int64(500*time.Millisecond))
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	cmd, _ = NewHiLoReturnCommand("users", 1, 2)
+	err = re.ExecuteCommandWithContext(ctx, cmd, nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 0, len(cmd.FailedNodes))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&nodeB.requests))
+	assert.Equal(t, 0, re.getNodeSelector().getState().failures[0].get())
+}
+
+func TestRequestExecutorServerBusyWithContext(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Retry-After", "2")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(server.URL, "db", nil, nil, nil)
+	defer re.Close()
+
+	// waiting for the retry is cut short by the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	cmd, _ := NewHiLoReturnCommand("users", 1, 2)
+	err := re.ExecuteCommandWithContext(ctx, cmd, nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, time.Since(start) < time.Second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+// newServerBusyTestServer returns a server that responds to the first
+// request with status and Retry-After header retryAfter (if not empty)
+// and to the following ones with 200
+func newServerBusyTestServer(status int, retryAfter string, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(requests, 1) == 1 {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+}
+
+func TestRequestExecutorServerBusyRetries(t *testing.T) {
+	tests := []struct {
+		status     int
+		retryAfter string
+		minDelay   time.Duration
+	}{
+		// without Retry-After we still wait before retrying
+		{http.StatusTooManyRequests, "", minServerBusyRetryDelay},
+		{http.StatusTooManyRequests, "0", minServerBusyRetryDelay},
+		{http.StatusTooManyRequests, "1", time.Second},
+		{http.StatusServiceUnavailable, "1", time.Second},
+	}
+	for _, test := range tests {
+		var requests int32
+		server := newServerBusyTestServer(test.status, test.retryAfter, &requests)
+		re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(server.URL, "db", nil, nil, nil)
+
+		start := time.Now()
+		cmd, _ := NewHiLoReturnCommand("users", 1, 2)
+		err := re.ExecuteCommand(cmd, nil)
+		assert.NoError(t, err, "status %d, Retry-After '%s'", test.status, test.retryAfter)
+		assert.True(t, time.Since(start) >= test.minDelay, "status %d, Retry-After '%s'", test.status, test.retryAfter)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+		re.Close()
+		server.Close()
+	}
+}
+
+func TestRequestExecutorServerBusyAboveMaxRetryAfterDelay(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		var requests int32
+		server := newServerBusyTestServer(status, "10", &requests)
+		re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(server.URL, "db", nil, nil, nil)
+
+		start := time.Now()
+		cmd, _ := NewHiLoReturnCommand("users", 1, 2)
+		err := re.ExecuteCommand(cmd, nil)
+		if busyErr, ok := err.(*ServerBusyError); assert.True(t, ok, "status %d: unexpected error %v", status, err) {
+			assert.Equal(t, 10*time.Second, busyErr.RetryAfter)
+		}
+		assert.True(t, time.Since(start) < time.Second)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+		re.Close()
+		server.Close()
+	}
+}
+
+func TestRequestExecutorAllNodesDown(t *testing.T) {
+	nodeA, nodeB := newFakeNode(), newFakeNode()
+	defer nodeA.server.Close()
+	defer nodeB.server.Close()
+	re := newFailoverTestExecutor(nodeA, nodeB)
+	defer re.Close()
+
+	nodeA.setDown(true)
+	nodeB.setDown(true)
+	cmd, _ := NewHiLoReturnCommand("users", 1, 2)
+	err := re.ExecuteCommand(cmd, nil)
+	downErr, ok := err.(*AllTopologyNodesDownError)
+	if assert.True(t, ok, "unexpected error %v", err) {
+		assert.Equal(t, 2, len(downErr.FailedNodes))
+		assert.Contains(t, downErr.Error(), nodeA.server.URL)
+		assert.Contains(t, downErr.Error(), nodeB.server.URL)
+	}
+}
+
+func TestRequestExecutorAllNodesUnreachable(t *testing.T) {
+	nodeA, nodeB := newFakeNode(), newFakeNode()
+	re := newFailoverTestExecutor(nodeA, nodeB)
+	defer re.Close()
+	nodeA.server.Close()
+	nodeB.server.Close()
+
+	cmd, _ := NewHiLoReturnCommand("users", 1, 2)
+	err := re.ExecuteCommand(cmd, nil)
+	downErr, ok := err.(*AllTopologyNodesDownError)
+	if assert.True(t, ok, "unexpected error %v", err) {
+		assert.Equal(t, 2, len(downErr.FailedNodes))
+	}
+}
+
+func TestNodeSelectorGetNodeBySessionID(t *testing.T) {
+	topology := &Topology{}
+	for _, url := range []string{"http://a", "http://b", "http://c"} {
+		node := NewServerNode()
+		node.URL = url
+		node.ServerRole = ServerNodeRoleMember
+		topology.Nodes = append(topology.Nodes, node)
+	}
+	s := NewNodeSelector(topology)
+
+	n, err := s.getNodeBySessionID(4)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n.currentIndex)
+	n, err = s.getNodeBySessionID(-1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n.currentIndex)
+
+	s.onFailedRequest(1)
+	n, err = s.getNodeBySessionID(4)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n.currentIndex)
+
+	s.restoreNodeIndex(1)
+	s.restoreNodeIndex(3)
+	n, err = s.getNodeBySessionID(4)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n.currentIndex)
+
+	_, err = NewNodeSelector(&Topology{}).getNodeBySessionID(1)
+	assert.IsType(t, &AllTopologyNodesDownError{}, err)
+}
+
+func TestRequestExecutorLoadBalanceBehavior(t *testing.T) {
+	nodeA, nodeB, nodeC := newFakeNode(), newFakeNode(), newFakeNode()
+	defer nodeA.server.Close()
+	defer nodeB.server.Close()
+	defer nodeC.server.Close()
+	re := newFailoverTestExecutor(nodeA, nodeB, nodeC)
+	defer re.Close()
+
+	conventions := NewDocumentConventions()
+	conventions.LoadBalanceBehavior = LoadBalanceBehaviorUseSessionContext
+	conventions.LoadBalancerPerSessionContextSelector = func(databaseName string) string {
+		return "tenant-" + databaseName
+	}
+	re.loadBalanceBehavior.Store(conventions.LoadBalanceBehavior)
+	for _, node := range re.GetTopologyNodes() {
+		node.ServerRole = ServerNodeRoleMember
+	}
+
+	write, _ := NewHiLoReturnCommand("users", 1, 2)
+	write.IsReadRequest = false
+	read, _ := NewHiLoReturnCommand("users", 1, 2)
+
+	// sessions with the same context use the same node for reads and writes
+	info := newSessionInfo(1, conventions, "db")
+	assert.True(t, info.canUseLoadBalanceBehavior)
+	n1, err := re.chooseNodeForRequest(write, info)
+	assert.NoError(t, err)
+	n2, err := re.chooseNodeForRequest(read, newSessionInfo(2, conventions, "db"))
+	assert.NoError(t, err)
+	assert.Equal(t, n1.currentIndex, n2.currentIndex)
+
+	// the context can't be changed once the session was used
+	assert.Error(t, info.SetContext("other"))
+
+	// different contexts are spread over nodes
+	used := map[int]bool{}
+	for i := 0; i < 20; i++ {
+		info := newSessionInfo(i, NewDocumentConventions(), "db")
+		info.loadBalanceBehavior = LoadBalanceBehaviorUseSessionContext
+		assert.NoError(t, info.SetContext("tenant-"+strconv.Itoa(i)))
+		n, err := re.chooseNodeForRequest(write, info)
+		assert.NoError(t, err)
+		used[n.currentIndex] = true
+	}
+	assert.True(t, len(used) > 1)
+
+	// sessions without context fall back to read balance behavior
+	info = newSessionInfo(1, NewDocumentConventions(), "db")
+	assert.False(t, info.canUseLoadBalanceBehavior)
+	n, err := re.chooseNodeForRequest(write, info)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n.currentIndex)
+
+	re.readBalanceBehavior.Store(ReadBalanceBehaviorRoundRobin)
+	n, err = re.chooseNodeForRequest(read, info)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n.currentIndex)
+}
+
+func TestSessionInfoSetContext(t *testing.T) {
+	conventions := NewDocumentConventions()
+	conventions.LoadBalanceBehavior = LoadBalanceBehaviorUseSessionContext
+	info := newSessionInfo(1, conventions, "db")
+	assert.False(t, info.canUseLoadBalanceBehavior)
+	assert.Error(t, info.SetContext(" "))
+
+	assert.NoError(t, info.SetContext("tenant"))
+	assert.True(t, info.canUseLoadBalanceBehavior)
+	id := info.SessionID
+	assert.True(t, id >= 0)
+
+	conventions.LoadBalancerContextSeed = 7
+	seeded := newSessionInfo(1, conventions, "db")
+	assert.NoError(t, seeded.SetContext("tenant"))
+	assert.NotEqual(t, id, seeded.SessionID)
+}
+
+func TestRequestExecutorSpeedTest(t *testing.T) {
+	nodeA, nodeB, nodeC := newFakeNode(), newFakeNode(), newFakeNode()
+	defer nodeA.server.Close()
+	defer nodeB.server.Close()
+	defer nodeC.server.Close()
+	atomic.StoreInt64(&nodeA.delay, int64(50*time.Millisecond))
+	atomic.StoreInt64(&nodeC.delay, int64(50*time.Millisecond))
+	// the slow node responding with an error can't win
+	nodeC.setDown(true)
+
+	re := newFailoverTestExecutor(nodeA, nodeB, nodeC)
+	defer re.Close()
+	re.readBalanceBehavior.Store(ReadBalanceBehaviorFastestNode)
+	for _, node := range re.GetTopologyNodes() {
+		node.ServerRole = ServerNodeRoleMember
+	}
+	re.getNodeSelector().scheduleSpeedTest()
+	assert.True(t, re.inSpeedTestPhase())
+
+	for i := 0; i < speedTestWinsToSelect; i++ {
+		cmd := NewNextHiLoCommand("users", 0, nil, "/", 0)
+		assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	}
+	assert.False(t, re.inSpeedTestPhase())
+
+	fastest, err := re.getFastestNode()
+	assert.NoError(t, err)
+	assert.Equal(t, nodeB.server.URL, fastest.currentNode.URL)
+
+	nodes := re.GetTopologyNodes()
+	latencies := re.GetNodeLatencies()
+	assert.True(t, waitFor(func() bool {
+		latencies = re.GetNodeLatencies()
+		return len(latencies) == 2
+	}))
+	assert.True(t, latencies[nodes[0]] >= 50*time.Millisecond)
+	assert.True(t, latencies[nodes[1]] < latencies[nodes[0]])
+	// failed responses are not measured
+	_, ok := latencies[nodes[2]]
+	assert.False(t, ok)
+}
+
+// fakeTopologyServer returns a single node topology with etag set by the test.
+// Other requests get Refresh-Topology header if refresh is set
+type fakeTopologyServer struct {
+	server           *httptest.Server
+	etag             int64 // atomic
+	refresh          int32 // atomic
+	topologyRequests int32 // atomic
+	lastTopologyEtag atomic.Value
+}
+
+func newFakeTopologyServer() *fakeTopologyServer {
+	s := &fakeTopologyServer{}
+	s.lastTopologyEtag.Store("")
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/topology" {
+			atomic.AddInt32(&s.topologyRequests, 1)
+			etag := strconv.FormatInt(atomic.LoadInt64(&s.etag), 10)
+			w.Write([]byte(`{"Nodes": [{"Url": "` + s.server.URL + `", "ClusterTag": "A", "Database": "db", "ServerRole": "Member"}], "Etag": ` + etag + `}`))
+			return
+		}
+		s.lastTopologyEtag.Store(r.Header.Get(headersTopologyEtag))
+		if atomic.LoadInt32(&s.refresh) != 0 {
+			w.Header().Set(headersRefreshTopology, "true")
+		}
+		w.Write([]byte(`{}`))
+	}))
+	return s
+}
+
+func TestRequestExecutorRefreshTopologyHeader(t *testing.T) {
+	s := newFakeTopologyServer()
+	defer s.server.Close()
+	atomic.StoreInt64(&s.etag, 1)
+
+	conventions := NewDocumentConventions()
+	re := RequestExecutorCreate([]string{s.server.URL}, "db", nil, nil, conventions)
+	defer re.Close()
+
+	cmd := NewNextHiLoCommand("users", 0, nil, "/", 0)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int64(1), re.GetTopology().Etag)
+	assert.Equal(t, `"1"`, s.lastTopologyEtag.Load())
+
+	// the server tells us about a newer topology
+	atomic.StoreInt64(&s.etag, 2)
+	atomic.StoreInt32(&s.refresh, 1)
+	cmd = NewNextHiLoCommand("users", 0, nil, "/", 0)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int64(2), re.GetTopology().Etag)
+
+	node, err := re.GetPreferredNode()
+	assert.NoError(t, err)
+	assert.Equal(t, s.server.URL, node.URL)
+	assert.Equal(t, "A", node.ClusterTag)
+}
+
+func TestRequestExecutorPeriodicTopologyUpdates(t *testing.T) {
+	oldPeriod := topologyUpdatePeriod
+	topologyUpdatePeriod = 20 * time.Millisecond
+	defer func() {
+		topologyUpdatePeriod = oldPeriod
+	}()
+
+	s := newFakeTopologyServer()
+	defer s.server.Close()
+
+	re := RequestExecutorCreate([]string{s.server.URL}, "db", nil, nil, nil)
+	cmd := NewNextHiLoCommand("users", 0, nil, "/", 0)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+
+	atomic.StoreInt64(&s.etag, 5)
+	assert.True(t, waitFor(func() bool {
+		topology := re.GetTopology()
+		return topology != nil && topology.Etag == 5
+	}))
+
+	re.Close()
+	time.Sleep(50 * time.Millisecond)
+	n := atomic.LoadInt32(&s.topologyRequests)
+	time.Sleep(100 * time.Millisecond)
+	// closed executor doesn't update topology anymore
+	assert.Equal(t, n, atomic.LoadInt32(&s.topologyRequests))
+}
+
+type countingTransport struct {
+	requests int32 // atomic
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&t.requests, 1)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestRequestExecutorCustomHTTPClient(t *testing.T) {
+	node := newFakeNode()
+	defer node.server.Close()
+
+	conventions := NewDocumentConventions()
+	transport := &countingTransport{}
+	conventions.HTTPTransport = transport
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(node.server.URL, "db", nil, nil, conventions)
+	defer re.Close()
+	cmd, _ := NewHiLoReturnCommand("users", 1, 2)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&transport.requests))
+	_, ok := re.getProxy()
+	assert.False(t, ok)
+
+	client := &http.Client{Transport: transport}
+	conventions.HTTPClient = client
+	re2 := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(node.server.URL, "db", nil, nil, conventions)
+	defer re2.Close()
+	httpClient, err := re2.GetHTTPClient()
+	assert.NoError(t, err)
+	assert.True(t, httpClient == client)
+	cmd, _ = NewHiLoReturnCommand("users", 1, 2)
+	assert.NoError(t, re2.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&transport.requests))
+}
+
+func TestRequestExecutorHTTPTransportWithTrustStore(t *testing.T) {
+	ca := newTestCertificate(t, "ca", true, nil)
+	proxyURL, _ := url.Parse("http://proxy:3128")
+	conventions := NewDocumentConventions()
+	transport := &http.Transport{Proxy: http.ProxyURL(proxyURL)}
+	conventions.HTTPTransport = transport
+
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates("https://a:8080", "db", nil, ca.cert, conventions)
+	defer re.Close()
+	used := re.httpClient.Transport.(*http.Transport)
+	assert.True(t, used != transport)
+	assert.NotNil(t, used.TLSClientConfig.VerifyPeerCertificate)
+	// the user's transport is not modified
+	assert.True(t, transport.TLSClientConfig == nil || transport.TLSClientConfig.VerifyPeerCertificate == nil)
+
+	// TLS settings of the transport are kept
+	conventions.HTTPTransport = &http.Transport{TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS13}}
+	re2 := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates("https://a:8080", "db", nil, ca.cert, conventions)
+	defer re2.Close()
+	tlsConfig := re2.httpClient.Transport.(*http.Transport).TLSClientConfig
+	assert.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+	assert.NotNil(t, tlsConfig.RootCAs)
+
+	proxy, ok := re.getProxy()
+	assert.True(t, ok)
+	req, _ := http.NewRequest(http.MethodGet, "https://a:8080", nil)
+	u, err := proxy(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://proxy:3128", u.String())
+}
+
+func TestRequestExecutorClientConfigurationUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/configuration/client") {
+			w.Write([]byte(`{"Etag": 5, "Configuration": {"ReadBalanceBehavior": "RoundRobin", "LoadBalanceBehavior": "UseSessionContext"}}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(server.URL, "db", nil, nil, nil)
+	defer re.Close()
+
+	// the update runs concurrently with requests choosing nodes
+	future := re.updateClientConfigurationAsync()
+	cmd, _ := NewHiLoReturnCommand("users", 1, 2)
+	for !future.IsDone() {
+		_, err := re.chooseNodeForRequest(cmd, nil)
+		assert.NoError(t, err)
+	}
+	_, err := future.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, ReadBalanceBehaviorRoundRobin, re.getReadBalanceBehavior())
+	assert.Equal(t, LoadBalanceBehaviorUseSessionContext, re.getLoadBalanceBehavior())
+}
+
+func TestRequestExecutorSelectedNodeTag(t *testing.T) {
+	nodeA, nodeB := newFakeNode(), newFakeNode()
+	defer nodeA.server.Close()
+	defer nodeB.server.Close()
+
+	re := newFailoverTestExecutor(nodeA, nodeB)
+	defer re.Close()
+	topology := re.getNodeSelector().getTopology()
+	topology.Nodes[0].ClusterTag = "A"
+	topology.Nodes[1].ClusterTag = "B"
+
+	op := NewOperation(re, nil, re.GetConventions(), 1)
+	op.nodeTag = "B"
+	_, err := op.fetchOperationsStatus(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, op.Kill())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&nodeA.requests))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&nodeB.requests))
+
+	// the selected node being down doesn't fail over to another node
+	nodeB.setDown(true)
+	_, err = op.fetchOperationsStatus(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&nodeA.requests))
+
+	op.nodeTag = "C"
+	_, err = op.fetchOperationsStatus(context.Background())
+	_, ok := err.(*RequestedNodeUnavailableError)
+	assert.True(t, ok, "unexpected error %v", err)
+}
+
+func TestContextVariantsGiveUpWhenCancelled(t *testing.T) {
+	node := newFakeNode()
+	defer node.server.Close()
+	store := NewDocumentStore([]string{node.server.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	assert.NoError(t, store.Initialize())
+	defer store.Close()
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	session, err := store.OpenSession("")
+	assert.NoError(t, err)
+	defer session.Close()
+	var user *User
+	err = session.LoadWithContext(cancelled, &user, "users/1")
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+	var users []*User
+	err = session.QueryCollection("Users").GetResultsWithContext(cancelled, &users)
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+
+	deleteOp, err := NewDeleteByQueryOperation(NewIndexQuery("from Users"), nil)
+	assert.NoError(t, err)
+	_, err = store.Operations().SendAsyncWithContext(cancelled, deleteOp, nil)
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+	_, err = store.Maintenance().SendAsyncWithContext(cancelled, NewStartBackupOperation(true, 1))
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&node.requests))
+
+	err = store.Maintenance().WaitForIndexing(cancelled, "")
+	assert.IsType(t, &TimeoutError{}, err)
+
+	// a status request still running when the deadline passes
+	atomic.StoreInt64(&node.delay, int64(500*time.Millisecond))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	op := NewOperation(store.GetRequestExecutor(""), nil, store.GetConventions(), 1)
+	err = op.WaitForCompletionWithContext(ctx)
+	assert.IsType(t, &TimeoutError{}, err)
+}
+
+func TestRequestExecutorCloseAbandonsTopologyUpdate(t *testing.T) {
+	node := newFakeNode()
+	defer node.server.Close()
+	atomic.StoreInt64(&node.delay, int64(2*time.Second))
+	re := newFailoverTestExecutor(node)
+
+	serverNode := re.getNodeSelector().getTopology().Nodes[0]
+	future := re.UpdateTopologyAsync(serverNode, 0)
+	time.Sleep(100 * time.Millisecond)
+
+	start := time.Now()
+	re.Close()
+	assert.True(t, time.Since(start) < time.Second, "Close() waited %s for the topology update", time.Since(start))
+
+	select {
+	case result := <-future:
+		assert.False(t, result.Ok)
+	case <-time.After(time.Second):
+		t.Fatal("topology update wasn't abandoned")
+	}
+}
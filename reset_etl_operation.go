@@ -0,0 +1,67 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &ResetEtlOperation{}
+)
+
+// ResetEtlOperation makes a transformation of an ETL task start over
+// and process all documents again
+type ResetEtlOperation struct {
+	configurationName  string
+	transformationName string
+
+	Command *ResetEtlCommand
+}
+
+// NewResetEtlOperation returns new ResetEtlOperation
+//
+// Deprecated: use operations.NewResetEtlOperation.
+func NewResetEtlOperation(configurationName string, transformationName string) (*ResetEtlOperation, error) {
+	if configurationName == "" {
+		return nil, newIllegalArgumentError("configurationName cannot be empty")
+	}
+	if transformationName == "" {
+		return nil, newIllegalArgumentError("transformationName cannot be empty")
+	}
+	return &ResetEtlOperation{
+		configurationName:  configurationName,
+		transformationName: transformationName,
+	}, nil
+}
+
+func (o *ResetEtlOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewResetEtlCommand(o.configurationName, o.transformationName)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &ResetEtlCommand{}
+
+// ResetEtlCommand describes "reset etl" command
+type ResetEtlCommand struct {
+	RavenCommandBase
+
+	configurationName  string
+	transformationName string
+}
+
+// NewResetEtlCommand returns new ResetEtlCommand
+func NewResetEtlCommand(configurationName string, transformationName string) *ResetEtlCommand {
+	cmd := &ResetEtlCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		configurationName:  configurationName,
+		transformationName: transformationName,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd
+}
+
+func (c *ResetEtlCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/etl?configurationName=" + urlUtilsEscapeDataString(c.configurationName) + "&transformationName=" + urlUtilsEscapeDataString(c.transformationName)
+
+	return newHttpReset(url)
+}
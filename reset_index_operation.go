@@ -6,12 +6,16 @@ import (
 
 var _ IVoidMaintenanceOperation = &ResetIndexOperation{}
 
+// ResetIndexOperation deletes all entries of an index and re-indexes from scratch
 type ResetIndexOperation struct {
 	indexName string
 
 	Command *ResetIndexCommand
 }
 
+// NewResetIndexOperation returns ResetIndexOperation
+//
+// Deprecated: use operations.NewResetIndexOperation.
 func NewResetIndexOperation(indexName string) (*ResetIndexOperation, error) {
 	if indexName == "" {
 		return nil, newIllegalArgumentError("indexName cannot be empty")
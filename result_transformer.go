@@ -0,0 +1,153 @@
+package ravendb
+
+import (
+	"context"
+	"reflect"
+)
+
+var (
+	errorType           = reflect.TypeOf((*error)(nil)).Elem()
+	documentSessionType = reflect.TypeOf(&DocumentSession{})
+)
+
+// ResultTransformer is a reusable client-side step that converts results
+// of a query, e.g. into view models. It wraps a function of one of the forms:
+//
+//	func([]T) []U
+//	func([]T) ([]U, error)
+//	func(T) U
+//	func(T) (U, error)
+//	func(*DocumentSession, T) (U, error)
+//
+// The session gives access to documents included by the query
+// without additional requests to the server.
+// Transformers are attached to queries with DocumentQuery.Transform
+type ResultTransformer struct {
+	fn          reflect.Value
+	in          reflect.Type
+	out         reflect.Type
+	perItem     bool
+	withSession bool
+	withError   bool
+}
+
+// NewResultTransformer returns a transformer wrapping fn
+func NewResultTransformer(fn interface{}) (*ResultTransformer, error) {
+	if fn == nil {
+		return nil, newIllegalArgumentError("fn cannot be nil")
+	}
+	v := reflect.ValueOf(fn)
+	tp := v.Type()
+	if tp.Kind() != reflect.Func {
+		return nil, newIllegalArgumentError("fn must be a function, got %T", fn)
+	}
+	res := &ResultTransformer{fn: v}
+
+	nIn := tp.NumIn()
+	switch {
+	case nIn == 2 && tp.In(0) == documentSessionType:
+		res.withSession = true
+		res.perItem = true
+		res.in = tp.In(1)
+	case nIn == 1:
+		res.in = tp.In(0)
+	default:
+		return nil, newIllegalArgumentError("fn has unsupported signature %s", tp)
+	}
+
+	switch {
+	case tp.NumOut() == 1:
+		res.out = tp.Out(0)
+	case tp.NumOut() == 2 && tp.Out(1) == errorType:
+		res.out = tp.Out(0)
+		res.withError = true
+	default:
+		return nil, newIllegalArgumentError("fn has unsupported signature %s", tp)
+	}
+
+	if !res.withSession {
+		inSlice := res.in.Kind() == reflect.Slice
+		outSlice := res.out.Kind() == reflect.Slice
+		if inSlice != outSlice {
+			return nil, newIllegalArgumentError("fn has unsupported signature %s", tp)
+		}
+		res.perItem = !inSlice
+	}
+	if res.perItem {
+		// normalize to slice types
+		res.in = reflect.SliceOf(res.in)
+		res.out = reflect.SliceOf(res.out)
+	}
+	return res, nil
+}
+
+// transform converts a slice of results of type t.in into a slice of type t.out
+func (t *ResultTransformer) transform(session *DocumentSession, in reflect.Value) (reflect.Value, error) {
+	call := func(args ...reflect.Value) (reflect.Value, error) {
+		out := t.fn.Call(args)
+		if t.withError && !out[1].IsNil() {
+			return reflect.Value{}, out[1].Interface().(error)
+		}
+		return out[0], nil
+	}
+
+	if !t.perItem {
+		return call(in)
+	}
+
+	res := reflect.MakeSlice(t.out, 0, in.Len())
+	for i := 0; i < in.Len(); i++ {
+		var v reflect.Value
+		var err error
+		if t.withSession {
+			v, err = call(reflect.ValueOf(session), in.Index(i))
+		} else {
+			v, err = call(in.Index(i))
+		}
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		res = reflect.Append(res, v)
+	}
+	return res, nil
+}
+
+func (q *abstractDocumentQuery) transform(transformer *ResultTransformer) error {
+	if transformer == nil {
+		return newIllegalArgumentError("transformer cannot be nil")
+	}
+	if n := len(q.resultTransformers); n > 0 {
+		prev := q.resultTransformers[n-1]
+		if !prev.out.AssignableTo(transformer.in) {
+			return newIllegalArgumentError("transformer expects %s but previous transformer returns %s", transformer.in, prev.out)
+		}
+	}
+	q.resultTransformers = append(q.resultTransformers, transformer)
+	return nil
+}
+
+// getTransformedResults runs the query for results of the type expected by
+// the first transformer and sets results to the output of the last one
+func (q *abstractDocumentQuery) getTransformedResults(ctx context.Context, results interface{}) error {
+	first := q.resultTransformers[0]
+	last := q.resultTransformers[len(q.resultTransformers)-1]
+
+	resultsSlice := reflect.ValueOf(results).Elem()
+	if !last.out.AssignableTo(resultsSlice.Type()) {
+		return newIllegalArgumentError("results should be *%s, got %T", last.out, results)
+	}
+
+	slicePtr := reflect.New(first.in)
+	if err := q.executeQueryOperation(ctx, slicePtr.Interface(), -1); err != nil {
+		return err
+	}
+	v := slicePtr.Elem()
+	for _, transformer := range q.resultTransformers {
+		var err error
+		if v, err = transformer.transform(q.theSession.session, v); err != nil {
+			return err
+		}
+	}
+	resultsSlice.Set(v)
+	return nil
+}
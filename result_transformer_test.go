@@ -0,0 +1,103 @@
+package ravendb
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewResultTransformerSignatures(t *testing.T) {
+	valid := []interface{}{
+		func(a []int) []string { return nil },
+		func(a []int) ([]string, error) { return nil, nil },
+		func(a int) string { return "" },
+		func(a int) (string, error) { return "", nil },
+		func(s *DocumentSession, a int) (string, error) { return "", nil },
+	}
+	for _, fn := range valid {
+		tr, err := NewResultTransformer(fn)
+		assert.NoError(t, err)
+		assert.Equal(t, reflect.TypeOf([]int{}), tr.in)
+		assert.Equal(t, reflect.TypeOf([]string{}), tr.out)
+	}
+
+	invalid := []interface{}{
+		nil,
+		5,
+		func() []int { return nil },
+		func(a []int) string { return "" },
+		func(a int) []string { return nil },
+		func(a int) (string, int) { return "", 0 },
+		func(a, b int) string { return "" },
+		func(s *DocumentSession, a, b int) (string, error) { return "", nil },
+	}
+	for i, fn := range invalid {
+		_, err := NewResultTransformer(fn)
+		assert.Error(t, err, "case %d", i)
+	}
+}
+
+func TestResultTransformerTransform(t *testing.T) {
+	upper, err := NewResultTransformer(strings.ToUpper)
+	assert.NoError(t, err)
+	in := reflect.ValueOf([]string{"a", "b"})
+	out, err := upper.transform(nil, in)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A", "B"}, out.Interface())
+
+	join, err := NewResultTransformer(func(a []string) []string {
+		return []string{strings.Join(a, ",")}
+	})
+	assert.NoError(t, err)
+	out, err = join.transform(nil, in)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a,b"}, out.Interface())
+
+	failing, err := NewResultTransformer(func(s string) (string, error) {
+		return "", errors.New("failed")
+	})
+	assert.NoError(t, err)
+	_, err = failing.transform(nil, in)
+	assert.Error(t, err)
+}
+
+func TestAbstractDocumentQueryTransformChain(t *testing.T) {
+	toLen, _ := NewResultTransformer(func(s string) int { return len(s) })
+	upper, _ := NewResultTransformer(strings.ToUpper)
+
+	q := &abstractDocumentQuery{}
+	assert.NoError(t, q.transform(toLen))
+	assert.Error(t, q.transform(upper))
+	assert.Error(t, q.transform(nil))
+	assert.Equal(t, 1, len(q.resultTransformers))
+}
+
+func TestDerivedQueriesDontShareTransformers(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:8080"}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	assert.NoError(t, store.Initialize())
+	defer store.Close()
+	session, err := store.OpenSession("")
+	assert.NoError(t, err)
+	defer session.Close()
+
+	upper, _ := NewResultTransformer(strings.ToUpper)
+	lower, _ := NewResultTransformer(strings.ToLower)
+	toLen, _ := NewResultTransformer(func(s string) int { return len(s) })
+
+	// 3 transformers leave spare capacity in the slice
+	base := session.QueryCollection("Users").Transform(upper).Transform(upper).Transform(upper)
+	assert.NoError(t, base.err)
+	type nameOnly struct {
+		Name string
+	}
+	first := base.SelectFields(reflect.TypeOf(&nameOnly{}), "Name").Transform(toLen)
+	second := base.SelectFields(reflect.TypeOf(&nameOnly{}), "Name").Transform(lower)
+	assert.NoError(t, first.err)
+	assert.NoError(t, second.err)
+	assert.Equal(t, toLen, first.resultTransformers[3])
+	assert.Equal(t, lower, second.resultTransformers[3])
+}
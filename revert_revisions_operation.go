@@ -0,0 +1,89 @@
+package ravendb
+
+import (
+	"net/http"
+	"time"
+)
+
+var (
+	_ IMaintenanceOperation = &RevertRevisionsOperation{}
+)
+
+// RevertRevisionsRequest describes a point in time documents are reverted to
+type RevertRevisionsRequest struct {
+	// documents are reverted to their latest revision before Time
+	Time Time `json:"Time"`
+	// documents modified later than WindowInSec seconds after Time are
+	// not reverted, as they are likely not affected by what the revert fixes
+	WindowInSec int64 `json:"WindowInSec"`
+	// limits the revert to given collections. Empty means all collections
+	Collections []string `json:"Collections,omitempty"`
+}
+
+// RevertRevisionsOperation reverts documents to their revisions at a point in
+// time. It's meant for recovering from unwanted changes and should be sent
+// with MaintenanceOperationExecutor.SendAsync because it runs in the background
+type RevertRevisionsOperation struct {
+	request *RevertRevisionsRequest
+	Command *RevertRevisionsCommand
+}
+
+// NewRevertRevisionsOperation returns an operation reverting documents
+// of collections (all if empty) to their state at pointInTime
+//
+// Deprecated: use operations.NewRevertRevisionsOperation.
+func NewRevertRevisionsOperation(pointInTime time.Time, window time.Duration, collections ...string) *RevertRevisionsOperation {
+	return &RevertRevisionsOperation{
+		request: &RevertRevisionsRequest{
+			Time:        Time(pointInTime.UTC()),
+			WindowInSec: int64(window / time.Second),
+			Collections: collections,
+		},
+	}
+}
+
+// GetCommand returns new RavenCommand for this operation
+func (o *RevertRevisionsOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	if o.request.WindowInSec < 0 {
+		return nil, newIllegalArgumentError("window cannot be negative")
+	}
+	o.Command = NewRevertRevisionsCommand(o.request)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &RevertRevisionsCommand{}
+
+// RevertRevisionsCommand is a command for RevertRevisionsOperation
+type RevertRevisionsCommand struct {
+	RavenCommandBase
+
+	request *RevertRevisionsRequest
+
+	Result *OperationIDResult
+}
+
+// NewRevertRevisionsCommand returns new RevertRevisionsCommand
+func NewRevertRevisionsCommand(request *RevertRevisionsRequest) *RevertRevisionsCommand {
+	return &RevertRevisionsCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		request: request,
+	}
+}
+
+func (c *RevertRevisionsCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/revisions/revert"
+
+	d, err := jsonMarshal(c.request)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *RevertRevisionsCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
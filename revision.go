@@ -1,7 +1,46 @@
 package ravendb
 
-// Revision describes a revision
+// Revision describes a revision received by a revisions subscription.
+// Previous is nil for a newly created document and Current is nil
+// for a deleted document
 type Revision struct {
 	Previous interface{}
 	Current  interface{}
 }
+
+// GetPrevious sets result to the previous version of the document.
+// result should be a pointer to a variable of the type the subscription
+// worker was created with e.g. **User.
+// Returns false if there is no previous version
+func (r *Revision) GetPrevious(result interface{}) (bool, error) {
+	return getRevisionValue(r.Previous, result)
+}
+
+// GetCurrent sets result to the current version of the document.
+// result should be a pointer to a variable of the type the subscription
+// worker was created with e.g. **User.
+// Returns false if there is no current version
+func (r *Revision) GetCurrent(result interface{}) (bool, error) {
+	return getRevisionValue(r.Current, result)
+}
+
+func getRevisionValue(v interface{}, result interface{}) (bool, error) {
+	if v == nil {
+		return false, nil
+	}
+	if res, ok := result.(*map[string]interface{}); ok {
+		doc, ok := v.(map[string]interface{})
+		if !ok {
+			return false, newIllegalArgumentError("revision is %T and can't be assigned to map[string]interface{}", v)
+		}
+		*res = doc
+		return true, nil
+	}
+	if err := checkValidLoadArg(result, "result"); err != nil {
+		return false, err
+	}
+	if err := setInterfaceToValue(result, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
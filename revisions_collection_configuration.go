@@ -9,4 +9,7 @@ type RevisionsCollectionConfiguration struct {
 	MinimumRevisionAgeToKeep *Duration `json:"MinimumRevisionAgeToKeep"`
 	Disabled                 bool      `json:"Disabled"`
 	PurgeOnDelete            bool      `json:"PurgeOnDelete"`
+	// MaximumRevisionsToDeleteUponDocumentUpdate limits how many old revisions
+	// are deleted per document update. 0 means no limit
+	MaximumRevisionsToDeleteUponDocumentUpdate int64 `json:"MaximumRevisionsToDeleteUponDocumentUpdate,omitempty"`
 }
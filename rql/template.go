@@ -0,0 +1,200 @@
+/*
+Package rql provides templates of RQL queries with named parameters.
+
+A template is an RQL query with $name placeholders, parsed once and reused.
+Values are always sent as query parameters, never interpolated into the query
+text, so they can't change the meaning of the query:
+
+	byName := rql.MustNew("from Users where name = $name and age > $minAge")
+
+	q, err := byName.RawQuery(session, ravendb.Parameters{
+		"name":   "John",
+		"minAge": 18,
+	})
+*/
+package rql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+)
+
+// Template is a parsed RQL query with named $parameters.
+// It's safe for concurrent use
+type Template struct {
+	query string
+	// names of placeholders in order of first use
+	names []string
+}
+
+// New parses query and returns a template
+func New(query string) (*Template, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("rql: query cannot be empty")
+	}
+	names, err := parsePlaceholders(query)
+	if err != nil {
+		return nil, err
+	}
+	return &Template{
+		query: query,
+		names: names,
+	}, nil
+}
+
+// MustNew is like New but panics on error. Meant for templates
+// initialized in global variables
+func MustNew(query string) *Template {
+	t, err := New(query)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Query returns text of the query
+func (t *Template) Query() string {
+	return t.query
+}
+
+// Parameters returns names of placeholders used in the query
+func (t *Template) Parameters() []string {
+	return append([]string(nil), t.names...)
+}
+
+// Bind validates that params provide values for all placeholders
+// and nothing else, and returns a copy of params
+func (t *Template) Bind(params ravendb.Parameters) (ravendb.Parameters, error) {
+	var missing []string
+	for _, name := range t.names {
+		if _, ok := params[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("rql: missing values for parameters %s", strings.Join(missing, ", "))
+	}
+
+	used := map[string]bool{}
+	for _, name := range t.names {
+		used[name] = true
+	}
+	var unused []string
+	res := ravendb.Parameters{}
+	for name, value := range params {
+		if !used[name] {
+			unused = append(unused, name)
+			continue
+		}
+		res[name] = value
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return nil, fmt.Errorf("rql: parameters %s are not used in the query", strings.Join(unused, ", "))
+	}
+	return res, nil
+}
+
+// RawQuery returns a raw query for the template with params bound
+func (t *Template) RawQuery(session *ravendb.DocumentSession, params ravendb.Parameters) (*ravendb.RawDocumentQuery, error) {
+	if session == nil {
+		return nil, fmt.Errorf("rql: session cannot be nil")
+	}
+	bound, err := t.Bind(params)
+	if err != nil {
+		return nil, err
+	}
+	q := session.RawQuery(t.query)
+	// sorted for deterministic parameter order
+	for _, name := range sortedKeys(bound) {
+		q = q.AddParameter(name, bound[name])
+	}
+	return q, nil
+}
+
+func sortedKeys(m ravendb.Parameters) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
+
+// parsePlaceholders returns names of $placeholders in query, skipping
+// string literals and comments
+func parsePlaceholders(query string) ([]string, error) {
+	var names []string
+	seen := map[string]bool{}
+	n := len(query)
+	for i := 0; i < n; i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			end := skipString(query, i)
+			if end < 0 {
+				return nil, fmt.Errorf("rql: unterminated string literal at offset %d", i)
+			}
+			i = end
+		case c == '/' && i+1 < n && query[i+1] == '/':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				return names, nil
+			}
+			i += end
+		case c == '/' && i+1 < n && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("rql: unterminated comment at offset %d", i)
+			}
+			i += end + 3
+		case c == '$':
+			start := i + 1
+			if start >= n || !isIdentStart(query[start]) {
+				return nil, fmt.Errorf("rql: invalid placeholder at offset %d", i)
+			}
+			end := start
+			for end < n && isIdentChar(query[end]) {
+				end++
+			}
+			name := query[start:end]
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+			i = end - 1
+		}
+	}
+	return names, nil
+}
+
+// skipString returns index of the quote closing a string literal starting
+// at start or -1 if it's not terminated. Quotes are escaped with a backslash
+// or by doubling them
+func skipString(query string, start int) int {
+	quote := query[start]
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,60 @@
+package rql
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateParameters(t *testing.T) {
+	tests := []struct {
+		query string
+		exp   []string
+	}{
+		{"from Users", nil},
+		{"from Users where name = $name and age > $minAge or name = $name", []string{"name", "minAge"}},
+		// placeholders in strings and comments are not placeholders
+		{"from Users where name = '$notParam' and lastName = \"it''s $no\" and age = $age", []string{"age"}},
+		{"from Users where name = 'it\\'s $no' // $comment\nand age = $age /* $comment */", []string{"age"}},
+		{"from Users where name = $p_1", []string{"p_1"}},
+	}
+	for _, test := range tests {
+		tpl, err := New(test.query)
+		assert.NoError(t, err, "query: %s", test.query)
+		if err == nil {
+			assert.Equal(t, test.exp, tpl.names, "query: %s", test.query)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"from Users where name = $",
+		"from Users where name = $1",
+		"from Users where name = 'unterminated",
+		"from Users /* unterminated",
+	}
+	for _, query := range invalid {
+		_, err := New(query)
+		assert.Error(t, err, "query: %s", query)
+	}
+}
+
+func TestTemplateBind(t *testing.T) {
+	tpl := MustNew("from Users where name = $name and age > $minAge")
+	assert.Equal(t, []string{"name", "minAge"}, tpl.Parameters())
+
+	params, err := tpl.Bind(ravendb.Parameters{"name": "John", "minAge": 18})
+	assert.NoError(t, err)
+	assert.Equal(t, ravendb.Parameters{"name": "John", "minAge": 18}, params)
+
+	_, err = tpl.Bind(ravendb.Parameters{"name": "John"})
+	assert.EqualError(t, err, "rql: missing values for parameters minAge")
+
+	_, err = tpl.Bind(ravendb.Parameters{"name": "John", "minAge": 18, "maxAge": 30, "city": "NY"})
+	assert.EqualError(t, err, "rql: parameters city, maxAge are not used in the query")
+
+	assert.Panics(t, func() {
+		MustNew("from Users where name = $")
+	})
+}
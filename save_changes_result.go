@@ -0,0 +1,31 @@
+package ravendb
+
+import "time"
+
+// SavedDocument describes a document stored or patched by SaveChanges,
+// with metadata assigned to it by the server
+type SavedDocument struct {
+	ID           string
+	ChangeVector string
+	// zero if the server didn't send it
+	LastModified time.Time
+	Entity       interface{}
+}
+
+// SaveChangesResult describes documents stored by SaveChanges,
+// in the order they were sent to the server, followed by patched
+// documents tracked by the session
+type SaveChangesResult struct {
+	Documents []*SavedDocument
+}
+
+// GetDocument returns information about a stored or patched entity or nil
+// if the entity wasn't saved
+func (r *SaveChangesResult) GetDocument(entity interface{}) *SavedDocument {
+	for _, doc := range r.Documents {
+		if doc.Entity == entity {
+			return doc
+		}
+	}
+	return nil
+}
@@ -39,7 +39,7 @@ func (c *SeedIdentityForCommand) CreateRequest(node *ServerNode) (*http.Request,
 		return nil, err
 	}
 
-	url := node.URL + "/databases/" + node.Database + "/identity/seed?name=" + urlEncode(c.id) + "&value=" + i64toa(c.value)
+	url := node.URL + "/databases/" + node.Database + "/identity/seed?name=" + urlUtilsEscapeDataString(c.id) + "&value=" + i64toa(c.value)
 
 	if c.forced {
 		url += "&force=true"
@@ -0,0 +1,40 @@
+package ravendb
+
+var (
+	_ IMaintenanceOperation = &SeedIdentityForOperation{}
+)
+
+// SeedIdentityForOperation sets server identity for a given name to value.
+// Unless forced, the identity is only changed if value is bigger than the
+// current value. The resulting value is in Command.Result
+type SeedIdentityForOperation struct {
+	identityName  string
+	identityValue int64
+	forceUpdate   bool
+
+	Command *SeedIdentityForCommand
+}
+
+// NewSeedIdentityForOperation returns new SeedIdentityForOperation
+//
+// Deprecated: use operations.NewSeedIdentityForOperation.
+func NewSeedIdentityForOperation(name string, value int64, forceUpdate bool) (*SeedIdentityForOperation, error) {
+	if stringIsBlank(name) {
+		return nil, newIllegalArgumentError("The field name cannot be null or whitespace.")
+	}
+	return &SeedIdentityForOperation{
+		identityName:  name,
+		identityValue: value,
+		forceUpdate:   forceUpdate,
+	}, nil
+}
+
+// GetCommand returns new RavenCommand for this operation
+func (o *SeedIdentityForOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewSeedIdentityForCommand(o.identityName, o.identityValue, o.forceUpdate)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
@@ -1,9 +1,12 @@
 package ravendb
 
+import "context"
+
 type ServerOperationExecutor struct {
 	requestExecutor *ClusterRequestExecutor
 }
 
+// Deprecated: use operations.NewServerOperationExecutor.
 func NewServerOperationExecutor(store *DocumentStore) *ServerOperationExecutor {
 	res := &ServerOperationExecutor{}
 	urls := store.GetUrls()
@@ -23,20 +26,31 @@ func NewServerOperationExecutor(store *DocumentStore) *ServerOperationExecutor {
 }
 
 func (e *ServerOperationExecutor) Send(operation IServerOperation) error {
+	return e.SendWithContext(context.Background(), operation)
+}
+
+// SendWithContext is like Send but gives up when ctx is cancelled
+func (e *ServerOperationExecutor) SendWithContext(ctx context.Context, operation IServerOperation) error {
 	command, err := operation.GetCommand(e.requestExecutor.GetConventions())
 	if err != nil {
 		return err
 	}
-	return e.requestExecutor.ExecuteCommand(command, nil)
+	return e.requestExecutor.ExecuteCommandWithContext(ctx, command, nil)
 }
 
 func (e *ServerOperationExecutor) SendAsync(operation IServerOperation) (*Operation, error) {
+	return e.SendAsyncWithContext(context.Background(), operation)
+}
+
+// SendAsyncWithContext is like SendAsync but gives up when ctx is cancelled.
+// ctx only applies to starting the operation, not to waiting for its completion
+func (e *ServerOperationExecutor) SendAsyncWithContext(ctx context.Context, operation IServerOperation) (*Operation, error) {
 	requestExecutor := e.requestExecutor
 	command, err := operation.GetCommand(requestExecutor.GetConventions())
 	if err != nil {
 		return nil, err
 	}
-	if err = requestExecutor.ExecuteCommand(command, nil); err != nil {
+	if err = requestExecutor.ExecuteCommandWithContext(ctx, command, nil); err != nil {
 		return nil, err
 	}
 	result := getCommandOperationIDResult(command)
@@ -2,6 +2,7 @@ package ravendb
 
 // Note: for simplicity, ServerWideOperation is folded into Operation
 
+// Deprecated: use operations.NewServerWideOperation.
 func NewServerWideOperation(requestExecutor *RequestExecutor, conventions *DocumentConventions, id int64) *Operation {
 	res := NewOperation(requestExecutor, nil, conventions, id)
 	res.IsServerWide = true
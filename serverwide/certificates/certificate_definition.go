@@ -24,3 +24,16 @@ func (da DatabaseAccess) String() string {
 func (sa SecurityClearance) String() string {
 	return []string{"ClusterAdmin", "ClusterNode", "Operator", "ValidUser"}[sa]
 }
+
+// CertificateDefinition describes a certificate registered in the cluster
+type CertificateDefinition struct {
+	Name string `json:"Name"`
+	// base64 encoded public part of the certificate
+	Certificate       string `json:"Certificate"`
+	SecurityClearance string `json:"SecurityClearance"`
+	Thumbprint        string `json:"Thumbprint"`
+	NotAfter          string `json:"NotAfter"`
+	// Permissions maps database names to DatabaseAccess names
+	Permissions          map[string]string `json:"Permissions"`
+	PublicKeyPinningHash string            `json:"PublicKeyPinningHash"`
+}
@@ -0,0 +1,79 @@
+package certificates
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ravendb/ravendb-go-client"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// OperationCreateClientCertificate makes the server generate a new client
+// certificate. RawData is a zip archive with the certificate and its
+// private key, protected by Password if it's not empty
+type OperationCreateClientCertificate struct {
+	Name              string            `json:"Name"`
+	SecurityClearance string            `json:"SecurityClearance"`
+	Password          string            `json:"Password,omitempty"`
+	Permissions       map[string]string `json:"Permissions"`
+
+	RawData []byte `json:"-"`
+}
+
+func NewOperationCreateClientCertificate(name string, permissions map[string]string, clearance SecurityClearance, password string) *OperationCreateClientCertificate {
+	return &OperationCreateClientCertificate{
+		Name:              name,
+		SecurityClearance: clearance.String(),
+		Password:          password,
+		Permissions:       permissions,
+	}
+}
+
+func (operation *OperationCreateClientCertificate) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Name == "" {
+		return nil, errors.New("Name cannot be empty")
+	}
+	if operation.SecurityClearance == "" {
+		return nil, errors.New("SecurityClearance cannot be empty")
+	}
+	return &createClientCertificateCommand{
+		RaftCommandBase: ravendb.RaftCommandBase{
+			RavenCommandBase: ravendb.RavenCommandBase{
+				ResponseType: ravendb.RavenCommandResponseTypeRaw,
+			},
+		},
+		parent: operation,
+	}, nil
+}
+
+type createClientCertificateCommand struct {
+	ravendb.RaftCommandBase
+	parent *OperationCreateClientCertificate
+}
+
+func (c *createClientCertificateCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	raftUniqueRequestId, err := c.RaftCommandBase.RaftUniqueRequestId()
+	if err != nil {
+		return nil, err
+	}
+	url := node.URL + "/admin/certificates?raft-request-id=" + raftUniqueRequestId
+
+	body, err := json.Marshal(c.parent)
+	if err != nil {
+		return nil, err
+	}
+	return ravendb.NewHttpPost(url, body)
+}
+
+func (c *createClientCertificateCommand) SetResponseRaw(response *http.Response, stream io.Reader) error {
+	if stream == nil {
+		return errors.New("Response is invalid: certificate is missing")
+	}
+	data, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return err
+	}
+	c.parent.RawData = data
+	return nil
+}
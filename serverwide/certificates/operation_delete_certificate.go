@@ -0,0 +1,48 @@
+package certificates
+
+import (
+	"errors"
+	"github.com/ravendb/ravendb-go-client"
+	"net/http"
+	"net/url"
+)
+
+// OperationDeleteCertificate removes a certificate with a given thumbprint
+// from the cluster
+type OperationDeleteCertificate struct {
+	Thumbprint string
+}
+
+func NewOperationDeleteCertificate(thumbprint string) *OperationDeleteCertificate {
+	return &OperationDeleteCertificate{
+		Thumbprint: thumbprint,
+	}
+}
+
+func (operation *OperationDeleteCertificate) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Thumbprint == "" {
+		return nil, errors.New("Thumbprint cannot be empty")
+	}
+	return &deleteCertificateCommand{
+		RaftCommandBase: ravendb.RaftCommandBase{
+			RavenCommandBase: ravendb.RavenCommandBase{
+				ResponseType: ravendb.RavenCommandResponseTypeEmpty,
+			},
+		},
+		parent: operation,
+	}, nil
+}
+
+type deleteCertificateCommand struct {
+	ravendb.RaftCommandBase
+	parent *OperationDeleteCertificate
+}
+
+func (c *deleteCertificateCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	raftUniqueRequestId, err := c.RaftCommandBase.RaftUniqueRequestId()
+	if err != nil {
+		return nil, err
+	}
+	uri := node.URL + "/admin/certificates?thumbprint=" + url.QueryEscape(c.parent.Thumbprint) + "&raft-request-id=" + raftUniqueRequestId
+	return http.NewRequest(http.MethodDelete, uri, nil)
+}
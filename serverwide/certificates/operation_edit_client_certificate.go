@@ -0,0 +1,62 @@
+package certificates
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ravendb/ravendb-go-client"
+	"net/http"
+)
+
+// OperationEditClientCertificate changes name, security clearance
+// and permissions of a registered certificate
+type OperationEditClientCertificate struct {
+	Thumbprint        string            `json:"Thumbprint"`
+	Name              string            `json:"Name"`
+	SecurityClearance string            `json:"SecurityClearance"`
+	Permissions       map[string]string `json:"Permissions"`
+}
+
+func NewOperationEditClientCertificate(thumbprint string, name string, permissions map[string]string, clearance SecurityClearance) *OperationEditClientCertificate {
+	return &OperationEditClientCertificate{
+		Thumbprint:        thumbprint,
+		Name:              name,
+		SecurityClearance: clearance.String(),
+		Permissions:       permissions,
+	}
+}
+
+func (operation *OperationEditClientCertificate) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Thumbprint == "" {
+		return nil, errors.New("Thumbprint cannot be empty")
+	}
+	if operation.Name == "" {
+		return nil, errors.New("Name cannot be empty")
+	}
+	return &editClientCertificateCommand{
+		RaftCommandBase: ravendb.RaftCommandBase{
+			RavenCommandBase: ravendb.RavenCommandBase{
+				ResponseType: ravendb.RavenCommandResponseTypeEmpty,
+			},
+		},
+		parent: operation,
+	}, nil
+}
+
+type editClientCertificateCommand struct {
+	ravendb.RaftCommandBase
+	parent *OperationEditClientCertificate
+}
+
+func (c *editClientCertificateCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	raftUniqueRequestId, err := c.RaftCommandBase.RaftUniqueRequestId()
+	if err != nil {
+		return nil, err
+	}
+	url := node.URL + "/admin/certificates/edit?raft-request-id=" + raftUniqueRequestId
+
+	body, err := json.Marshal(c.parent)
+	if err != nil {
+		return nil, err
+	}
+	return ravendb.NewHttpPost(url, body)
+}
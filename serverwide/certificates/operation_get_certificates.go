@@ -0,0 +1,77 @@
+package certificates
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ravendb/ravendb-go-client"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// OperationGetCertificates returns certificates registered in the cluster.
+// If Thumbprint is set, only the certificate with that thumbprint is returned
+type OperationGetCertificates struct {
+	Start      int    `json:"-"`
+	PageSize   int    `json:"-"`
+	Thumbprint string `json:"-"`
+
+	Results []*CertificateDefinition `json:"Results"`
+}
+
+func NewOperationGetCertificates(start int, pageSize int) *OperationGetCertificates {
+	return &OperationGetCertificates{
+		Start:    start,
+		PageSize: pageSize,
+	}
+}
+
+func NewOperationGetCertificate(thumbprint string) *OperationGetCertificates {
+	return &OperationGetCertificates{
+		Thumbprint: thumbprint,
+	}
+}
+
+func (operation *OperationGetCertificates) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Start < 0 || operation.PageSize < 0 {
+		return nil, errors.New("Start and PageSize cannot be negative")
+	}
+	operation.Results = nil
+	return &getCertificatesCommand{
+		RavenCommandBase: ravendb.RavenCommandBase{
+			ResponseType: ravendb.RavenCommandResponseTypeObject,
+		},
+		parent: operation,
+	}, nil
+}
+
+type getCertificatesCommand struct {
+	ravendb.RavenCommandBase
+	parent *OperationGetCertificates
+}
+
+func (c *getCertificatesCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	base, err := url.Parse(node.URL + "/admin/certificates")
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	if c.parent.Thumbprint != "" {
+		params.Add("thumbprint", c.parent.Thumbprint)
+	} else {
+		params.Add("start", strconv.Itoa(c.parent.Start))
+		if c.parent.PageSize > 0 {
+			params.Add("pageSize", strconv.Itoa(c.parent.PageSize))
+		}
+	}
+	base.RawQuery = params.Encode()
+
+	return http.NewRequest(http.MethodGet, base.String(), nil)
+}
+
+func (c *getCertificatesCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
+	return json.Unmarshal(response, c.parent)
+}
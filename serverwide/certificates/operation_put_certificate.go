@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"github.com/ravendb/ravendb-go-client"
 	"net/http"
 )
 
+// OperationPutCertificate registers an existing client certificate
+// (public part only) in the cluster
 type OperationPutCertificate struct {
 	CertName          string            `json:"CertName,omitempty"`
 	CertBytes         []byte            `json:"CertBytes,omitempty"`
@@ -16,6 +19,12 @@ type OperationPutCertificate struct {
 }
 
 func (operation *OperationPutCertificate) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.CertName == "" {
+		return nil, errors.New("CertName cannot be empty")
+	}
+	if len(operation.CertBytes) == 0 {
+		return nil, errors.New("CertBytes cannot be empty")
+	}
 	return &putCertificateCommand{
 		RaftCommandBase: ravendb.RaftCommandBase{
 			RavenCommandBase: ravendb.RavenCommandBase{
@@ -60,5 +69,8 @@ func (c *putCertificateCommand) CreateRequest(node *ravendb.ServerNode) (*http.R
 }
 
 func (c *putCertificateCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
 	return json.Unmarshal(response, c.parent)
 }
@@ -2,14 +2,16 @@ package operations
 
 import (
 	"encoding/json"
-	"fmt"
+	"errors"
 	"github.com/ravendb/ravendb-go-client"
 	"net/http"
-	"net/url"
+	urlpkg "net/url"
 	"strconv"
 	"strings"
 )
 
+// OperationAddClusterNode adds a server to the cluster as a member
+// or, if Watcher is true, as a watcher. Tag is optional
 type OperationAddClusterNode struct {
 	Url     string `json:"Url"`
 	Tag     string `json:"Tag"`
@@ -17,6 +19,9 @@ type OperationAddClusterNode struct {
 }
 
 func (operation *OperationAddClusterNode) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Url == "" {
+		return nil, errors.New("Url cannot be empty")
+	}
 	return &addNodeCommand{
 		RaftCommandBase: ravendb.RaftCommandBase{
 			RavenCommandBase: ravendb.RavenCommandBase{
@@ -33,14 +38,17 @@ type addNodeCommand struct {
 }
 
 func (c *addNodeCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
-	url := node.URL + "/admin/cluster/node?url=" + url.QueryEscape(c.parent.Url) + "&watcher=" + strconv.FormatBool(c.parent.Watcher)
+	url := node.URL + "/admin/cluster/node?url=" + urlpkg.QueryEscape(c.parent.Url) + "&watcher=" + strconv.FormatBool(c.parent.Watcher)
 
-	if len(strings.TrimSpace(c.parent.Tag)) == 0 {
-		url += fmt.Sprintf("&tag=%s", c.parent.Tag)
+	if tag := strings.TrimSpace(c.parent.Tag); tag != "" {
+		url += "&tag=" + urlpkg.QueryEscape(tag)
 	}
 	return http.NewRequest(http.MethodPut, url, nil)
 }
 
 func (c *addNodeCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
 	return json.Unmarshal(response, c.parent)
 }
@@ -2,17 +2,33 @@ package operations
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/ravendb/ravendb-go-client"
 	"net/http"
 	"net/url"
 )
 
+// OperationAddDatabaseNode adds a node to the database group.
+// If Node is empty, the server picks the node
 type OperationAddDatabaseNode struct {
 	Name string `json:"Name"`
-	Node string `json:"Node"`
+	Node string `json:"-"`
+
+	RaftCommandIndex int64    `json:"RaftCommandIndex"`
+	NodesAddedTo     []string `json:"NodesAddedTo"`
+}
+
+func NewOperationAddDatabaseNode(databaseName string, node string) *OperationAddDatabaseNode {
+	return &OperationAddDatabaseNode{
+		Name: databaseName,
+		Node: node,
+	}
 }
 
 func (operation *OperationAddDatabaseNode) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Name == "" {
+		return nil, errors.New("database name cannot be empty")
+	}
 	return &addDatabaseOperation{
 		RaftCommandBase: ravendb.RaftCommandBase{
 			RavenCommandBase: ravendb.RavenCommandBase{
@@ -29,13 +45,15 @@ type addDatabaseOperation struct {
 }
 
 func (o *addDatabaseOperation) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
-	base, err := url.Parse(node.URL + "/admin/databases/node?name=")
+	base, err := url.Parse(node.URL + "/admin/databases/node")
 	if err != nil {
 		return nil, err
 	}
 	params := url.Values{}
 	params.Add("name", o.parent.Name)
-	params.Add("node", o.parent.Node)
+	if o.parent.Node != "" {
+		params.Add("node", o.parent.Node)
+	}
 	base.RawQuery = params.Encode()
 
 	return http.NewRequest(http.MethodPut, base.String(), nil)
@@ -0,0 +1,32 @@
+package operations
+
+import (
+	"github.com/ravendb/ravendb-go-client"
+	"net/http"
+)
+
+// OperationForceLeaderElection makes the cluster elect a new leader.
+// The current leader steps down and doesn't vote for itself
+type OperationForceLeaderElection struct {
+}
+
+func NewOperationForceLeaderElection() *OperationForceLeaderElection {
+	return &OperationForceLeaderElection{}
+}
+
+func (operation *OperationForceLeaderElection) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	return &forceLeaderElectionCommand{
+		RavenCommandBase: ravendb.RavenCommandBase{
+			ResponseType: ravendb.RavenCommandResponseTypeEmpty,
+		},
+	}, nil
+}
+
+type forceLeaderElectionCommand struct {
+	ravendb.RavenCommandBase
+}
+
+func (c *forceLeaderElectionCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	url := node.URL + "/admin/cluster/reelect"
+	return http.NewRequest(http.MethodPost, url, nil)
+}
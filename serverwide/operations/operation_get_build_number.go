@@ -2,10 +2,14 @@ package operations
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/ravendb/ravendb-go-client"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
+// OperationGetBuildNumber returns version of the server
 type OperationGetBuildNumber struct {
 	BuildVersion   int    `json:"BuildVersion"`
 	ProductVersion string `json:"ProductVersion"`
@@ -16,12 +20,55 @@ type OperationGetBuildNumber struct {
 func (operation *OperationGetBuildNumber) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
 	return &getBuildNumber{
 		RavenCommandBase: ravendb.RavenCommandBase{
-			ResponseType: ravendb.RavenCommandResponseTypeObject,
+			ResponseType:  ravendb.RavenCommandResponseTypeObject,
+			IsReadRequest: true,
 		},
 		parent: operation,
 	}, nil
 }
 
+// IsAtLeast returns true if FullVersion of the server is equal to or
+// newer than version e.g. "5.2" or "5.4.100"
+func (operation *OperationGetBuildNumber) IsAtLeast(version string) (bool, error) {
+	if operation.FullVersion == "" {
+		return false, errors.New("FullVersion is empty, the operation must be executed first")
+	}
+	want, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	have, err := parseVersion(operation.FullVersion)
+	if err != nil {
+		return false, err
+	}
+	for i, n := range want {
+		if i >= len(have) {
+			return n == 0, nil
+		}
+		if have[i] != n {
+			return have[i] > n, nil
+		}
+	}
+	return true, nil
+}
+
+// parseVersion parses numeric parts of a version like "5.4.107" or "5.4.107-nightly"
+func parseVersion(version string) ([]int, error) {
+	if i := strings.IndexAny(version, "-+ "); i >= 0 {
+		version = version[:i]
+	}
+	parts := strings.Split(version, ".")
+	res := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, errors.New("invalid version '" + version + "'")
+		}
+		res[i] = n
+	}
+	return res, nil
+}
+
 type getBuildNumber struct {
 	ravendb.RavenCommandBase
 	parent *OperationGetBuildNumber
@@ -33,5 +80,8 @@ func (c *getBuildNumber) CreateRequest(node *ravendb.ServerNode) (*http.Request,
 }
 
 func (c *getBuildNumber) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return errors.New("Response is invalid")
+	}
 	return json.Unmarshal(response, c.parent)
 }
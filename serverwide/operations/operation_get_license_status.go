@@ -0,0 +1,59 @@
+package operations
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ravendb/ravendb-go-client"
+	"net/http"
+)
+
+// OperationGetLicenseStatus returns license of the server
+type OperationGetLicenseStatus struct {
+	ID                   string `json:"Id"`
+	LicensedTo           string `json:"LicensedTo"`
+	Status               string `json:"Status"`
+	Type                 string `json:"Type"`
+	Expired              bool   `json:"Expired"`
+	Expiration           string `json:"Expiration"`
+	FirstServerStartDate string `json:"FirstServerStartDate"`
+	ErrorMessage         string `json:"ErrorMessage"`
+	MaxCores             int    `json:"MaxCores"`
+	MaxMemory            int    `json:"MaxMemory"`
+	MaxClusterSize       int    `json:"MaxClusterSize"`
+	// Attributes describe features enabled by the license e.g.
+	// "externalReplication" or "ravenEtl"
+	Attributes map[string]interface{} `json:"Attributes"`
+}
+
+func (operation *OperationGetLicenseStatus) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	return &getLicenseStatus{
+		RavenCommandBase: ravendb.RavenCommandBase{
+			ResponseType:  ravendb.RavenCommandResponseTypeObject,
+			IsReadRequest: true,
+		},
+		parent: operation,
+	}, nil
+}
+
+// HasFeature returns true if the license enables a feature with a given attribute name
+func (operation *OperationGetLicenseStatus) HasFeature(name string) bool {
+	enabled, ok := operation.Attributes[name].(bool)
+	return ok && enabled
+}
+
+type getLicenseStatus struct {
+	ravendb.RavenCommandBase
+	parent *OperationGetLicenseStatus
+}
+
+func (c *getLicenseStatus) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	url := node.URL + "/license/status"
+	return http.NewRequest(http.MethodGet, url, nil)
+}
+
+func (c *getLicenseStatus) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return errors.New("Response is invalid")
+	}
+	return json.Unmarshal(response, c.parent)
+}
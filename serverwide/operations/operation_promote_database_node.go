@@ -0,0 +1,64 @@
+package operations
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ravendb/ravendb-go-client"
+	"net/http"
+	"net/url"
+)
+
+// OperationPromoteDatabaseNode promotes a database node from promotable to member
+// of the database group
+type OperationPromoteDatabaseNode struct {
+	Name string `json:"Name"`
+	Node string `json:"Node"`
+
+	RaftCommandIndex int64 `json:"RaftCommandIndex"`
+}
+
+func NewOperationPromoteDatabaseNode(databaseName string, node string) *OperationPromoteDatabaseNode {
+	return &OperationPromoteDatabaseNode{
+		Name: databaseName,
+		Node: node,
+	}
+}
+
+func (operation *OperationPromoteDatabaseNode) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Name == "" {
+		return nil, errors.New("database name cannot be empty")
+	}
+	if operation.Node == "" {
+		return nil, errors.New("node cannot be empty")
+	}
+	return &promoteDatabaseNodeCommand{
+		RaftCommandBase: ravendb.RaftCommandBase{
+			RavenCommandBase: ravendb.RavenCommandBase{
+				ResponseType: ravendb.RavenCommandResponseTypeObject,
+			},
+		},
+		parent: operation,
+	}, nil
+}
+
+type promoteDatabaseNodeCommand struct {
+	ravendb.RaftCommandBase
+	parent *OperationPromoteDatabaseNode
+}
+
+func (c *promoteDatabaseNodeCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	base, err := url.Parse(node.URL + "/admin/databases/promote")
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Add("name", c.parent.Name)
+	params.Add("node", c.parent.Node)
+	base.RawQuery = params.Encode()
+
+	return http.NewRequest(http.MethodPost, base.String(), nil)
+}
+
+func (c *promoteDatabaseNodeCommand) SetResponse(response []byte, fromCache bool) error {
+	return json.Unmarshal(response, c.parent)
+}
@@ -2,15 +2,26 @@ package operations
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/ravendb/ravendb-go-client"
 	"net/http"
+	"net/url"
 )
 
+// RemoveClusterNode removes a node with a given Tag from the cluster
 type RemoveClusterNode struct {
 	Node string `json:"Node"`
 	Tag  string `json:"Tag"`
 }
 
+// NewRemoveClusterNode returns an operation that removes a node with a given tag
+func NewRemoveClusterNode(tag string) *RemoveClusterNode {
+	return &RemoveClusterNode{
+		Tag: tag,
+	}
+}
+
+// Deprecated: returns a promote operation, use NewRemoveClusterNode
 func NewRemovePromoteClusterNode(node string) *OperationPromoteClusterNode {
 	return &OperationPromoteClusterNode{
 		Node: node,
@@ -18,6 +29,9 @@ func NewRemovePromoteClusterNode(node string) *OperationPromoteClusterNode {
 }
 
 func (operation *RemoveClusterNode) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Tag == "" {
+		return nil, errors.New("Tag cannot be empty")
+	}
 	return &removeNodeCommand{
 		RaftCommandBase: ravendb.RaftCommandBase{
 			RavenCommandBase: ravendb.RavenCommandBase{
@@ -34,9 +48,13 @@ type removeNodeCommand struct {
 }
 
 func (c *removeNodeCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
-	url := node.URL + "/admin/cluster/node?nodeTag=" + c.parent.Tag
+	url := node.URL + "/admin/cluster/node?nodeTag=" + url.QueryEscape(c.parent.Tag)
 	return http.NewRequest(http.MethodDelete, url, nil)
 }
+
 func (c *removeNodeCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
 	return json.Unmarshal(response, c.parent)
 }
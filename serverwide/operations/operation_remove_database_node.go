@@ -0,0 +1,65 @@
+package operations
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/ravendb/ravendb-go-client"
+	"net/http"
+)
+
+// OperationRemoveDatabaseNode removes a node from the database group,
+// deleting the database from that node
+type OperationRemoveDatabaseNode struct {
+	Name       string `json:"-"`
+	Node       string `json:"-"`
+	HardDelete bool   `json:"-"`
+
+	RaftCommandIndex int64    `json:"RaftCommandIndex"`
+	PendingDeletes   []string `json:"PendingDeletes"`
+}
+
+func NewOperationRemoveDatabaseNode(databaseName string, node string, hardDelete bool) *OperationRemoveDatabaseNode {
+	return &OperationRemoveDatabaseNode{
+		Name:       databaseName,
+		Node:       node,
+		HardDelete: hardDelete,
+	}
+}
+
+func (operation *OperationRemoveDatabaseNode) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	return &removeDatabaseNodeCommand{
+		RaftCommandBase: ravendb.RaftCommandBase{
+			RavenCommandBase: ravendb.RavenCommandBase{
+				ResponseType: ravendb.RavenCommandResponseTypeObject,
+			},
+		},
+		parent: operation,
+	}, nil
+}
+
+type removeDatabaseNodeCommand struct {
+	ravendb.RaftCommandBase
+	parent *OperationRemoveDatabaseNode
+}
+
+func (c *removeDatabaseNodeCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	parameters := &ravendb.DeleteDatabaseParameters{
+		DatabaseNames: []string{c.parent.Name},
+		HardDelete:    c.parent.HardDelete,
+		FromNodes:     []string{c.parent.Node},
+	}
+	body, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequest(http.MethodDelete, node.URL+"/admin/databases", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Content-Type", "application/json; charset=UTF-8")
+	return request, nil
+}
+
+func (c *removeDatabaseNodeCommand) SetResponse(response []byte, fromCache bool) error {
+	return json.Unmarshal(response, c.parent)
+}
@@ -0,0 +1,61 @@
+package operations
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ravendb/ravendb-go-client"
+	"net/http"
+	"net/url"
+)
+
+// OperationReorderDatabaseMembers changes the order of members of the database group.
+// MembersOrder must contain all current members, in desired order.
+type OperationReorderDatabaseMembers struct {
+	Name         string   `json:"-"`
+	MembersOrder []string `json:"MembersOrder"`
+}
+
+func NewOperationReorderDatabaseMembers(databaseName string, order []string) *OperationReorderDatabaseMembers {
+	return &OperationReorderDatabaseMembers{
+		Name:         databaseName,
+		MembersOrder: order,
+	}
+}
+
+func (operation *OperationReorderDatabaseMembers) GetCommand(conventions *ravendb.DocumentConventions) (ravendb.RavenCommand, error) {
+	if operation.Name == "" {
+		return nil, errors.New("database name cannot be empty")
+	}
+	if len(operation.MembersOrder) == 0 {
+		return nil, errors.New("MembersOrder cannot be empty")
+	}
+	return &reorderDatabaseMembersCommand{
+		RaftCommandBase: ravendb.RaftCommandBase{
+			RavenCommandBase: ravendb.RavenCommandBase{
+				ResponseType: ravendb.RavenCommandResponseTypeEmpty,
+			},
+		},
+		parent: operation,
+	}, nil
+}
+
+type reorderDatabaseMembersCommand struct {
+	ravendb.RaftCommandBase
+	parent *OperationReorderDatabaseMembers
+}
+
+func (c *reorderDatabaseMembersCommand) CreateRequest(node *ravendb.ServerNode) (*http.Request, error) {
+	base, err := url.Parse(node.URL + "/admin/databases/reorder")
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Add("name", c.parent.Name)
+	base.RawQuery = params.Encode()
+
+	body, err := json.Marshal(c.parent)
+	if err != nil {
+		return nil, err
+	}
+	return ravendb.NewHttpPost(base.String(), body)
+}
@@ -0,0 +1,229 @@
+package ravendb
+
+import (
+	"strings"
+)
+
+// countersCache holds values of counters of a document known to the session
+type countersCache struct {
+	// true if values has all counters of the document
+	gotAll bool
+	// nil value means the counter doesn't exist.
+	// Like on the server, counter names are case-insensitive
+	values map[string]*int64
+}
+
+func (c *countersCache) get(name string) (*int64, bool) {
+	if v, ok := c.values[name]; ok {
+		return v, true
+	}
+	for k, v := range c.values {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func (c *countersCache) set(name string, value *int64) {
+	for k := range c.values {
+		if k != name && strings.EqualFold(k, name) {
+			delete(c.values, k)
+		}
+	}
+	c.values[name] = value
+}
+
+// getAll returns values of counters that exist
+func (c *countersCache) getAll() map[string]int64 {
+	res := map[string]int64{}
+	if c == nil {
+		return res
+	}
+	for name, value := range c.values {
+		if value != nil {
+			res[name] = *value
+		}
+	}
+	return res
+}
+
+// SessionDocumentCounters reads and modifies counters of a document.
+// Values are cached by the session. Modifications are sent on SaveChanges
+type SessionDocumentCounters struct {
+	session *InMemoryDocumentSessionOperations
+	docID   string
+}
+
+// CountersFor returns counters of a document. entityOrID is either
+// a document id or an entity tracked by the session
+func (s *DocumentSession) CountersFor(entityOrID interface{}) (*SessionDocumentCounters, error) {
+	docID, ok := entityOrID.(string)
+	if !ok {
+		if err := checkValidEntityIn(entityOrID, "entityOrID"); err != nil {
+			return nil, err
+		}
+		document := getDocumentInfoByEntity(s.documentsByEntity, entityOrID)
+		if document == nil {
+			return nil, throwEntityNotInSession(entityOrID)
+		}
+		docID = document.id
+	}
+	if stringIsBlank(docID) {
+		return nil, newIllegalArgumentError("document id cannot be empty")
+	}
+	return &SessionDocumentCounters{
+		session: s.InMemoryDocumentSessionOperations,
+		docID:   docID,
+	}, nil
+}
+
+func (c *SessionDocumentCounters) isDocumentDeleted() bool {
+	s := c.session
+	if _, ok := s.deferredCommandsMap[newIDTypeAndName(c.docID, CommandDelete, "")]; ok {
+		return true
+	}
+	document := s.documentsByID.getValue(c.docID)
+	return document != nil && s.deletedEntities.contains(document.entity)
+}
+
+// deferOperation adds op to a deferred command for the document,
+// registering the command if it doesn't exist
+func (c *SessionDocumentCounters) deferOperation(op *CounterOperation, conflictingType CounterOperationType) error {
+	s := c.session
+	cmd, ok := s.deferredCommandsMap[newIDTypeAndName(c.docID, CommandCounters, "")].(*CountersBatchCommandData)
+	if !ok {
+		cmd, err := NewCountersBatchCommandData(c.docID, op)
+		if err != nil {
+			return err
+		}
+		s.Defer(cmd)
+		return nil
+	}
+	if cmd.hasOperation(conflictingType, op.CounterName) {
+		return newIllegalStateError("Can't %s counter %s of document %s, there is a deferred command registered to %s a counter with the same name.", strings.ToLower(string(op.Type)), op.CounterName, c.docID, strings.ToLower(string(conflictingType)))
+	}
+	cmd.counters.Operations = append(cmd.counters.Operations, op)
+	return nil
+}
+
+// Increment increments counter name by delta, creating the counter if needed
+func (c *SessionDocumentCounters) Increment(name string, delta int64) error {
+	if stringIsBlank(name) {
+		return newIllegalArgumentError("Counter name cannot be empty")
+	}
+	if c.isDocumentDeleted() {
+		return newIllegalStateError("Can't increment counter %s of document %s, the document was already deleted in this session", name, c.docID)
+	}
+	return c.deferOperation(NewCounterOperationIncrement(name, delta), CounterOperationTypeDelete)
+}
+
+// Delete deletes counter name
+func (c *SessionDocumentCounters) Delete(name string) error {
+	if stringIsBlank(name) {
+		return newIllegalArgumentError("Counter name cannot be empty")
+	}
+	if c.isDocumentDeleted() {
+		// deleting the document deletes its counters
+		return nil
+	}
+	if err := c.deferOperation(NewCounterOperationDelete(name), CounterOperationTypeIncrement); err != nil {
+		return err
+	}
+	c.session.getCountersCache(c.docID).set(name, nil)
+	return nil
+}
+
+// metadataCounterNames returns names of counters from metadata of
+// a document tracked by the session. ok is false if the document isn't tracked
+// or if counters were modified by the session, making the metadata outdated
+func (c *SessionDocumentCounters) metadataCounterNames() (names []string, ok bool) {
+	if c.session.countersByDocID[c.docID] != nil {
+		return nil, false
+	}
+	document := c.session.documentsByID.getValue(c.docID)
+	if document == nil {
+		return nil, false
+	}
+	counters, _ := document.metadata[MetadataCounters].([]interface{})
+	for _, v := range counters {
+		if name, ok := v.(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names, true
+}
+
+// Get returns value of counter name or nil if the counter doesn't exist
+func (c *SessionDocumentCounters) Get(name string) (*int64, error) {
+	if stringIsBlank(name) {
+		return nil, newIllegalArgumentError("Counter name cannot be empty")
+	}
+	s := c.session
+	if cache := s.countersByDocID[c.docID]; cache != nil {
+		if value, ok := cache.get(name); ok {
+			return value, nil
+		}
+		if cache.gotAll {
+			return nil, nil
+		}
+	}
+
+	// metadata of a tracked document has names of all its counters
+	if names, ok := c.metadataCounterNames(); ok {
+		exists := false
+		for _, counter := range names {
+			exists = exists || strings.EqualFold(counter, name)
+		}
+		if !exists {
+			return nil, nil
+		}
+	}
+
+	if err := s.incrementRequestCount(); err != nil {
+		return nil, err
+	}
+	op := NewGetCountersOperation(c.docID, []string{name}, false)
+	if err := s.GetOperations().Send(op, s.sessionInfo); err != nil {
+		return nil, err
+	}
+	var value *int64
+	if result := op.Command.Result; result != nil && len(result.Counters) > 0 && result.Counters[0] != nil {
+		v := result.Counters[0].TotalValue
+		value = &v
+	}
+	s.getCountersCache(c.docID).set(name, value)
+	return value, nil
+}
+
+// GetAll returns values of all counters of the document
+func (c *SessionDocumentCounters) GetAll() (map[string]int64, error) {
+	s := c.session
+	if cache := s.countersByDocID[c.docID]; cache != nil && cache.gotAll {
+		return cache.getAll(), nil
+	}
+
+	if names, ok := c.metadataCounterNames(); ok && len(names) == 0 {
+		return map[string]int64{}, nil
+	}
+
+	if err := s.incrementRequestCount(); err != nil {
+		return nil, err
+	}
+	op := NewGetCountersOperation(c.docID, nil, false)
+	if err := s.GetOperations().Send(op, s.sessionInfo); err != nil {
+		return nil, err
+	}
+	cache := s.getCountersCache(c.docID)
+	cache.gotAll = true
+	cache.values = map[string]*int64{}
+	if result := op.Command.Result; result != nil {
+		for _, counter := range result.Counters {
+			if counter != nil {
+				value := counter.TotalValue
+				cache.set(counter.CounterName, &value)
+			}
+		}
+	}
+	return cache.getAll(), nil
+}
@@ -0,0 +1,206 @@
+package ravendb
+
+import (
+	"time"
+)
+
+// SessionDocumentTimeSeries reads and modifies a time series of a document.
+// Entries read by the session are cached. Modifications are sent on SaveChanges
+type SessionDocumentTimeSeries struct {
+	session *InMemoryDocumentSessionOperations
+	docID   string
+	name    string
+}
+
+// TimeSeriesFor returns time series name of a document. entityOrID is either
+// a document id or an entity tracked by the session
+func (s *DocumentSession) TimeSeriesFor(entityOrID interface{}, name string) (*SessionDocumentTimeSeries, error) {
+	docID, ok := entityOrID.(string)
+	if !ok {
+		if err := checkValidEntityIn(entityOrID, "entityOrID"); err != nil {
+			return nil, err
+		}
+		document := getDocumentInfoByEntity(s.documentsByEntity, entityOrID)
+		if document == nil {
+			return nil, throwEntityNotInSession(entityOrID)
+		}
+		docID = document.id
+	}
+	if stringIsBlank(docID) {
+		return nil, newIllegalArgumentError("document id cannot be empty")
+	}
+	if stringIsBlank(name) {
+		return nil, newIllegalArgumentError("Time series name cannot be empty")
+	}
+	return &SessionDocumentTimeSeries{
+		session: s.InMemoryDocumentSessionOperations,
+		docID:   docID,
+		name:    name,
+	}, nil
+}
+
+func (ts *SessionDocumentTimeSeries) isDocumentDeleted() bool {
+	s := ts.session
+	if _, ok := s.deferredCommandsMap[newIDTypeAndName(ts.docID, CommandDelete, "")]; ok {
+		return true
+	}
+	document := s.documentsByID.getValue(ts.docID)
+	return document != nil && s.deletedEntities.contains(document.entity)
+}
+
+// deferredOperation returns the operation deferred for SaveChanges,
+// registering it if it doesn't exist
+func (ts *SessionDocumentTimeSeries) deferredOperation() (*TimeSeriesOperation, error) {
+	s := ts.session
+	cmd, ok := s.deferredCommandsMap[newIDTypeAndName(ts.docID, CommandTimeSeries, ts.name)].(*TimeSeriesBatchCommandData)
+	if ok {
+		return cmd.timeSeries, nil
+	}
+	op := NewTimeSeriesOperation(ts.name)
+	cmd, err := NewTimeSeriesBatchCommandData(ts.docID, op)
+	if err != nil {
+		return nil, err
+	}
+	s.Defer(cmd)
+	return op, nil
+}
+
+// Append appends an entry with values at timestamp. tag is optional
+func (ts *SessionDocumentTimeSeries) Append(timestamp time.Time, values []float64, tag string) error {
+	if len(values) == 0 {
+		return newIllegalArgumentError("values cannot be empty")
+	}
+	if IsIncrementalTimeSeries(ts.name) {
+		return newIllegalStateError("Can't append to incremental time series %s, use Increment instead", ts.name)
+	}
+	if ts.isDocumentDeleted() {
+		return newIllegalStateError("Can't append to time series %s of document %s, the document was already deleted in this session", ts.name, ts.docID)
+	}
+	op, err := ts.deferredOperation()
+	if err != nil {
+		return err
+	}
+	op.Append(timestamp, values, tag)
+	return nil
+}
+
+// Increment adds values to values of the entry at timestamp, creating the
+// entry if needed. It only works for incremental time series, whose names
+// start with TimeSeriesIncrementalPrefix
+func (ts *SessionDocumentTimeSeries) Increment(timestamp time.Time, values []float64) error {
+	if len(values) == 0 {
+		return newIllegalArgumentError("values cannot be empty")
+	}
+	if !IsIncrementalTimeSeries(ts.name) {
+		return newIllegalStateError("Can't increment time series %s, names of incremental time series must start with %s", ts.name, TimeSeriesIncrementalPrefix)
+	}
+	if ts.isDocumentDeleted() {
+		return newIllegalStateError("Can't increment time series %s of document %s, the document was already deleted in this session", ts.name, ts.docID)
+	}
+	op, err := ts.deferredOperation()
+	if err != nil {
+		return err
+	}
+	op.Increment(timestamp, values)
+	return nil
+}
+
+// Delete deletes entries between from and to (inclusive).
+// nil from or to means the range is unbounded
+func (ts *SessionDocumentTimeSeries) Delete(from *time.Time, to *time.Time) error {
+	if ts.isDocumentDeleted() {
+		// deleting the document deletes its time series
+		return nil
+	}
+	op, err := ts.deferredOperation()
+	if err != nil {
+		return err
+	}
+	op.Delete(from, to)
+	return nil
+}
+
+// timeSeriesRangeCovers returns true if range r has all entries between from and to
+func timeSeriesRangeCovers(r *TimeSeriesRangeResult, from *time.Time, to *time.Time) bool {
+	if r.From != nil && (from == nil || from.Before(time.Time(*r.From))) {
+		return false
+	}
+	if r.To != nil && (to == nil || to.After(time.Time(*r.To))) {
+		return false
+	}
+	return true
+}
+
+// Get returns entries between from and to (inclusive), skipping start
+// entries and returning at most pageSize entries (0 means no limit).
+// nil from or to means the range is unbounded.
+// It returns nil if the time series doesn't exist
+func (ts *SessionDocumentTimeSeries) Get(from *time.Time, to *time.Time, start int, pageSize int) ([]*TimeSeriesEntry, error) {
+	if err := validateTimeSeriesPaging(start, pageSize); err != nil {
+		return nil, err
+	}
+	s := ts.session
+	for _, r := range s.timeSeriesByDocID[ts.docID][ts.name] {
+		if timeSeriesRangeCovers(r, from, to) {
+			return pageTimeSeriesEntries(r.Entries, from, to, start, pageSize), nil
+		}
+	}
+
+	if err := s.incrementRequestCount(); err != nil {
+		return nil, err
+	}
+	op := NewGetTimeSeriesOperation(ts.docID, ts.name, from, to, start, pageSize)
+	if err := s.GetOperations().Send(op, s.sessionInfo); err != nil {
+		return nil, err
+	}
+	result := op.Command.Result
+	if result == nil {
+		return nil, nil
+	}
+	// only a complete range can serve later reads
+	if start == 0 && pageSize == 0 {
+		r := *result
+		r.From, r.To = nil, nil
+		if from != nil {
+			t := Time(*from)
+			r.From = &t
+		}
+		if to != nil {
+			t := Time(*to)
+			r.To = &t
+		}
+		s.addTimeSeriesRanges(ts.docID, ts.name, &r)
+	}
+	return result.Entries, nil
+}
+
+// pageTimeSeriesEntries returns a page of entries between from and to
+func pageTimeSeriesEntries(entries []*TimeSeriesEntry, from *time.Time, to *time.Time, start int, pageSize int) []*TimeSeriesEntry {
+	var res []*TimeSeriesEntry
+	for _, e := range entries {
+		t := time.Time(e.Timestamp)
+		if (from != nil && t.Before(*from)) || (to != nil && t.After(*to)) {
+			continue
+		}
+		if start > 0 {
+			start--
+			continue
+		}
+		if pageSize > 0 && len(res) == pageSize {
+			break
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// TimeSeriesRollupFor returns the rollup series created by policy policyName
+// for raw time series rawName of a document. Rollup series are read-only,
+// entries have IsRollup set and several aggregated values per raw value
+func (s *DocumentSession) TimeSeriesRollupFor(entityOrID interface{}, rawName string, policyName string) (*SessionDocumentTimeSeries, error) {
+	if stringIsBlank(rawName) || stringIsBlank(policyName) {
+		return nil, newIllegalArgumentError("Time series and policy names cannot be empty")
+	}
+	policy := &TimeSeriesPolicy{Name: policyName}
+	return s.TimeSeriesFor(entityOrID, policy.GetTimeSeriesName(rawName))
+}
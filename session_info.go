@@ -1,6 +1,69 @@
 package ravendb
 
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strings"
+)
+
 // SessionInfo describes a session
 type SessionInfo struct {
 	SessionID int
+
+	// per-session caching overrides, see AdvancedSessionOperations.AggressivelyCacheFor
+	// and AdvancedSessionOperations.DisableCaching
+	aggressiveCaching *AggressiveCacheOptions
+	noCaching         bool
+
+	loadBalanceBehavior     LoadBalanceBehavior
+	loadBalancerContextSeed int
+	// true if the session has a context and LoadBalanceBehaviorUseSessionContext is used
+	canUseLoadBalanceBehavior bool
+	// true once SessionID was used to choose a node
+	sessionIDUsed bool
+}
+
+func newSessionInfo(sessionID int, conventions *DocumentConventions, dbName string) *SessionInfo {
+	res := &SessionInfo{
+		SessionID:               sessionID,
+		loadBalanceBehavior:     conventions.LoadBalanceBehavior,
+		loadBalancerContextSeed: conventions.LoadBalancerContextSeed,
+	}
+	if res.loadBalanceBehavior == LoadBalanceBehaviorUseSessionContext && conventions.LoadBalancerPerSessionContextSelector != nil {
+		if context := conventions.LoadBalancerPerSessionContextSelector(dbName); context != "" {
+			res.SessionID = res.sessionIDForContext(context)
+			res.canUseLoadBalanceBehavior = true
+		}
+	}
+	return res
+}
+
+// SetContext sets the context of the session. With LoadBalanceBehaviorUseSessionContext
+// all requests of sessions with the same context are sent to the same node.
+// It must be called before the session sends its first request
+func (i *SessionInfo) SetContext(sessionKey string) error {
+	if strings.TrimSpace(sessionKey) == "" {
+		return newIllegalArgumentError("sessionKey cannot be empty")
+	}
+	if i.sessionIDUsed {
+		return newIllegalStateError("Unable to set the session context after it has already been used. The session context can only be modified before it is utilized")
+	}
+	i.SessionID = i.sessionIDForContext(sessionKey)
+	i.canUseLoadBalanceBehavior = i.loadBalanceBehavior == LoadBalanceBehaviorUseSessionContext
+	return nil
+}
+
+func (i *SessionInfo) sessionIDForContext(context string) int {
+	var seed [8]byte
+	binary.LittleEndian.PutUint64(seed[:], uint64(i.loadBalancerContextSeed))
+	h := fnv.New64a()
+	_, _ = h.Write(seed[:])
+	_, _ = h.Write([]byte(context))
+	// keep it non-negative so it works as an index on 32-bit platforms too
+	return int(h.Sum64() & 0x7fffffff)
+}
+
+func (i *SessionInfo) getSessionID() int {
+	i.sessionIDUsed = true
+	return i.SessionID
 }
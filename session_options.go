@@ -4,4 +4,6 @@ package ravendb
 type SessionOptions struct {
 	Database        string
 	RequestExecutor *RequestExecutor
+	// TransactionMode defaults to TransactionModeSingleNode
+	TransactionMode TransactionMode
 }
@@ -7,11 +7,16 @@ import (
 
 var _ IVoidMaintenanceOperation = &SetIndexesLockOperation{}
 
+// SetIndexesLockOperation sets lock mode of indexes. Locked indexes are
+// protected from being redefined, e.g. by ExecuteIndex from an older deployment
 type SetIndexesLockOperation struct {
 	parameters *SetIndexesLockParameters
 	Command    *SetIndexesLockCommand
 }
 
+// NewSetIndexesLockOperation returns SetIndexesLockOperation for a single index
+//
+// Deprecated: use operations.NewSetIndexesLockOperation.
 func NewSetIndexesLockOperation(indexName string, mode IndexLockMode) (*SetIndexesLockOperation, error) {
 	if indexName == "" {
 		return nil, newIllegalArgumentError("indexName cannot be empty")
@@ -24,10 +29,22 @@ func NewSetIndexesLockOperation(indexName string, mode IndexLockMode) (*SetIndex
 	return NewSetIndexesLockOperationWithParameters(p)
 }
 
+// NewSetIndexesLockOperationWithParameters returns SetIndexesLockOperation
+// for multiple indexes
+//
+// Deprecated: use operations.NewSetIndexesLockOperationWithParameters.
 func NewSetIndexesLockOperationWithParameters(parameters *SetIndexesLockParameters) (*SetIndexesLockOperation, error) {
 	if parameters == nil {
 		return nil, newIllegalArgumentError("parameters cannot be nil")
 	}
+	if len(parameters.IndexNames) == 0 {
+		return nil, newIllegalArgumentError("IndexNames cannot be empty")
+	}
+	switch parameters.Mode {
+	case IndexLockModeUnlock, IndexLockModeLockedIgnore, IndexLockModeLockedError:
+	default:
+		return nil, newIllegalArgumentError("invalid lock mode '%s'", parameters.Mode)
+	}
 
 	res := &SetIndexesLockOperation{
 		parameters: parameters,
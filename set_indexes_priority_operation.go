@@ -13,6 +13,8 @@ type SetIndexesPriorityOperation struct {
 }
 
 // NewSetIndexesPriorityOperation returns new SetIndexesPriorityParameters
+//
+// Deprecated: use operations.NewSetIndexesPriorityOperation.
 func NewSetIndexesPriorityOperation(indexName string, priority IndexPriority) (*SetIndexesPriorityOperation, error) {
 	if indexName == "" {
 		return nil, newIllegalArgumentError("indexName cannot be empty")
@@ -22,8 +24,27 @@ func NewSetIndexesPriorityOperation(indexName string, priority IndexPriority) (*
 		IndexNames: []string{indexName},
 		Priority:   priority,
 	}
+	return NewSetIndexesPriorityOperationWithParameters(p)
+}
+
+// NewSetIndexesPriorityOperationWithParameters returns SetIndexesPriorityOperation
+// for multiple indexes
+//
+// Deprecated: use operations.NewSetIndexesPriorityOperationWithParameters.
+func NewSetIndexesPriorityOperationWithParameters(parameters *SetIndexesPriorityParameters) (*SetIndexesPriorityOperation, error) {
+	if parameters == nil {
+		return nil, newIllegalArgumentError("parameters cannot be nil")
+	}
+	if len(parameters.IndexNames) == 0 {
+		return nil, newIllegalArgumentError("IndexNames cannot be empty")
+	}
+	switch parameters.Priority {
+	case IndexPriorityLow, IndexPriorityNormal, IndexPriorityHigh:
+	default:
+		return nil, newIllegalArgumentError("invalid priority '%s'", parameters.Priority)
+	}
 	return &SetIndexesPriorityOperation{
-		parameters: p,
+		parameters: parameters,
 	}, nil
 }
 
@@ -61,7 +82,9 @@ func NewSetIndexesPriorityCommand(conventions *DocumentConventions, parameters *
 	// here as it's simpler and faster than two-step serialization,
 	// first to map[string]interface{} and then to JSON
 	d, err := jsonMarshal(parameters)
-	panicIf(err != nil, "jsonMarshal failed with %s", err)
+	if err != nil {
+		return nil, err
+	}
 	cmd := &SetIndexesPriorityCommand{
 		RavenCommandBase: NewRavenCommandBase(),
 
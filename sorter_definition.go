@@ -0,0 +1,8 @@
+package ravendb
+
+// SorterDefinition describes a custom sorter. Code is C# source of a class
+// deriving from Lucene.Net.Search.FieldComparator, compiled by the server
+type SorterDefinition struct {
+	Name string `json:"Name"`
+	Code string `json:"Code"`
+}
@@ -0,0 +1,25 @@
+package ravendb
+
+// SqlConnectionString represents connection string for a relational database,
+// used by SQL ETL.
+// Note: it doesn't embed ConnectionString because its ConnectionString
+// field would clash with the embedded struct
+type SqlConnectionString struct {
+	Name string               `json:"Name"`
+	Type ConnectionStringType `json:"Type"`
+	// ConnectionString is in the format expected by the database driver
+	ConnectionString string `json:"ConnectionString"`
+	// FactoryName is a name of ADO.NET provider, e.g. "System.Data.SqlClient"
+	// or "Npgsql"
+	FactoryName string `json:"FactoryName"`
+}
+
+// NewSqlConnectionString returns new SqlConnectionString
+func NewSqlConnectionString(name string, connectionString string, factoryName string) *SqlConnectionString {
+	return &SqlConnectionString{
+		Name:             name,
+		Type:             ConnectionStringTypeSQL,
+		ConnectionString: connectionString,
+		FactoryName:      factoryName,
+	}
+}
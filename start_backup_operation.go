@@ -0,0 +1,77 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var (
+	_ IMaintenanceOperation = &StartBackupOperation{}
+)
+
+// StartBackupOperationResult is a result of StartBackupOperation
+type StartBackupOperationResult struct {
+	ResponsibleNode string `json:"ResponsibleNode"`
+	OperationID     int64  `json:"OperationId"`
+}
+
+// StartBackupOperation runs a periodic backup task immediately.
+// Use MaintenanceOperationExecutor.SendAsync to wait for the backup
+// to finish
+type StartBackupOperation struct {
+	isFullBackup bool
+	taskID       int64
+
+	Command *StartBackupCommand
+}
+
+// NewStartBackupOperation returns new StartBackupOperation
+//
+// Deprecated: use operations.NewStartBackupOperation.
+func NewStartBackupOperation(isFullBackup bool, taskID int64) *StartBackupOperation {
+	return &StartBackupOperation{
+		isFullBackup: isFullBackup,
+		taskID:       taskID,
+	}
+}
+
+func (o *StartBackupOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewStartBackupCommand(o.isFullBackup, o.taskID)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &StartBackupCommand{}
+
+// StartBackupCommand describes "start backup" command
+type StartBackupCommand struct {
+	RavenCommandBase
+
+	isFullBackup bool
+	taskID       int64
+
+	Result *StartBackupOperationResult
+}
+
+// NewStartBackupCommand returns new StartBackupCommand
+func NewStartBackupCommand(isFullBackup bool, taskID int64) *StartBackupCommand {
+	return &StartBackupCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		isFullBackup: isFullBackup,
+		taskID:       taskID,
+	}
+}
+
+func (c *StartBackupCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/backup/database?isFullBackup=" + strconv.FormatBool(c.isFullBackup) + "&taskId=" + i64toa(c.taskID)
+
+	return NewHttpPost(url, nil)
+}
+
+func (c *StartBackupCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -6,15 +6,17 @@ import (
 
 var _ IVoidMaintenanceOperation = &StartIndexOperation{}
 
+// StartIndexOperation resumes indexing of an index stopped with StopIndexOperation
 type StartIndexOperation struct {
 	indexName string
 
 	Command *StartIndexCommand
 }
 
+// Deprecated: use operations.NewStartIndexOperation.
 func NewStartIndexOperation(indexName string) (*StartIndexOperation, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 	return &StartIndexOperation{
 		indexName: indexName,
@@ -42,7 +44,7 @@ type StartIndexCommand struct {
 
 func NewStartIndexCommand(indexName string) (*StartIndexCommand, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 
 	cmd := &StartIndexCommand{
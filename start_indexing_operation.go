@@ -6,10 +6,12 @@ import (
 
 var _ IVoidMaintenanceOperation = &StartIndexingOperation{}
 
+// StartIndexingOperation resumes indexing of all indexes in a database
 type StartIndexingOperation struct {
 	Command *StartIndexingCommand
 }
 
+// Deprecated: use operations.NewStartIndexingOperation.
 func NewStartIndexingOperation() *StartIndexingOperation {
 	return &StartIndexingOperation{}
 }
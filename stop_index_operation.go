@@ -6,15 +6,18 @@ import (
 
 var _ IVoidMaintenanceOperation = &StopIndexOperation{}
 
+// StopIndexOperation pauses indexing of an index until it's started again
+// or the database is restarted
 type StopIndexOperation struct {
 	indexName string
 
 	Command *StopIndexCommand
 }
 
+// Deprecated: use operations.NewStopIndexOperation.
 func NewStopIndexOperation(indexName string) (*StopIndexOperation, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 	return &StopIndexOperation{
 		indexName: indexName,
@@ -42,7 +45,7 @@ type StopIndexCommand struct {
 
 func NewStopIndexCommand(indexName string) (*StopIndexCommand, error) {
 	if indexName == "" {
-		return nil, newIllegalArgumentError("Index name connot be empty")
+		return nil, newIllegalArgumentError("Index name cannot be empty")
 	}
 
 	cmd := &StopIndexCommand{
@@ -6,10 +6,13 @@ import (
 
 var _ IVoidMaintenanceOperation = &StopIndexingOperation{}
 
+// StopIndexingOperation pauses indexing of all indexes in a database until
+// it's started again or the database is restarted
 type StopIndexingOperation struct {
 	Command *StopIndexingCommand
 }
 
+// Deprecated: use operations.NewStopIndexingOperation.
 func NewStopIndexingOperation() *StopIndexingOperation {
 	return &StopIndexingOperation{}
 }
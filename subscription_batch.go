@@ -58,12 +58,53 @@ type SubscriptionBatch struct {
 	Items []*SubscriptionBatchItem
 }
 
+// OpenSession opens a session that already tracks documents from this batch.
+// Entities returned by GetResult can be modified and saved with SaveChanges
+// without loading them again. The change vectors are the ones received
+// in the batch so SaveChanges fails on a concurrency conflict if optimistic
+// concurrency is enabled and a document was modified in the meantime.
+// Documents of revisions subscriptions and map[string]interface{} results
+// are not tracked
 func (b *SubscriptionBatch) OpenSession() (*DocumentSession, error) {
 	sessionOptions := &SessionOptions{
 		Database:        b.dbName,
 		RequestExecutor: b.requestExecutor,
 	}
-	return b.store.OpenSessionWithOptions(sessionOptions)
+	session, err := b.store.OpenSessionWithOptions(sessionOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err = b.loadDocumentsIntoSession(session); err != nil {
+		session.Close()
+		return nil, err
+	}
+	return session, nil
+}
+
+func (b *SubscriptionBatch) loadDocumentsIntoSession(session *DocumentSession) error {
+	if b.revisions {
+		return nil
+	}
+	for _, item := range b.Items {
+		if item.ErrorMessage != "" || item.Result == nil {
+			continue
+		}
+		if _, ok := item.Result.(map[string]interface{}); ok {
+			continue
+		}
+		changeVector := item.ChangeVector
+		info := &documentInfo{
+			id:           item.ID,
+			document:     item.RawResult,
+			metadata:     item.RawMetadata,
+			changeVector: &changeVector,
+		}
+		info.setEntity(item.Result)
+		if err := session.registerExternalLoadedIntoTheSession(info); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func newSubscriptionBatch(clazz reflect.Type, revisions bool, requestExecutor *RequestExecutor, store *DocumentStore, dbName string, logger *log.Logger) *SubscriptionBatch {
@@ -112,43 +153,28 @@ func (b *SubscriptionBatch) initialize(batch []*subscriptionConnectionServerMess
 		var instance interface{}
 
 		if item.Exception == "" {
-			if b.clazz == reflect.TypeOf(map[string]interface{}{}) {
+			if b.revisions {
+				// parse outer object manually as Previous/Current has PascalCase
+				revision := &Revision{}
+				var err error
+				if revision.Current, err = b.convertRevision(id, curDoc["Current"]); err != nil {
+					return "", err
+				}
+				if revision.Previous, err = b.convertRevision(id, curDoc["Previous"]); err != nil {
+					return "", err
+				}
+				instance = revision
+			} else if b.clazz == reflect.TypeOf(map[string]interface{}{}) {
 				instance = curDoc
 			} else {
-				if b.revisions {
-					// parse outer object manually as Previous/Current has PascalCase
-					previous := curDoc["Previous"]
-					current := curDoc["Current"]
-					revision := &Revision{}
-					//c := b._requestExecutor.GetConventions()
-					if current != nil {
-						doc := current.(map[string]interface{})
-						v, err := entityToJSONConvertToEntity(b.clazz, id, doc)
-						if err != nil {
-							return "", err
-						}
-						revision.Current = v
-					}
-					if previous != nil {
-						doc := previous.(map[string]interface{})
-						v, err := entityToJSONConvertToEntity(b.clazz, id, doc)
-						if err != nil {
-							return "", err
-						}
-						revision.Previous = v
-					}
-					instance = revision
-				} else {
-					var err error
-					instance, err = entityToJSONConvertToEntity(b.clazz, id, curDoc)
-					if err != nil {
-						return "", err
-					}
+				var err error
+				instance, err = entityToJSONConvertToEntity(b.clazz, id, curDoc)
+				if err != nil {
+					return "", err
+				}
+				if stringIsNotEmpty(id) {
+					b.generateEntityIdOnTheClient.trySetIdentity(instance, id)
 				}
-			}
-
-			if stringIsNotEmpty(id) {
-				b.generateEntityIdOnTheClient.trySetIdentity(instance, id)
 			}
 		}
 		itemToAdd := &SubscriptionBatchItem{
@@ -164,6 +190,25 @@ func (b *SubscriptionBatch) initialize(batch []*subscriptionConnectionServerMess
 	return lastReceivedChangeVector, nil
 }
 
+// convertRevision converts Previous or Current part of a revision to b.clazz
+func (b *SubscriptionBatch) convertRevision(id string, v interface{}) (interface{}, error) {
+	doc, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	if b.clazz == reflect.TypeOf(map[string]interface{}{}) {
+		return doc, nil
+	}
+	instance, err := entityToJSONConvertToEntity(b.clazz, id, doc)
+	if err != nil {
+		return nil, err
+	}
+	if stringIsNotEmpty(id) {
+		b.generateEntityIdOnTheClient.trySetIdentity(instance, id)
+	}
+	return instance, nil
+}
+
 func throwRequired(name string) error {
 	return newIllegalStateError("Document must have a " + name)
 }
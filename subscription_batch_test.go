@@ -0,0 +1,135 @@
+package ravendb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRevisionMessage(id string, previous, current map[string]interface{}) *subscriptionConnectionServerMessage {
+	data := map[string]interface{}{
+		MetadataKey: map[string]interface{}{
+			MetadataID:           id,
+			MetadataChangeVector: "A:1-abc",
+		},
+	}
+	if previous != nil {
+		data["Previous"] = previous
+	}
+	if current != nil {
+		data["Current"] = current
+	}
+	return &subscriptionConnectionServerMessage{
+		Type: subscriptionServerMessageData,
+		Data: data,
+	}
+}
+
+func TestSubscriptionBatchRevisions(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:8080"}, "db")
+	re := NewRequestExecutor("db", nil, nil, store.GetConventions(), store.GetUrls())
+
+	batch := newSubscriptionBatch(reflect.TypeOf(&User{}), true, re, store, "db", nil)
+	messages := []*subscriptionConnectionServerMessage{
+		newRevisionMessage("users/1", nil, map[string]interface{}{"Name": "John"}),
+		newRevisionMessage("users/1", map[string]interface{}{"Name": "John"}, map[string]interface{}{"Name": "Jon"}),
+	}
+	cv, err := batch.initialize(messages)
+	assert.NoError(t, err)
+	assert.Equal(t, "A:1-abc", cv)
+	assert.Equal(t, 2, len(batch.Items))
+
+	var revision *Revision
+	err = batch.Items[0].GetResult(&revision)
+	assert.NoError(t, err)
+	var previous, current *User
+	ok, err := revision.GetPrevious(&previous)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, previous)
+	ok, err = revision.GetCurrent(&current)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "John", current.Name)
+	assert.Equal(t, "users/1", current.ID)
+
+	err = batch.Items[1].GetResult(&revision)
+	assert.NoError(t, err)
+	ok, err = revision.GetPrevious(&previous)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "John", previous.Name)
+	_, err = revision.GetCurrent(&current)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jon", current.Name)
+
+	var wrongType *Revision
+	_, err = revision.GetCurrent(&wrongType)
+	assert.Error(t, err)
+}
+
+func TestSubscriptionBatchRevisionsAsMaps(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:8080"}, "db")
+	re := NewRequestExecutor("db", nil, nil, store.GetConventions(), store.GetUrls())
+
+	batch := newSubscriptionBatch(reflect.TypeOf(map[string]interface{}{}), true, re, store, "db", nil)
+	messages := []*subscriptionConnectionServerMessage{
+		newRevisionMessage("users/1", map[string]interface{}{"Name": "John"}, nil),
+	}
+	_, err := batch.initialize(messages)
+	assert.NoError(t, err)
+
+	revision, ok := batch.Items[0].Result.(*Revision)
+	assert.True(t, ok)
+	var previous map[string]interface{}
+	ok, err = revision.GetPrevious(&previous)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "John", previous["Name"])
+	ok, err = revision.GetCurrent(&previous)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestSubscriptionBatchOpenSessionTracksDocuments(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:8080"}, "db")
+	assert.NoError(t, store.Initialize())
+	defer store.Close()
+	re := NewRequestExecutor("db", nil, nil, store.GetConventions(), store.GetUrls())
+
+	batch := newSubscriptionBatch(reflect.TypeOf(&User{}), false, re, store, "db", nil)
+	doc := map[string]interface{}{
+		"Name": "John",
+		MetadataKey: map[string]interface{}{
+			MetadataID:           "users/1",
+			MetadataChangeVector: "A:1-abc",
+			MetadataCollection:   "Users",
+		},
+	}
+	messages := []*subscriptionConnectionServerMessage{
+		{Type: subscriptionServerMessageData, Data: doc},
+	}
+	_, err := batch.initialize(messages)
+	assert.NoError(t, err)
+
+	session, err := batch.OpenSession()
+	assert.NoError(t, err)
+	defer session.Close()
+	assert.True(t, session.IsLoaded("users/1"))
+
+	var user *User
+	err = batch.Items[0].GetResult(&user)
+	assert.NoError(t, err)
+	cv, err := session.GetChangeVectorFor(user)
+	assert.NoError(t, err)
+	assert.Equal(t, "A:1-abc", *cv)
+
+	changed, err := session.HasChanged(user)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	user.Name = "Jon"
+	changed, err = session.HasChanged(user)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+}
@@ -10,6 +10,11 @@ const (
 	subscriptionServerMessageData             = "Data"
 	subscriptionServerMessageConfirm          = "Confirm"
 	subscriptionServerMessageError            = "Error"
+	// sent by newer servers when subscription query has includes,
+	// which we don't support yet
+	subscriptionServerMessageIncludes           = "Includes"
+	subscriptionServerMessageCounterIncludes    = "CounterIncludes"
+	subscriptionServerMessageTimeSeriesIncludes = "TimeSeriesIncludes"
 )
 
 // subscriptionConnectionStatus describes subscription connection status
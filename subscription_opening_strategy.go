@@ -1,21 +1,34 @@
 package ravendb
 
 // SubscriptionOpeningStrategy describes opening strategy for subscriptions
-type SubscriptionOpeningStrategy = string
+type SubscriptionOpeningStrategy string
 
 const (
 	// SubscriptionOpeningStrategyOpenIfFree:
 	// The client will successfully open a subscription only if there isn't any other currently connected client.
 	// Otherwise it will end up with SubscriptionInUseError
-	SubscriptionOpeningStrategyOpenIfFree = "OpenIfFree"
+	SubscriptionOpeningStrategyOpenIfFree SubscriptionOpeningStrategy = "OpenIfFree"
 	// SubscriptionOpeningStrategyTakeOver:
 	// The connecting client will successfully open a subscription even if there is another active subscription's consumer.
 	// If the new client takes over an existing client then the existing one will get a SubscriptionInUseException.
 	//
 	// The subscription will always be held by the last connected client.
-	SubscriptionOpeningStrategyTakeOver = "TakeOver"
+	SubscriptionOpeningStrategyTakeOver SubscriptionOpeningStrategy = "TakeOver"
 	// SubscriptionOpeningStrategyWaitForFree:
 	// If the client currently cannot open the subscription because it is used by another client but it will wait for that client
 	// to complete and keep attempting to gain the subscription
-	SubscriptionOpeningStrategyWaitForFree = "WaitForFree"
+	SubscriptionOpeningStrategyWaitForFree SubscriptionOpeningStrategy = "WaitForFree"
+	// SubscriptionOpeningStrategyConcurrent:
+	// Multiple clients can connect to the same subscription at the same time.
+	// The server sends each client different batches and documents in
+	// a batch sent to one client are not sent to other clients until
+	// that batch is acknowledged. Requires RavenDB 5.3 or newer
+	SubscriptionOpeningStrategyConcurrent SubscriptionOpeningStrategy = "Concurrent"
 )
+
+var subscriptionOpeningStrategyValues = []string{string(SubscriptionOpeningStrategyOpenIfFree), string(SubscriptionOpeningStrategyTakeOver), string(SubscriptionOpeningStrategyWaitForFree), string(SubscriptionOpeningStrategyConcurrent)}
+
+// MarshalJSON returns an error if SubscriptionOpeningStrategy is not one of the defined values
+func (s SubscriptionOpeningStrategy) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(s), "SubscriptionOpeningStrategy", subscriptionOpeningStrategyValues)
+}
@@ -0,0 +1,112 @@
+package ravendb
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SubscriptionQuery describes a subscription query built from a Go type
+// and optional JavaScript filter and projection snippets.
+// Snippets refer to the subscribed document as doc and to parameters as $name.
+// Subscription queries don't support query parameters, so parameter values
+// are substituted as JSON literals, never as raw strings.
+type SubscriptionQuery struct {
+	// Filter is a JavaScript expression, e.g. "doc.Age > $minAge"
+	Filter string
+	// Projection is a JavaScript object literal, e.g. "{ Name: doc.Name }"
+	Projection string
+	Parameters Parameters
+	// if true, subscribes to revisions of documents
+	Revisions bool
+}
+
+var subscriptionQueryParameterRegex = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// BuildCreationOptions returns SubscriptionCreationOptions with Query built
+// for documents of a given type from filter and projection in query.
+// Name, ChangeVector and MentorNode are copied from options, which can be nil.
+func (s *DocumentSubscriptions) BuildCreationOptions(clazz reflect.Type, query *SubscriptionQuery, options *SubscriptionCreationOptions) (*SubscriptionCreationOptions, error) {
+	if clazz == nil {
+		return nil, newIllegalArgumentError("clazz cannot be nil")
+	}
+	if query == nil {
+		query = &SubscriptionQuery{}
+	}
+	collectionName := s.store.GetConventions().getCollectionName(clazz)
+	rql, err := buildSubscriptionQuery(collectionName, query)
+	if err != nil {
+		return nil, err
+	}
+	res := &SubscriptionCreationOptions{
+		Query: rql,
+	}
+	if options != nil {
+		res.Name = options.Name
+		res.ChangeVector = options.ChangeVector
+		res.MentorNode = options.MentorNode
+	}
+	return res, nil
+}
+
+func buildSubscriptionQuery(collectionName string, query *SubscriptionQuery) (string, error) {
+	used := map[string]bool{}
+	var err error
+	substitute := func(snippet string) string {
+		return subscriptionQueryParameterRegex.ReplaceAllStringFunc(snippet, func(match string) string {
+			name := match[1:]
+			value, ok := query.Parameters[name]
+			if !ok {
+				if err == nil {
+					err = newIllegalArgumentError("Parameter '%s' is used in subscription query but wasn't provided", name)
+				}
+				return match
+			}
+			used[name] = true
+			d, err2 := json.Marshal(value)
+			if err2 != nil && err == nil {
+				err = newIllegalArgumentError("Failed to serialize value of parameter '%s': %s", name, err2)
+			}
+			return string(d)
+		})
+	}
+
+	filter := substitute(strings.TrimSpace(query.Filter))
+	projection := substitute(strings.TrimSpace(query.Projection))
+	if err != nil {
+		return "", err
+	}
+
+	var unused []string
+	for name := range query.Parameters {
+		if !used[name] {
+			unused = append(unused, name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return "", newIllegalArgumentError("Parameters %s are not used in subscription query", strings.Join(unused, ", "))
+	}
+
+	var sb strings.Builder
+	if filter != "" {
+		sb.WriteString("declare function predicate() {\n")
+		sb.WriteString("\tvar doc = this;\n")
+		sb.WriteString("\treturn " + filter + ";\n")
+		sb.WriteString("}\n")
+	}
+	sb.WriteString("from " + collectionName)
+	if query.Revisions {
+		sb.WriteString(" (Revisions = true)")
+	}
+	sb.WriteString(" as doc")
+	if filter != "" {
+		sb.WriteString(" where predicate.call(doc)")
+	}
+	if projection != "" {
+		sb.WriteString(" select " + projection)
+	}
+	return sb.String(), nil
+}
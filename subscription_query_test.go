@@ -0,0 +1,37 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSubscriptionQuery(t *testing.T) {
+	rql, err := buildSubscriptionQuery("Users", &SubscriptionQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, "from Users as doc", rql)
+
+	q := &SubscriptionQuery{
+		Filter:     "doc.Age > $minAge && doc.Name != $name",
+		Projection: "{ Name: doc.Name }",
+		Parameters: Parameters{
+			"minAge": 18,
+			"name":   `John "Doe"`,
+		},
+	}
+	rql, err = buildSubscriptionQuery("Users", q)
+	assert.NoError(t, err)
+	exp := "declare function predicate() {\n\tvar doc = this;\n\treturn doc.Age > 18 && doc.Name != \"John \\\"Doe\\\"\";\n}\nfrom Users as doc where predicate.call(doc) select { Name: doc.Name }"
+	assert.Equal(t, exp, rql)
+
+	q = &SubscriptionQuery{
+		Revisions: true,
+		Filter:    "doc.Current.Age > $age",
+	}
+	_, err = buildSubscriptionQuery("Users", q)
+	assert.Error(t, err)
+
+	q.Parameters = Parameters{"age": 3, "other": 5}
+	_, err = buildSubscriptionQuery("Users", q)
+	assert.Error(t, err)
+}
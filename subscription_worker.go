@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"net"
 	"reflect"
 	"strconv"
@@ -36,6 +37,10 @@ type SubscriptionWorker struct {
 	// this channel is closed when worker
 	chDone chan struct{}
 
+	// closed when cancellation is requested, wakes up waiting before reconnect
+	chCancel   chan struct{}
+	cancelOnce sync.Once
+
 	afterAcknowledgment           []func(*SubscriptionBatch)
 	onSubscriptionConnectionRetry []func(error)
 
@@ -48,6 +53,9 @@ type SubscriptionWorker struct {
 
 	err atomic.Value // error
 	mu  sync.Mutex
+	// serializes writes to tcpClient, acks are sent from the subscription
+	// goroutine and disposed notification from Close
+	writeMu sync.Mutex
 }
 
 // Err returns a potential error, available after worker finished
@@ -69,6 +77,9 @@ func (w *SubscriptionWorker) isCancellationRequested() bool {
 // To wait
 func (w *SubscriptionWorker) Cancel() {
 	atomic.AddInt32(&w.cancellationRequested, 1)
+	w.cancelOnce.Do(func() {
+		close(w.chCancel)
+	})
 	// we might be reading from a connection, so break that loop
 	// by closing the connection
 	w.closeTcpClient()
@@ -156,10 +167,25 @@ func (w *SubscriptionWorker) RemoveOnSubscriptionConnectionRetry(id int) {
 
 // NewSubscriptionWorker returns new SubscriptionWorker
 func NewSubscriptionWorker(clazz reflect.Type, options *SubscriptionWorkerOptions, withRevisions bool, documentStore *DocumentStore, dbName string) (*SubscriptionWorker, error) {
-
+	if options == nil {
+		return nil, newIllegalArgumentError("options cannot be nil")
+	}
 	if options.SubscriptionName == "" {
 		return nil, newIllegalArgumentError("SubscriptionConnectionOptions must specify the subscriptionName")
 	}
+	if options.TimeToWaitBeforeConnectionRetry < 0 || options.MaxErroneousPeriod < 0 {
+		return nil, newIllegalArgumentError("TimeToWaitBeforeConnectionRetry and MaxErroneousPeriod cannot be negative")
+	}
+	// options might not come from NewSubscriptionWorkerOptions,
+	// use the same defaults so that we don't retry in a tight loop.
+	// Defaults go to a copy, the caller might reuse options
+	opts := *options
+	if opts.TimeToWaitBeforeConnectionRetry == 0 {
+		opts.TimeToWaitBeforeConnectionRetry = Duration(time.Second * 5)
+	}
+	if opts.MaxErroneousPeriod == 0 {
+		opts.MaxErroneousPeriod = Duration(time.Minute * 5)
+	}
 
 	if dbName == "" {
 		dbName = documentStore.GetDatabase()
@@ -167,10 +193,11 @@ func NewSubscriptionWorker(clazz reflect.Type, options *SubscriptionWorkerOption
 
 	res := &SubscriptionWorker{
 		clazz:     clazz,
-		options:   options,
+		options:   &opts,
 		revisions: withRevisions,
 		store:     documentStore,
 		dbName:    dbName,
+		chCancel:  make(chan struct{}),
 	}
 
 	return res, nil
@@ -191,6 +218,10 @@ func (w *SubscriptionWorker) close(waitForSubscriptionTask bool) error {
 		}
 	}()
 	w.markDisposed()
+	if w.isConcurrent() {
+		// let the server know it can give our documents to other workers
+		w.sendDisposedNotification()
+	}
 	w.Cancel()
 
 	if waitForSubscriptionTask {
@@ -218,6 +249,40 @@ func (w *SubscriptionWorker) Run(cb func(*SubscriptionBatch) error) error {
 	return nil
 }
 
+func (w *SubscriptionWorker) isConcurrent() bool {
+	return w.options.Strategy == SubscriptionOpeningStrategyConcurrent
+}
+
+func (w *SubscriptionWorker) sendDisposedNotification() {
+	tcpClient := w.getTcpClient()
+	if tcpClient == nil {
+		return
+	}
+	msg := &SubscriptionConnectionClientMessage{
+		Type: SubscriptionClientMessageDisposedNotification,
+	}
+	d, err := jsonMarshal(msg)
+	if err != nil {
+		return
+	}
+	// best effort, the connection might already be broken
+	// setting the deadline before taking the lock also unblocks an ack
+	// write that is stuck on a server that doesn't read
+	_ = tcpClient.SetWriteDeadline(time.Now().Add(time.Second))
+	_ = w.write(tcpClient, d)
+}
+
+// write sends a message to the server, it's safe to call concurrently
+func (w *SubscriptionWorker) write(conn net.Conn, d []byte) error {
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+	if _, err := conn.Write(d); err != nil {
+		return err
+	}
+	LogSubscriptionWorker("write", d)
+	return nil
+}
+
 func (w *SubscriptionWorker) getCurrentNodeTag() string {
 	if w.redirectNode != nil {
 		return w.redirectNode.ClusterTag
@@ -265,6 +330,9 @@ func (w *SubscriptionWorker) connectToServer() (net.Conn, error) {
 	}
 	LogSubscriptionWorker("connect", nil)
 	w.tcpClient.Store(tcpClient)
+	// a new connection needs a new parser, the old one might have
+	// buffered data from a previous connection
+	w.parser = json.NewDecoder(tcpClient)
 	databaseName := w.dbName
 	if databaseName == "" {
 		databaseName = w.store.GetDatabase()
@@ -274,6 +342,9 @@ func (w *SubscriptionWorker) connectToServer() (net.Conn, error) {
 	parameters.database = databaseName
 	parameters.operation = operationSubscription
 	parameters.version = subscriptionTCPVersion
+	if w.isConcurrent() {
+		parameters.version = concurrentSubscriptionTCPVersion
+	}
 	fn := func(s string) int {
 		n, _ := w.readServerResponseAndGetVersion(s)
 		return n
@@ -291,16 +362,18 @@ func (w *SubscriptionWorker) connectToServer() (net.Conn, error) {
 		return nil, newIllegalStateError(w.options.SubscriptionName + " : TCP negotiation resulted with an invalid protocol version: " + strconv.Itoa(w.supportedFeatures.protocolVersion))
 	}
 
+	if w.isConcurrent() && (w.supportedFeatures.subscription == nil || !w.supportedFeatures.subscription.concurrent) {
+		return nil, newSubscriptionInvalidStateError("Subscription " + w.options.SubscriptionName + " : the server doesn't support concurrent subscriptions, RavenDB 5.3 or newer is required")
+	}
+
 	options, err := jsonMarshal(w.options)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = tcpClient.Write(options)
-	if err != nil {
+	if err = w.write(tcpClient, options); err != nil {
 		return nil, err
 	}
-	LogSubscriptionWorker("write", options)
 	if w.subscriptionLocalRequestExecutor != nil {
 		w.subscriptionLocalRequestExecutor.Close()
 	}
@@ -360,12 +433,7 @@ func (w *SubscriptionWorker) sendDropMessage(reply *tcpConnectionHeaderResponse)
 	if err != nil {
 		return err
 	}
-	tcpClient := w.getTcpClient()
-	if _, err = tcpClient.Write(header); err != nil {
-		return err
-	}
-	LogSubscriptionWorker("write", header)
-	return nil
+	return w.write(w.getTcpClient(), header)
 }
 
 func (w *SubscriptionWorker) assertConnectionState(connectionStatus *subscriptionConnectionServerMessage) error {
@@ -383,7 +451,7 @@ func (w *SubscriptionWorker) assertConnectionState(connectionStatus *subscriptio
 	switch connectionStatus.Status {
 	case subscriptionConnectionStatusAccepted:
 	case subscriptionConnectionStatusInUse:
-		return newSubscriptionInUseError("Subscription with id " + w.options.SubscriptionName + " cannot be opened, because it's in use and the connection strategy is " + w.options.Strategy)
+		return newSubscriptionInUseError("Subscription with id " + w.options.SubscriptionName + " cannot be opened, because it's in use and the connection strategy is " + string(w.options.Strategy))
 	case subscriptionConnectionStatusClosed:
 		return newSubscriptionClosedError("Subscription with id " + w.options.SubscriptionName + " was closed. " + connectionStatus.Exception)
 	case subscriptionConnectionStatusInvalid:
@@ -462,6 +530,7 @@ func (w *SubscriptionWorker) processSubscriptionInner(cb func(batch *Subscriptio
 		// only copy the fields needed in OpenSession
 		batchCopy := &SubscriptionBatch{
 			Items:           batch.Items,
+			revisions:       batch.revisions,
 			store:           batch.store,
 			requestExecutor: batch.requestExecutor,
 			dbName:          batch.dbName,
@@ -469,12 +538,16 @@ func (w *SubscriptionWorker) processSubscriptionInner(cb func(batch *Subscriptio
 
 		err = cb(batchCopy)
 		if err != nil {
-			return err
+			if !w.options.IgnoreSubscriberErrors {
+				return newSubscriberErrorError("Subscriber threw an exception in subscription '%s': %s", w.options.SubscriptionName, err.Error(), err)
+			}
+			if w.logger != nil {
+				w.logger.Printf("Subscription %s. Subscriber threw an exception on document batch: %s", w.options.SubscriptionName, err)
+			}
 		}
 
 		if tcpClientCopy != nil {
-			err = w.sendAck(lastReceivedChangeVector, tcpClientCopy)
-			if err != nil && !w.options.IgnoreSubscriberErrors {
+			if err = w.sendAck(lastReceivedChangeVector, tcpClientCopy); err != nil {
 				return err
 			}
 		}
@@ -529,6 +602,8 @@ func (w *SubscriptionWorker) readSingleSubscriptionBatchFromServer(batch *Subscr
 			if err = w.assertConnectionState(receivedMessage); err != nil {
 				return nil, err
 			}
+		case subscriptionServerMessageIncludes, subscriptionServerMessageCounterIncludes, subscriptionServerMessageTimeSeriesIncludes:
+			// ignored, included items can still be loaded in a session
 		case subscriptionServerMessageError:
 			return nil, throwSubscriptionError(receivedMessage)
 		default:
@@ -576,9 +651,7 @@ func (w *SubscriptionWorker) sendAck(lastReceivedChangeVector string, networkStr
 	if err != nil {
 		return err
 	}
-	_, err = networkStream.Write(ack)
-	LogSubscriptionWorker("write", ack)
-	return err
+	return w.write(networkStream, ack)
 }
 
 func (w *SubscriptionWorker) runSubscriptionAsync(cb func(*SubscriptionBatch) error) {
@@ -617,9 +690,15 @@ func (w *SubscriptionWorker) runSubscriptionAsync(cb func(*SubscriptionBatch) er
 			}
 			return
 		}
-		time.Sleep(time.Duration(w.options.TimeToWaitBeforeConnectionRetry))
+		select {
+		case <-time.After(time.Duration(w.options.TimeToWaitBeforeConnectionRetry)):
+		case <-w.chCancel:
+			return
+		}
 		for _, cb := range w.onSubscriptionConnectionRetry {
-			cb(ex)
+			if cb != nil {
+				cb(ex)
+			}
 		}
 	}
 }
@@ -654,11 +733,12 @@ func (w *SubscriptionWorker) shouldTryToReconnect(ex error) (bool, error) {
 			return true, nil
 		}
 
-		var nodeToRedirectTo *ServerNode
-		for _, x := range requestExecutor.GetTopologyNodes() {
-			if x.ClusterTag == se.appropriateNode {
-				nodeToRedirectTo = x
-				break
+		nodeToRedirectTo := findNodeByTag(requestExecutor.GetTopologyNodes(), se.appropriateNode)
+		if nodeToRedirectTo == nil {
+			// the node might have been added to the cluster recently
+			if current, err := requestExecutor.getPreferredNode(); err == nil {
+				<-requestExecutor.updateTopologyAsyncWithForceUpdate(current.currentNode, math.MaxInt32, true)
+				nodeToRedirectTo = findNodeByTag(requestExecutor.GetTopologyNodes(), se.appropriateNode)
 			}
 		}
 
@@ -693,6 +773,15 @@ func (w *SubscriptionWorker) shouldTryToReconnect(ex error) (bool, error) {
 	return true, nil
 }
 
+func findNodeByTag(nodes []*ServerNode, tag string) *ServerNode {
+	for _, node := range nodes {
+		if node.ClusterTag == tag {
+			return node
+		}
+	}
+	return nil
+}
+
 func (w *SubscriptionWorker) closeTcpClient() {
 	//w._parser = nil // Note: not necessary and causes data race
 
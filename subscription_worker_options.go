@@ -14,6 +14,8 @@ type SubscriptionWorkerOptions struct {
 }
 
 // NewSubscriptionWorkerOptions returns new SubscriptionWorkerOptions
+//
+// Deprecated: use subscriptions.NewSubscriptionWorkerOptions.
 func NewSubscriptionWorkerOptions(subscriptionName string) *SubscriptionWorkerOptions {
 	panicIf(subscriptionName == "", "SubscriptionName cannot be null or empty")
 	return &SubscriptionWorkerOptions{
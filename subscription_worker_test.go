@@ -0,0 +1,113 @@
+package ravendb
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSubscriptionWorkerValidatesOptions(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:8080"}, "db")
+	clazz := reflect.TypeOf(&User{})
+
+	_, err := NewSubscriptionWorker(clazz, nil, false, store, "")
+	assert.Error(t, err)
+	_, err = NewSubscriptionWorker(clazz, &SubscriptionWorkerOptions{}, false, store, "")
+	assert.Error(t, err)
+	_, err = NewSubscriptionWorker(clazz, &SubscriptionWorkerOptions{
+		SubscriptionName:                "sub",
+		TimeToWaitBeforeConnectionRetry: Duration(-time.Second),
+	}, false, store, "")
+	assert.Error(t, err)
+
+	options := &SubscriptionWorkerOptions{
+		SubscriptionName: "sub",
+	}
+	worker, err := NewSubscriptionWorker(clazz, options, false, store, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "db", worker.dbName)
+	assert.Equal(t, Duration(time.Second*5), worker.options.TimeToWaitBeforeConnectionRetry)
+	assert.Equal(t, Duration(time.Minute*5), worker.options.MaxErroneousPeriod)
+	// defaults are not written back to caller's options
+	assert.Equal(t, Duration(0), options.TimeToWaitBeforeConnectionRetry)
+	assert.Equal(t, Duration(0), options.MaxErroneousPeriod)
+
+	// cancelling twice must not panic and must wake up retry wait
+	worker.Cancel()
+	worker.Cancel()
+	select {
+	case <-worker.chCancel:
+	default:
+		t.Fatal("chCancel should be closed after Cancel")
+	}
+}
+
+func TestSubscriptionWorkerShouldNotReconnectOnSubscriberError(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:8080"}, "db")
+	worker, err := NewSubscriptionWorker(reflect.TypeOf(&User{}), NewSubscriptionWorkerOptions("sub"), false, store, "")
+	assert.NoError(t, err)
+
+	reconnect, err := worker.shouldTryToReconnect(newSubscriberErrorError("failed"))
+	assert.False(t, reconnect)
+	assert.IsType(t, &SubscriberErrorError{}, err)
+	assert.True(t, worker.isCancellationRequested())
+}
+
+func TestSubscriberErrorErrorKeepsCause(t *testing.T) {
+	cause := newIllegalStateError("boom")
+	err := newSubscriberErrorError("Subscriber threw an exception in subscription '%s': %s", "sub", cause.Error(), cause)
+	assert.Equal(t, "Subscriber threw an exception in subscription 'sub': boom", err.Error())
+	assert.True(t, errors.Is(err, cause))
+	var illegalState *IllegalStateError
+	assert.True(t, errors.As(err, &illegalState))
+	assert.Equal(t, cause, GetWrappedError(err))
+}
+
+// overlapConn records whether two writes were ever in progress at the same time
+type overlapConn struct {
+	net.Conn
+	writing int32
+	overlap int32
+}
+
+func (c *overlapConn) Write(d []byte) (int, error) {
+	if !atomic.CompareAndSwapInt32(&c.writing, 0, 1) {
+		atomic.StoreInt32(&c.overlap, 1)
+	}
+	time.Sleep(time.Millisecond)
+	atomic.StoreInt32(&c.writing, 0)
+	return len(d), nil
+}
+
+func (c *overlapConn) SetWriteDeadline(t time.Time) error {
+	return nil
+}
+
+func TestSubscriptionWorkerSerializesWrites(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:8080"}, "db")
+	worker, err := NewSubscriptionWorker(reflect.TypeOf(&User{}), NewSubscriptionWorkerOptions("sub"), false, store, "")
+	assert.NoError(t, err)
+	conn := &overlapConn{}
+	worker.tcpClient.Store(net.Conn(conn))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, worker.sendAck("A:1", conn))
+		}()
+		go func() {
+			defer wg.Done()
+			worker.sendDisposedNotification()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(0), atomic.LoadInt32(&conn.overlap))
+}
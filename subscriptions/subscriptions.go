@@ -0,0 +1,74 @@
+/*
+Package subscriptions exposes data subscriptions, which deliver documents
+matching a query to workers in batches, obtained with DocumentStore.Subscriptions().
+
+This package is the curated surface for subscriptions: creation and worker
+options, workers and their batches, subscription state, the errors returned
+by workers and the outbox helpers built on subscriptions.
+
+The types are aliases of the types in package ravendb, so values can be
+used with either package. The constructors call the ones in package ravendb,
+which are deprecated:
+
+	options := subscriptions.NewSubscriptionWorkerOptions(name)
+	worker, err := store.Subscriptions().GetSubscriptionWorker(reflect.TypeOf(&User{}), options, "")
+*/
+package subscriptions
+
+import (
+	ravendb "github.com/ravendb/ravendb-go-client"
+)
+
+type (
+	CreateSubscriptionResult         = ravendb.CreateSubscriptionResult
+	DocumentSubscriptions            = ravendb.DocumentSubscriptions
+	OutboxDispatcher                 = ravendb.OutboxDispatcher
+	OutboxMessage                    = ravendb.OutboxMessage
+	SubscriptionBatch                = ravendb.SubscriptionBatch
+	SubscriptionBatchItem            = ravendb.SubscriptionBatchItem
+	SubscriptionCreationOptions      = ravendb.SubscriptionCreationOptions
+	SubscriptionOpeningStrategy      = ravendb.SubscriptionOpeningStrategy
+	SubscriptionState                = ravendb.SubscriptionState
+	SubscriptionStateWithNodeDetails = ravendb.SubscriptionStateWithNodeDetails
+	SubscriptionTryout               = ravendb.SubscriptionTryout
+	SubscriptionWorker               = ravendb.SubscriptionWorker
+	SubscriptionWorkerOptions        = ravendb.SubscriptionWorkerOptions
+)
+
+// errors returned by subscription workers
+type (
+	SubscriberErrorError                           = ravendb.SubscriberErrorError
+	SubscriptionChangeVectorUpdateConcurrencyError = ravendb.SubscriptionChangeVectorUpdateConcurrencyError
+	SubscriptionClosedError                        = ravendb.SubscriptionClosedError
+	SubscriptionDoesNotBelongToNodeError           = ravendb.SubscriptionDoesNotBelongToNodeError
+	SubscriptionDoesNotExistError                  = ravendb.SubscriptionDoesNotExistError
+	SubscriptionError                              = ravendb.SubscriptionError
+	SubscriptionInUseError                         = ravendb.SubscriptionInUseError
+	SubscriptionInvalidStateError                  = ravendb.SubscriptionInvalidStateError
+)
+
+const (
+	SubscriptionOpeningStrategyOpenIfFree  = ravendb.SubscriptionOpeningStrategyOpenIfFree
+	SubscriptionOpeningStrategyTakeOver    = ravendb.SubscriptionOpeningStrategyTakeOver
+	SubscriptionOpeningStrategyWaitForFree = ravendb.SubscriptionOpeningStrategyWaitForFree
+	SubscriptionOpeningStrategyConcurrent  = ravendb.SubscriptionOpeningStrategyConcurrent
+)
+
+// NewOutboxDispatcher returns a dispatcher which uses subscription with a given
+// name, creating it if it doesn't exist. Empty database means store's database
+func NewOutboxDispatcher(store *ravendb.DocumentStore, subscriptionName string, database string) (*OutboxDispatcher, error) {
+	return ravendb.NewOutboxDispatcher(store, subscriptionName, database)
+}
+
+// NewSubscriptionWorkerOptions returns new SubscriptionWorkerOptions
+func NewSubscriptionWorkerOptions(subscriptionName string) *SubscriptionWorkerOptions {
+	return ravendb.NewSubscriptionWorkerOptions(subscriptionName)
+}
+
+// StoreOutboxMessage adds an outbox message with a given type and payload
+// to the session. The message is saved by the next SaveChanges, in the same
+// transaction as all other changes made in the session, so it's only
+// dispatched if the domain changes are saved
+func StoreOutboxMessage(session *ravendb.DocumentSession, messageType string, payload interface{}) (*OutboxMessage, error) {
+	return ravendb.StoreOutboxMessage(session, messageType, payload)
+}
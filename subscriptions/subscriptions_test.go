@@ -0,0 +1,19 @@
+package subscriptions
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasesAreInterchangeable(t *testing.T) {
+	options := NewSubscriptionWorkerOptions("orders")
+	assert.Equal(t, ravendb.NewSubscriptionWorkerOptions("orders"), options)
+	options.Strategy = SubscriptionOpeningStrategyTakeOver
+	assert.Equal(t, ravendb.SubscriptionOpeningStrategyTakeOver, options.Strategy)
+
+	var err error = &SubscriptionClosedError{}
+	_, ok := err.(*ravendb.SubscriptionClosedError)
+	assert.True(t, ok)
+}
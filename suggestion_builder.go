@@ -23,6 +23,19 @@ func (b *SuggestionBuilder) ByField(fieldName string, term string, terms ...stri
 	return b
 }
 
+// buildSuggestion calls builder on a new SuggestionBuilder and returns the resulting suggestion
+func buildSuggestion(builder func(*SuggestionBuilder)) (SuggestionBase, error) {
+	if builder == nil {
+		return nil, newIllegalArgumentError("builder cannot be nil")
+	}
+	b := NewSuggestionBuilder()
+	builder(b)
+	if b.term == nil && b.terms == nil {
+		return nil, newIllegalStateError("builder must call ByField()")
+	}
+	return b.GetSuggestion(), nil
+}
+
 func (b *SuggestionBuilder) GetSuggestion() SuggestionBase {
 	if b.term != nil {
 		return b.term
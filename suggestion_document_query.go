@@ -47,6 +47,28 @@ func (q *SuggestionDocumentQuery) Execute() (map[string]*SuggestionResult, error
 	return q.processResults(command.Result, q.session.GetConventions())
 }
 
+// AndSuggestUsing adds another suggestion, for example for a different field
+func (q *SuggestionDocumentQuery) AndSuggestUsing(suggestion SuggestionBase) *SuggestionDocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.source.suggestUsing(suggestion)
+	return q
+}
+
+// AndSuggestUsingBuilder adds another suggestion configured by builder
+func (q *SuggestionDocumentQuery) AndSuggestUsingBuilder(builder func(*SuggestionBuilder)) *SuggestionDocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	suggestion, err := buildSuggestion(builder)
+	if err != nil {
+		q.err = err
+		return q
+	}
+	return q.AndSuggestUsing(suggestion)
+}
+
 func (q *SuggestionDocumentQuery) processResults(queryResult *QueryResult, conventions *DocumentConventions) (map[string]*SuggestionResult, error) {
 	q.InvokeAfterQueryExecuted(queryResult)
 
@@ -26,10 +26,13 @@ const (
 	hearthbeatsBaseLine                = 20
 	subscriptionBaseLine               = 40
 	testConnectionBaseLine             = 50
+	concurrentSubscriptionsBaseLine    = 53000
 
 	heartbeatsTCPVersion     = hearthbeatsBaseLine
 	subscriptionTCPVersion   = subscriptionBaseLine
 	testConnectionTCPVersion = testConnectionBaseLine
+
+	concurrentSubscriptionTCPVersion = concurrentSubscriptionsBaseLine
 )
 
 type tcpConnectionHeaderMessage struct {
@@ -71,7 +74,8 @@ func newDropFeatures() *dropFeatures {
 }
 
 type subscriptionFeatures struct {
-	baseLine bool
+	baseLine   bool
+	concurrent bool
 }
 
 func newSubscriptionFeatures() *subscriptionFeatures {
@@ -137,7 +141,8 @@ func init() {
 	operationsToSupportedProtocolVersions[operationPing] = []int{pingBaseLine}
 	operationsToSupportedProtocolVersions[operationNone] = []int{noneBaseLine}
 	operationsToSupportedProtocolVersions[operationDrop] = []int{dropBaseLine}
-	operationsToSupportedProtocolVersions[operationSubscription] = []int{subscriptionBaseLine}
+	// must be sorted from the newest version, see operationVersionSupported
+	operationsToSupportedProtocolVersions[operationSubscription] = []int{concurrentSubscriptionsBaseLine, subscriptionBaseLine}
 	operationsToSupportedProtocolVersions[operationHeartbeats] = []int{hearthbeatsBaseLine}
 	operationsToSupportedProtocolVersions[operationTestConnection] = []int{testConnectionBaseLine}
 
@@ -164,6 +169,10 @@ func init() {
 	subscriptionFeatures := newSupportedFeatures(subscriptionBaseLine)
 	subscriptionFeatures.subscription = newSubscriptionFeatures()
 	subscriptionFeaturesMap[subscriptionBaseLine] = subscriptionFeatures
+	concurrentSubscriptionFeatures := newSupportedFeatures(concurrentSubscriptionsBaseLine)
+	concurrentSubscriptionFeatures.subscription = newSubscriptionFeatures()
+	concurrentSubscriptionFeatures.subscription.concurrent = true
+	subscriptionFeaturesMap[concurrentSubscriptionsBaseLine] = concurrentSubscriptionFeatures
 
 	heartbeatsFeaturesMap := map[int]*supportedFeatures{}
 	supportedFeaturesByProtocol[operationHeartbeats] = heartbeatsFeaturesMap
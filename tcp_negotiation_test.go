@@ -0,0 +1,39 @@
+package ravendb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func negotiateSubscription(t *testing.T, version int, serverVersions ...int) (*supportedFeatures, error) {
+	parameters := &tcpNegotiateParameters{
+		operation: operationSubscription,
+		version:   version,
+		database:  "db",
+	}
+	parameters.readResponseAndGetVersionCallback = func(string) int {
+		res := serverVersions[0]
+		serverVersions = serverVersions[1:]
+		return res
+	}
+	return negotiateProtocolVersion(&bytes.Buffer{}, parameters)
+}
+
+func TestNegotiateConcurrentSubscriptions(t *testing.T) {
+	features, err := negotiateSubscription(t, concurrentSubscriptionTCPVersion, concurrentSubscriptionTCPVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, concurrentSubscriptionTCPVersion, features.protocolVersion)
+	assert.True(t, features.subscription.concurrent)
+
+	// an older server answers with its own version, we fall back to the baseline
+	features, err = negotiateSubscription(t, concurrentSubscriptionTCPVersion, 51, subscriptionBaseLine)
+	assert.NoError(t, err)
+	assert.Equal(t, subscriptionBaseLine, features.protocolVersion)
+	assert.False(t, features.subscription.concurrent)
+
+	features, err = negotiateSubscription(t, subscriptionTCPVersion, subscriptionBaseLine)
+	assert.NoError(t, err)
+	assert.False(t, features.subscription.concurrent)
+}
@@ -9,26 +9,61 @@ import (
 	"net/url"
 )
 
+// newTLSConfig returns tls.Config that presents certificate to the server
+// (if not nil) and verifies server's certificate.
+// If trustStore is not nil, server's certificate must be signed by it
+// and, like in other RavenDB clients, host name is not verified.
+// Otherwise server's certificate is verified with system roots
 func newTLSConfig(certificate *tls.Certificate, trustStore *x509.Certificate) (*tls.Config, error) {
-	if certificate != nil && trustStore == nil {
-		return nil, newIllegalArgumentError("certificates and trustStoreASN1 can't be both empty")
+	if certificate == nil && trustStore == nil {
+		return nil, newIllegalArgumentError("certificate and trustStore can't be both nil")
 	}
 
 	config := &tls.Config{}
+	if certificate != nil {
+		config.Certificates = []tls.Certificate{*certificate}
+	}
 
 	if trustStore != nil {
 		roots := x509.NewCertPool()
 		roots.AddCert(trustStore)
 		config.RootCAs = roots
+		// standard verification also checks the host name, which often
+		// doesn't match e.g. when connecting by ip address,
+		// so we only verify the chain ourselves
+		config.InsecureSkipVerify = true
+		config.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyServerCertificate(rawCerts, roots)
+		}
 	}
-	// TODO: not sure if this should always (ever?) be set
-	// see setSSLHostnameVerifier and loadTrustMaterial in java code
-	config.InsecureSkipVerify = true
-
-	config.Certificates = []tls.Certificate{*certificate}
 	return config, nil
 }
 
+func verifyServerCertificate(rawCerts [][]byte, roots *x509.CertPool) error {
+	if len(rawCerts) == 0 {
+		return newAuthorizationError("Server didn't present a certificate")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs[i] = cert
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(opts); err != nil {
+		return newAuthorizationError("Server certificate is not trusted: %s", err)
+	}
+	return nil
+}
+
 func tcpConnect(uri string, serverCertificateBase64 []byte, clientCertificate *tls.Certificate) (net.Conn, error) {
 	//  uri is in the format: tcp://127.0.0.1:14206
 	parsed, err := url.Parse(uri)
@@ -60,6 +95,11 @@ func tcpConnect(uri string, serverCertificateBase64 []byte, clientCertificate *t
 		if err != nil {
 			return nil, err
 		}
+		if trustStore == nil {
+			// without the server's certificate there's nothing to verify
+			// against, so like before we don't verify it
+			config.InsecureSkipVerify = true
+		}
 		// forcing TLS 1.2 as Java code seems to be doing
 		config.MinVersion = tls.VersionTLS12
 		config.MaxVersion = tls.VersionTLS12
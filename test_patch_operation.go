@@ -0,0 +1,46 @@
+package ravendb
+
+var (
+	_ IOperation = &TestPatchOperation{}
+)
+
+// TestPatchOperation runs a patch script against a document in test mode.
+// Nothing is persisted, the server returns the original and modified document
+// together with debug output of the script, which helps developing patch scripts.
+// After sending, the result is in Command.Result
+type TestPatchOperation struct {
+	Command *PatchCommand
+
+	id             string
+	patch          *PatchRequest
+	patchIfMissing *PatchRequest
+}
+
+// NewTestPatchOperation returns new TestPatchOperation. patchIfMissing can be nil
+//
+// Deprecated: use operations.NewTestPatchOperation.
+func NewTestPatchOperation(id string, patch *PatchRequest, patchIfMissing *PatchRequest) (*TestPatchOperation, error) {
+	if id == "" {
+		return nil, newIllegalArgumentError("Id cannot be null")
+	}
+	if patch == nil {
+		return nil, newIllegalArgumentError("Patch cannot be null")
+	}
+	if stringIsBlank(patch.Script) {
+		return nil, newIllegalArgumentError("Patch script cannot be null")
+	}
+	if patchIfMissing != nil && stringIsBlank(patchIfMissing.Script) {
+		return nil, newIllegalArgumentError("PatchIfMissing script cannot be null")
+	}
+	return &TestPatchOperation{
+		id:             id,
+		patch:          patch,
+		patchIfMissing: patchIfMissing,
+	}, nil
+}
+
+func (o *TestPatchOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewPatchCommand(conventions, o.id, nil, o.patch, o.patchIfMissing, false, true, true)
+	return o.Command, err
+}
@@ -195,6 +195,32 @@ func aggregationCanCorrectlyAggregateMultipleItems(t *testing.T, driver *RavenTe
 
 		session.Close()
 	}
+
+	{
+		session := openSessionMust(t, store)
+
+		q := session.QueryIndex(index.IndexName)
+		q2 := q.AggregateBy(func(f *ravendb.FacetBuilder) {
+			f.ByField("product").SumOn("total")
+		}).AndAggregateBy(func(f *ravendb.FacetBuilder) {
+			f.ByField("currency").MaxOn("total")
+		})
+		r, err := q2.Execute()
+		assert.NoError(t, err)
+
+		x := getFirstFacetValueOfRange(r["product"].Values, "milk")
+		assert.Equal(t, *x.Sum, float64(12))
+
+		x = getFirstFacetValueOfRange(r["currency"].Values, "eur")
+		assert.Equal(t, *x.Max, float64(3333))
+
+		// builder must pick a field or ranges
+		q = session.QueryIndex(index.IndexName)
+		_, err = q.AggregateBy(func(f *ravendb.FacetBuilder) {}).Execute()
+		assert.Error(t, err)
+
+		session.Close()
+	}
 }
 
 func aggregationCanCorrectlyAggregateMultipleAggregations(t *testing.T, driver *RavenTestDriver) {
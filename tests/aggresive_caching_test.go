@@ -123,6 +123,51 @@ func aggressiveCachingWaitForNonStaleResultsIgnoresAggressiveCaching(t *testing.
 	assert.NotEqual(t, currNo, 1+oldNumOfRequests)
 }
 
+func aggressiveCachingCanAggressivelyCacheLoadsPerSession(t *testing.T, driver *RavenTestDriver) {
+	store := initAggressiveCaching(t, driver)
+	requestExecutor := store.GetRequestExecutor("")
+
+	oldNumOfRequests := requestExecutor.NumberOfServerRequests.Get()
+	for i := 0; i < 5; i++ {
+		session := openSessionMust(t, store)
+		{
+			cancel, err := session.Advanced().AggressivelyCacheFor(time.Minute * 5)
+			assert.NoError(t, err)
+			var u *User
+			err = session.Load(&u, "users/1-A")
+			assert.NoError(t, err)
+			cancel()
+		}
+		session.Close()
+	}
+	currNo := requestExecutor.NumberOfServerRequests.Get()
+	assert.Equal(t, currNo, 1+oldNumOfRequests)
+}
+
+func aggressiveCachingDisableCachingBypassesCache(t *testing.T, driver *RavenTestDriver) {
+	store := initAggressiveCaching(t, driver)
+	requestExecutor := store.GetRequestExecutor("")
+
+	cancel, err := store.AggressivelyCacheFor(time.Minute * 5)
+	assert.NoError(t, err)
+	defer cancel()
+
+	oldNumOfRequests := requestExecutor.NumberOfServerRequests.Get()
+	for i := 0; i < 5; i++ {
+		session := openSessionMust(t, store)
+		{
+			restore := session.Advanced().DisableCaching()
+			var u *User
+			err = session.Load(&u, "users/1-A")
+			assert.NoError(t, err)
+			restore()
+		}
+		session.Close()
+	}
+	currNo := requestExecutor.NumberOfServerRequests.Get()
+	assert.Equal(t, currNo, 5+oldNumOfRequests)
+}
+
 func TestAggressiveCaching(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -133,4 +178,6 @@ func TestAggressiveCaching(t *testing.T) {
 	aggressiveCachingWaitForNonStaleResultsIgnoresAggressiveCaching(t, driver)
 	aggressiveCachingCanAggressivelyCacheLoads(t, driver)
 	aggressiveCachingCanAggressivelyCacheLoads404(t, driver)
+	aggressiveCachingCanAggressivelyCacheLoadsPerSession(t, driver)
+	aggressiveCachingDisableCachingBypassesCache(t, driver)
 }
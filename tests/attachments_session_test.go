@@ -2,6 +2,7 @@ package tests
 
 import (
 	"bytes"
+	"io"
 	"io/ioutil"
 	"runtime"
 	"sort"
@@ -367,6 +368,53 @@ func attachmentsSessionGetAttachmentReleasesResources(t *testing.T, driver *Rave
 	}
 }
 
+func goAttachmentsSessionGetIfModified(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		err = session.Advanced().Attachments().StoreByID("users/1", "file1", bytes.NewBuffer([]byte{1, 2, 3}), "image/png")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	var changeVector string
+	{
+		session := openSessionMust(t, store)
+		result, err := session.Advanced().Attachments().GetByID("users/1", "file1")
+		assert.NoError(t, err)
+		changeVector = *result.Details.ChangeVector
+		result.Close()
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		result, err := session.Advanced().Attachments().GetByIDIfModified("users/1", "file1", changeVector)
+		assert.NoError(t, err)
+		assert.True(t, result.NotModified)
+		assert.Nil(t, result.Data)
+		assert.Equal(t, changeVector, *result.Details.ChangeVector)
+		assert.NoError(t, result.Close())
+
+		result, err = session.Advanced().Attachments().GetByIDIfModified("users/1", "file1", "A:1-doesnotmatch")
+		assert.NoError(t, err)
+		assert.False(t, result.NotModified)
+		data, err := ioutil.ReadAll(result.Data)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, data)
+		result.Close()
+
+		session.Close()
+	}
+}
+
 func attachmentsSessionDeleteDocumentAndThanItsAttachmentsThisIsNoOpButShouldBeSupported(t *testing.T, driver *RavenTestDriver) {
 	var err error
 	store := driver.getDocumentStoreMust(t)
@@ -556,6 +604,119 @@ func attachmentsSessionAttachmentExists(t *testing.T, driver *RavenTestDriver) {
 	}
 }
 
+func goAttachmentsOperations(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	putOp := ravendb.NewPutAttachmentOperation("users/1", "file1", bytes.NewReader([]byte{1, 2, 3}), "image/png", nil)
+	err = store.Operations().Send(putOp, nil)
+	assert.NoError(t, err)
+	details := putOp.Command.Result
+	assert.Equal(t, "file1", details.Name)
+	assert.Equal(t, int64(3), details.Size)
+
+	{
+		cmd, err := ravendb.NewHeadAttachmentCommand("users/1", "file1", nil)
+		assert.NoError(t, err)
+		err = store.GetRequestExecutor("").ExecuteCommand(cmd, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, *details.ChangeVector, cmd.Result)
+
+		// not modified
+		cmd, err = ravendb.NewHeadAttachmentCommand("users/1", "file1", details.ChangeVector)
+		assert.NoError(t, err)
+		err = store.GetRequestExecutor("").ExecuteCommand(cmd, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, *details.ChangeVector, cmd.Result)
+	}
+
+	{
+		getOp := ravendb.NewGetAttachmentOperation("users/1", "file1", ravendb.AttachmentDocument, "", nil)
+		err = store.Operations().Send(getOp, nil)
+		assert.NoError(t, err)
+		result := getOp.Command.Result
+		assert.Equal(t, "image/png", result.Details.ContentType)
+		data, err := ioutil.ReadAll(result.Data)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, data)
+		result.Close()
+	}
+
+	err = store.Operations().Send(ravendb.NewDeleteAttachmentOperation("users/1", "file1", nil), nil)
+	assert.NoError(t, err)
+
+	{
+		cmd, err := ravendb.NewHeadAttachmentCommand("users/1", "file1", nil)
+		assert.NoError(t, err)
+		err = store.GetRequestExecutor("").ExecuteCommand(cmd, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "", cmd.Result)
+	}
+}
+
+func goAttachmentsGetMultipleAndRange(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		err = session.Advanced().Attachments().StoreByID("users/1", "file1", bytes.NewBuffer([]byte("hello")), "text/plain")
+		assert.NoError(t, err)
+		err = session.Advanced().Attachments().StoreByID("users/1", "file2", bytes.NewBuffer([]byte("world!")), "text/plain")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		requests := []*ravendb.AttachmentRequest{
+			{DocumentID: "users/1", Name: "file2"},
+			{DocumentID: "users/1", Name: "file1"},
+		}
+		op := ravendb.NewGetAttachmentsOperation(requests, ravendb.AttachmentDocument)
+		err = store.Operations().Send(op, nil)
+		assert.NoError(t, err)
+		it := op.Command.Result
+		var contents []string
+		for {
+			result, err := it.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			d, err := ioutil.ReadAll(result.Data)
+			assert.NoError(t, err)
+			contents = append(contents, result.Details.Name+":"+string(d))
+		}
+		assert.NoError(t, it.Close())
+		assert.Equal(t, []string{"file2:world!", "file1:hello"}, contents)
+	}
+
+	{
+		op := ravendb.NewGetAttachmentRangeOperation("users/1", "file2", 1, 3)
+		err = store.Operations().Send(op, nil)
+		assert.NoError(t, err)
+		d, err := ioutil.ReadAll(op.Command.Result.Data)
+		assert.NoError(t, err)
+		assert.Equal(t, "orl", string(d))
+		op.Command.Result.Close()
+	}
+}
+
 func TestAttachmentsSession(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -579,4 +740,9 @@ func TestAttachmentsSession(t *testing.T) {
 	attachmentsSessionThrowIfStreamIsUseTwice(t, driver)
 	attachmentsSessionGetAttachmentReleasesResources(t, driver)
 	attachmentsSessionDeleteAttachmentsUsingCommand(t, driver)
+
+	// tests unique to go
+	goAttachmentsSessionGetIfModified(t, driver)
+	goAttachmentsOperations(t, driver)
+	goAttachmentsGetMultipleAndRange(t, driver)
 }
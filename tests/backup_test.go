@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func goBackupCanBackupToLocalFolder(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	dir, err := ioutil.TempDir("", "ravendb-backup")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	config := &ravendb.PeriodicBackupConfiguration{
+		Name:                "local",
+		FullBackupFrequency: "0 2 * * *",
+	}
+	config.BackupType = ravendb.BackupTypeBackup
+	config.LocalSettings = &ravendb.LocalSettings{FolderPath: dir}
+
+	updateOp := ravendb.NewUpdatePeriodicBackupOperation(config)
+	err = store.Maintenance().Send(updateOp)
+	assert.NoError(t, err)
+	taskID := updateOp.Command.Result.TaskID
+	assert.True(t, taskID > 0)
+
+	operation, err := store.Maintenance().SendAsync(ravendb.NewStartBackupOperation(true, taskID))
+	assert.NoError(t, err)
+	err = operation.WaitForCompletion()
+	assert.NoError(t, err)
+
+	statusOp := ravendb.NewGetPeriodicBackupStatusOperation(taskID)
+	err = store.Maintenance().Send(statusOp)
+	assert.NoError(t, err)
+	status := statusOp.Command.Result.Status
+	assert.NotNil(t, status)
+	assert.Equal(t, taskID, status.TaskID)
+	assert.True(t, status.IsFull)
+	assert.NotNil(t, status.LastFullBackup)
+	assert.Nil(t, status.Error)
+}
+
+func TestBackup(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	// tests unique to go
+	goBackupCanBackupToLocalFolder(t, driver)
+}
@@ -1,6 +1,9 @@
 package tests
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -151,6 +154,103 @@ type FooBar struct {
 	Name string
 }
 
+func goBulkInsertsLoadFixtures(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	dir := t.TempDir()
+	err = os.MkdirAll(filepath.Join(dir, "users"), 0755)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "users", "1.json"), []byte(`{"name": "John"}`), 0644)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "more.ndjson"), []byte("{\"Id\": \"users/2\", \"name\": \"Mary\"}\n"), 0644)
+	assert.NoError(t, err)
+
+	options := &ravendb.FixturesOptions{
+		IDField:    "Id",
+		Collection: "Users",
+	}
+	n, err := ravendb.LoadFixtures(store, dir, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	{
+		session := openSessionMust(t, store)
+		var user *User
+		err = session.Load(&user, "users/2")
+		assert.NoError(t, err)
+		assert.Equal(t, "Mary", *user.Name)
+
+		var users []*User
+		q := session.QueryCollection("Users")
+		err = q.GetResults(&users)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(users))
+		session.Close()
+	}
+}
+
+func goBulkInsertsAttachmentsCountersAndTimeSeries(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	baseline := time.Now().UTC().Truncate(time.Second)
+
+	{
+		bulkInsert := store.BulkInsert("")
+		err = bulkInsert.StoreWithID(&FooBar{Name: "John"}, "foobars/1", nil)
+		assert.NoError(t, err)
+		err = bulkInsert.CountersFor("foobars/1").Increment("likes", 5)
+		assert.NoError(t, err)
+		err = bulkInsert.AttachmentsFor("foobars/1").Store("file1", strings.NewReader("abc"), "text/plain")
+		assert.NoError(t, err)
+		err = bulkInsert.TimeSeriesFor("foobars/1", "HeartRate").Append(baseline, []float64{60}, "watch")
+		assert.NoError(t, err)
+		err = bulkInsert.Close()
+		assert.NoError(t, err)
+	}
+
+	{
+		session := openSessionMust(t, store)
+		result, err := session.Advanced().Attachments().GetByID("foobars/1", "file1")
+		assert.NoError(t, err)
+		data, err := ioutil.ReadAll(result.Data)
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", string(data))
+		result.Close()
+
+		var foobar *FooBar
+		err = session.Load(&foobar, "foobars/1")
+		assert.NoError(t, err)
+		meta, err := session.Advanced().GetMetadataFor(foobar)
+		assert.NoError(t, err)
+		counters, ok := meta.Get(ravendb.MetadataCounters)
+		assert.True(t, ok)
+		assert.Equal(t, []interface{}{"likes"}, counters)
+
+		documentCounters, err := session.CountersFor("foobars/1")
+		assert.NoError(t, err)
+		likes, err := documentCounters.Get("likes")
+		assert.NoError(t, err)
+		if assert.NotNil(t, likes) {
+			assert.Equal(t, int64(5), *likes)
+		}
+
+		ts, err := session.TimeSeriesFor("foobars/1", "HeartRate")
+		assert.NoError(t, err)
+		entries, err := ts.Get(nil, nil, 0, 0)
+		assert.NoError(t, err)
+		if assert.Equal(t, 1, len(entries)) {
+			assert.True(t, baseline.Equal(time.Time(entries[0].Timestamp)))
+			assert.Equal(t, []float64{60}, entries[0].Values)
+			assert.Equal(t, "watch", entries[0].Tag)
+		}
+		session.Close()
+	}
+}
+
 func TestBulkInserts(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -161,4 +261,8 @@ func TestBulkInserts(t *testing.T) {
 	bulkInsertsTestShouldNotAcceptIdsEndingWithPipeLine(t, driver)
 	bulkInsertsTestKilledToEarly(t, driver)
 	bulkInsertsTestCanModifyMetadataWithBulkInsert(t, driver)
+
+	// tests unique to go
+	goBulkInsertsLoadFixtures(t, driver)
+	goBulkInsertsAttachmentsCountersAndTimeSeries(t, driver)
 }
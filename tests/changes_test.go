@@ -410,6 +410,38 @@ func changesTestNotificationOnWrongDatabaseShouldNotCrashServer(t *testing.T, dr
 	assert.NoError(t, err)
 }
 
+func goChangesDebugInfo(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	changes := store.Changes("")
+	err := changes.EnsureConnectedNow()
+	assert.NoError(t, err)
+	defer changes.Close()
+
+	cancel1, err := changes.ForDocument("users/1", func(*ravendb.DocumentChange) {})
+	assert.NoError(t, err)
+	cancel2, err := changes.ForAllIndexes(func(*ravendb.IndexChange) {})
+	assert.NoError(t, err)
+
+	info := changes.DebugInfo()
+	assert.True(t, info.Connected)
+	assert.Equal(t, 1, info.ConnectCount)
+	assert.Equal(t, 0, info.ReconnectCount)
+	assert.Nil(t, info.LastError)
+	assert.Equal(t, 0, len(info.PendingCommands))
+	assert.Equal(t, 2, len(info.Subscriptions))
+	assert.Equal(t, "all-indexes", info.Subscriptions[0].Name)
+	assert.Equal(t, "watch-doc users/1", info.Subscriptions[1].Command)
+	assert.Equal(t, 1, info.Subscriptions[1].HandlersCount)
+	assert.Nil(t, info.Subscriptions[1].LastError)
+
+	cancel1()
+	cancel2()
+	info = changes.DebugInfo()
+	assert.Equal(t, 0, len(info.Subscriptions))
+}
+
 func TestChanges(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -426,4 +458,7 @@ func TestChanges(t *testing.T) {
 	// TODO: order different than Java's
 	changesTestCanCanNotificationAboutDocumentsStartingWiths(t, driver)
 	changesTestCanCanNotificationAboutDocumentsFromCollection(t, driver)
+
+	// tests unique to go
+	goChangesDebugInfo(t, driver)
 }
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func openClusterWideSessionMust(t *testing.T, store *ravendb.DocumentStore) *ravendb.DocumentSession {
+	session, err := store.OpenSessionWithOptions(&ravendb.SessionOptions{
+		TransactionMode: ravendb.TransactionModeClusterWide,
+	})
+	assert.NoError(t, err)
+	return session
+}
+
+func goClusterTransactionCanCreateUpdateAndDeleteCompareExchangeValues(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	userType := reflect.TypeOf(&User{})
+	{
+		session := openClusterWideSessionMust(t, store)
+		ct, err := session.Advanced().ClusterTransaction()
+		assert.NoError(t, err)
+		user := &User{}
+		user.setName("Karmel")
+		_, err = ct.CreateCompareExchangeValue("usernames/karmel", user)
+		assert.NoError(t, err)
+		err = session.Store(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+	{
+		session := openClusterWideSessionMust(t, store)
+		ct, err := session.Advanced().ClusterTransaction()
+		assert.NoError(t, err)
+		v, err := ct.GetCompareExchangeValue(userType, "usernames/karmel")
+		assert.NoError(t, err)
+		assert.NotNil(t, v)
+		assert.Equal(t, "Karmel", *v.Value.(*User).Name)
+		// tracked values don't hit the server again
+		v2, err := ct.GetCompareExchangeValue(userType, "usernames/karmel")
+		assert.NoError(t, err)
+		assert.True(t, v == v2)
+		missing, err := ct.GetCompareExchangeValue(userType, "usernames/missing")
+		assert.NoError(t, err)
+		assert.Nil(t, missing)
+		assert.Equal(t, 2, session.Advanced().GetNumberOfRequests())
+
+		// changes are detected on SaveChanges
+		v.Value.(*User).setName("Karmel2")
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+	{
+		session := openClusterWideSessionMust(t, store)
+		ct, err := session.Advanced().ClusterTransaction()
+		assert.NoError(t, err)
+		lazy1, err := ct.LazyGetCompareExchangeValue(userType, "usernames/karmel")
+		assert.NoError(t, err)
+		lazy2, err := ct.LazyGetCompareExchangeValues(userType, []string{"usernames/karmel", "usernames/missing"})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, session.Advanced().GetNumberOfRequests())
+
+		var v *ravendb.CompareExchangeValue
+		err = lazy1.GetValue(&v)
+		assert.NoError(t, err)
+		assert.Equal(t, "Karmel2", *v.Value.(*User).Name)
+		values := map[string]*ravendb.CompareExchangeValue{}
+		err = lazy2.GetValue(values)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(values))
+		assert.True(t, v == values["usernames/karmel"])
+		assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+
+		err = ct.DeleteCompareExchangeValue(v)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+	{
+		op, err := ravendb.NewGetCompareExchangeValueOperation(userType, "usernames/karmel")
+		assert.NoError(t, err)
+		err = store.Operations().Send(op, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, op.Command.Result)
+	}
+}
+
+func goClusterTransactionRequiresClusterWideSession(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+	_, err := session.Advanced().ClusterTransaction()
+	assert.Error(t, err)
+}
+
+func TestClusterTransaction(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	// tests unique to go
+	goClusterTransactionCanCreateUpdateAndDeleteCompareExchangeValues(t, driver)
+	goClusterTransactionRequiresClusterWideSession(t, driver)
+}
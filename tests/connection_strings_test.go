@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func connectionStringsCanCreateGetAndDeleteConnectionStrings(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	ravenConnectionString := ravendb.NewRavenConnectionString()
+	ravenConnectionString.Name = "r1"
+	ravenConnectionString.Database = "db1"
+	ravenConnectionString.TopologyDiscoveryUrls = []string{"http://localhost:8080"}
+
+	sqlConnectionString := ravendb.NewSqlConnectionString("s1", "test", "System.Data.SqlClient")
+
+	err := store.Maintenance().Send(ravendb.NewPutConnectionStringOperation(ravenConnectionString))
+	assert.NoError(t, err)
+	err = store.Maintenance().Send(ravendb.NewPutConnectionStringOperation(sqlConnectionString))
+	assert.NoError(t, err)
+
+	{
+		op := ravendb.NewGetConnectionStringsOperation()
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		res := op.Command.Result
+		assert.Equal(t, 1, len(res.RavenConnectionStrings))
+		assert.Equal(t, "db1", res.RavenConnectionStrings["r1"].Database)
+		assert.Equal(t, 1, len(res.SqlConnectionStrings))
+		assert.Equal(t, "test", res.SqlConnectionStrings["s1"].ConnectionString)
+	}
+	{
+		op, err := ravendb.NewGetConnectionStringOperation("s1", ravendb.ConnectionStringTypeSQL)
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		res := op.Command.Result
+		assert.Empty(t, res.RavenConnectionStrings)
+		assert.Equal(t, 1, len(res.SqlConnectionStrings))
+	}
+	{
+		op, err := ravendb.NewRemoveConnectionStringOperation("s1", ravendb.ConnectionStringTypeSQL)
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.True(t, op.Command.Result.RaftCommandIndex > 0)
+	}
+	{
+		op := ravendb.NewGetConnectionStringsOperation()
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		res := op.Command.Result
+		assert.Equal(t, 1, len(res.RavenConnectionStrings))
+		assert.Empty(t, res.SqlConnectionStrings)
+	}
+}
+
+func TestConnectionStrings(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	connectionStringsCanCreateGetAndDeleteConnectionStrings(t, driver)
+}
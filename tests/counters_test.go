@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func countersIncrementAndGet(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		err = session.StoreWithID(&User{}, "users/2")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	batch := &ravendb.CounterBatch{
+		Documents: []*ravendb.DocumentCountersOperation{
+			{
+				DocumentID: "users/1",
+				Operations: []*ravendb.CounterOperation{
+					ravendb.NewCounterOperationIncrement("likes", 10),
+					ravendb.NewCounterOperationIncrement("dislikes", 2),
+				},
+			},
+			{
+				DocumentID: "users/2",
+				Operations: []*ravendb.CounterOperation{
+					ravendb.NewCounterOperationIncrement("likes", 1),
+				},
+			},
+		},
+	}
+	batchOp := ravendb.NewCounterBatchOperation(batch)
+	err = store.Operations().Send(batchOp, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(batchOp.Command.Result.Counters))
+
+	{
+		op := ravendb.NewGetCountersOperation("users/1", []string{"likes", "doesnotexist"}, false)
+		err = store.Operations().Send(op, nil)
+		assert.NoError(t, err)
+		counters := op.Command.Result.Counters
+		assert.Equal(t, 2, len(counters))
+		assert.Equal(t, "likes", counters[0].CounterName)
+		assert.Equal(t, int64(10), counters[0].TotalValue)
+		assert.Nil(t, counters[1])
+	}
+
+	{
+		op := ravendb.NewGetCountersOperation("users/1", nil, true)
+		err = store.Operations().Send(op, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(op.Command.Result.Counters))
+		for _, counter := range op.Command.Result.Counters {
+			assert.NotEmpty(t, counter.CounterValues)
+		}
+	}
+
+	batch = &ravendb.CounterBatch{
+		Documents: []*ravendb.DocumentCountersOperation{
+			{
+				DocumentID: "users/1",
+				Operations: []*ravendb.CounterOperation{
+					ravendb.NewCounterOperationDelete("dislikes"),
+				},
+			},
+		},
+	}
+	err = store.Operations().Send(ravendb.NewCounterBatchOperation(batch), nil)
+	assert.NoError(t, err)
+
+	{
+		op := ravendb.NewGetCountersOperation("users/1", nil, false)
+		err = store.Operations().Send(op, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(op.Command.Result.Counters))
+		assert.Equal(t, "likes", op.Command.Result.Counters[0].CounterName)
+	}
+}
+
+func countersSessionIncrementGetAndDelete(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		counters, err := session.CountersFor(user)
+		assert.NoError(t, err)
+		err = counters.Increment("likes", 5)
+		assert.NoError(t, err)
+		err = counters.Increment("likes", 2)
+		assert.NoError(t, err)
+		err = counters.Increment("dislikes", 1)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+
+		// values returned by SaveChanges are cached
+		n := session.Advanced().GetNumberOfRequests()
+		value, err := counters.Get("likes")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), *value)
+		assert.Equal(t, n, session.Advanced().GetNumberOfRequests())
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		counters, err := session.CountersFor("users/1")
+		assert.NoError(t, err)
+
+		all, err := counters.GetAll()
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{"likes": 7, "dislikes": 1}, all)
+
+		// everything is cached after GetAll
+		n := session.Advanced().GetNumberOfRequests()
+		value, err := counters.Get("dislikes")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), *value)
+		value, err = counters.Get("doesnotexist")
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+		assert.Equal(t, n, session.Advanced().GetNumberOfRequests())
+
+		err = counters.Delete("dislikes")
+		assert.NoError(t, err)
+		err = counters.Increment("dislikes", 1)
+		assert.Error(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		var user *User
+		err = session.Load(&user, "users/1")
+		assert.NoError(t, err)
+		counters, err := session.CountersFor(user)
+		assert.NoError(t, err)
+
+		// loaded document lists its counters so missing ones are known
+		n := session.Advanced().GetNumberOfRequests()
+		value, err := counters.Get("dislikes")
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+		assert.Equal(t, n, session.Advanced().GetNumberOfRequests())
+
+		value, err = counters.Get("likes")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), *value)
+		session.Close()
+	}
+}
+
+func TestCounters(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	countersIncrementAndGet(t, driver)
+	countersSessionIncrementGetAndDelete(t, driver)
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/ravendb/ravendb-go-client"
@@ -712,6 +713,121 @@ func crudTestCrudCanUpdatePropertyFromNullToObject(t *testing.T, driver *RavenTe
 	}
 }
 
+func goCrudLargeDocumentGuard(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	conventions := store.GetRequestExecutor("").GetConventions()
+	conventions.LargeDocumentSize = 1024
+	defer func() {
+		conventions.LargeDocumentSize = 0
+		conventions.OnLargeDocument = nil
+	}()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName(strings.Repeat("a", 2048))
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		largeErr, ok := err.(*ravendb.LargeDocumentError)
+		assert.True(t, ok)
+		if ok {
+			assert.Equal(t, "users/1", largeErr.Document.ID)
+			assert.Equal(t, "Users", largeErr.Document.Collection)
+			assert.True(t, largeErr.Document.Size > 2048)
+		}
+		session.Close()
+	}
+
+	var reported []*ravendb.LargeDocumentInfo
+	conventions.OnLargeDocument = func(info *ravendb.LargeDocumentInfo) error {
+		reported = append(reported, info)
+		return nil
+	}
+
+	{
+		session := openSessionMust(t, store)
+		small := &User{}
+		small.setName("small")
+		err = session.StoreWithID(small, "users/2")
+		assert.NoError(t, err)
+		user := &User{}
+		user.setName(strings.Repeat("a", 2048))
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(reported))
+		assert.Equal(t, "users/1", reported[0].ID)
+		session.Close()
+	}
+}
+
+func goCrudSaveChangesWithResult(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	var events []*ravendb.AfterSaveChangesEventArgs
+	listenerID := store.AddAfterSaveChangesListener(func(event *ravendb.AfterSaveChangesEventArgs) {
+		events = append(events, event)
+	})
+	defer store.RemoveAfterSaveChangesListener(listenerID)
+
+	{
+		session := openSessionMust(t, store)
+		user1 := &User{}
+		user1.setName("John")
+		err = session.StoreWithID(user1, "users/1")
+		assert.NoError(t, err)
+		user2 := &User{}
+		user2.setName("Mary")
+		err = session.StoreWithID(user2, "users/2")
+		assert.NoError(t, err)
+
+		result, err := session.SaveChangesWithResult()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(result.Documents))
+
+		saved := result.GetDocument(user2)
+		assert.NotNil(t, saved)
+		assert.Equal(t, "users/2", saved.ID)
+		assert.False(t, saved.LastModified.IsZero())
+		changeVector, err := session.Advanced().GetChangeVectorFor(user2)
+		assert.NoError(t, err)
+		assert.Equal(t, *changeVector, saved.ChangeVector)
+
+		assert.Equal(t, 2, len(events))
+		assert.Equal(t, "users/1", events[0].DocumentID)
+		assert.Equal(t, result.Documents[0].ChangeVector, events[0].ChangeVector)
+
+		// nothing to save
+		result, err = session.SaveChangesWithResult()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(result.Documents))
+
+		// patched documents are reported with their new change vector
+		err = session.Patch(user1, "name", "Johnny")
+		assert.NoError(t, err)
+		result, err = session.SaveChangesWithResult()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(result.Documents))
+		saved = result.GetDocument(user1)
+		assert.NotNil(t, saved)
+		assert.False(t, saved.LastModified.IsZero())
+		changeVector, err = session.Advanced().GetChangeVectorFor(user1)
+		assert.NoError(t, err)
+		assert.Equal(t, *changeVector, saved.ChangeVector)
+		assert.Equal(t, 3, len(events))
+		assert.Equal(t, "users/1", events[2].DocumentID)
+
+		session.Close()
+	}
+}
+
 func TestCrud(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -731,4 +847,8 @@ func TestCrud(t *testing.T) {
 	crudTestCrudOperationsWithArrayInObject3(t, driver)
 	crudTestCrudOperationsWithArrayInObject4(t, driver)
 	crudTestCrudOperationsWithArrayOfArrays(t, driver)
+
+	// tests unique to go
+	goCrudLargeDocumentGuard(t, driver)
+	goCrudSaveChangesWithResult(t, driver)
 }
@@ -56,6 +56,35 @@ func deleteByQueryCanDeleteByQuery(t *testing.T, driver *RavenTestDriver) {
 	}
 }
 
+func goDeleteByQueryReturnsBulkOperationResult(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.Store(&User{Age: 5})
+		assert.NoError(t, err)
+		err = session.Store(&User{Age: 10})
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	indexQuery := ravendb.NewIndexQuery("from users where age == 5")
+	operation, err := ravendb.NewDeleteByQueryOperation(indexQuery, nil)
+	assert.NoError(t, err)
+	asyncOp, err := store.Operations().SendAsync(operation, nil)
+	assert.NoError(t, err)
+
+	var result ravendb.BulkOperationResult
+	err = asyncOp.WaitForCompletionResult(&result)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, int64(1), result.DocumentsProcessed)
+}
+
 func deleteByQueryCanDeleteByQueryWaitUsingChanges(t *testing.T, driver *RavenTestDriver) {
 	var err error
 	store := driver.getDocumentStoreMust(t)
@@ -124,4 +153,7 @@ func TestDeleteByQuery(t *testing.T) {
 	deleteByQueryCanDeleteByQuery(t, driver)
 
 	deleteByQueryCanDeleteByQueryWaitUsingChanges(t, driver)
+
+	// tests unique to go
+	goDeleteByQueryReturnsBulkOperationResult(t, driver)
 }
@@ -247,6 +247,55 @@ func enableReplicationTests() bool {
 	return false
 }
 
+func goDocumentReplicationCanModifyConflictSolverAndGetPerformance(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	source := driver.getDocumentStoreMust(t)
+	defer source.Close()
+
+	destination := driver.getDocumentStoreMust(t)
+	defer destination.Close()
+
+	{
+		scripts := map[string]*ravendb.ScriptResolver{
+			"Users": {Script: "return docs[0];"},
+		}
+		op, err := ravendb.NewModifyConflictSolverOperation(destination.GetDatabase(), scripts, true)
+		assert.NoError(t, err)
+		err = destination.Maintenance().Server().Send(op)
+		assert.NoError(t, err)
+		assert.NotNil(t, op.Command.Result)
+
+		getRecord := ravendb.NewGetDatabaseRecordOperation(destination.GetDatabase())
+		err = destination.Maintenance().Server().Send(getRecord)
+		assert.NoError(t, err)
+		solver := getRecord.Command.Result.ConflictSolverConfig
+		assert.True(t, solver.ResolveToLatest)
+		assert.Equal(t, "return docs[0];", solver.ResolveByCollection["Users"].Script)
+	}
+
+	driver.setupReplication(source, destination)
+	{
+		session := openSessionMust(t, source)
+		user := &User{}
+		user.setName("Arek")
+		err = session.Store(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	var outgoing []*ravendb.OutgoingReplicationPerformanceStats
+	for i := 0; i < 50 && len(outgoing) == 0; i++ {
+		op := ravendb.NewGetReplicationPerformanceStatisticsOperation()
+		err = source.Maintenance().Send(op)
+		assert.NoError(t, err)
+		outgoing = op.Command.Result.Outgoing
+		time.Sleep(time.Millisecond * 100)
+	}
+	assert.NotEmpty(t, outgoing)
+}
+
 func TestDocumentReplication(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -261,4 +310,7 @@ func TestDocumentReplication(t *testing.T) {
 	documentReplication_canReplicateDocument(t, driver)
 	documentReplication_getConflictsResult_command_should_work_properly(t, driver)
 	documentReplication_shouldCreateConflictThenResolveIt(t, driver)
+
+	// tests unique to go
+	goDocumentReplicationCanModifyConflictSolverAndGetPerformance(t, driver)
 }
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func goEtlCanAddUpdateResetAndToggleRavenEtl(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	src := driver.getDocumentStoreMust(t)
+	defer src.Close()
+
+	dst := driver.getDocumentStoreMust(t)
+	defer dst.Close()
+
+	connectionString := ravendb.NewRavenConnectionString()
+	connectionString.Name = "etl-" + dst.GetDatabase()
+	connectionString.Database = dst.GetDatabase()
+	connectionString.TopologyDiscoveryUrls = dst.GetUrls()
+	err = src.Maintenance().Send(ravendb.NewPutConnectionStringOperation(connectionString))
+	assert.NoError(t, err)
+
+	configuration := &ravendb.RavenEtlConfiguration{}
+	configuration.Name = "users-etl"
+	configuration.ConnectionStringName = connectionString.Name
+	configuration.Transforms = []*ravendb.Transformation{
+		{
+			Name:        "users",
+			Collections: []string{"Users"},
+			Script:      "loadToUsers(this);",
+		},
+	}
+	addOp, err := ravendb.NewAddEtlOperation(configuration)
+	assert.NoError(t, err)
+	err = src.Maintenance().Send(addOp)
+	assert.NoError(t, err)
+	taskID := addOp.Command.Result.TaskID
+	assert.True(t, taskID > 0)
+
+	var id string
+	{
+		session := openSessionMust(t, src)
+		user := &User{}
+		user.setName("Joe")
+		err = session.Store(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		id = user.ID
+		session.Close()
+	}
+	var fetchedUser *User
+	err = driver.waitForDocumentToReplicate(dst, &fetchedUser, id, time.Second*10)
+	assert.NoError(t, err)
+	if assert.NotNil(t, fetchedUser) {
+		assert.Equal(t, "Joe", *fetchedUser.Name)
+	}
+
+	configuration.Transforms[0].Script = "this.Name = 'changed'; loadToUsers(this);"
+	updateOp, err := ravendb.NewUpdateEtlOperation(taskID, configuration)
+	assert.NoError(t, err)
+	err = src.Maintenance().Send(updateOp)
+	assert.NoError(t, err)
+	taskID = updateOp.Command.Result.TaskID
+
+	resetOp, err := ravendb.NewResetEtlOperation(configuration.Name, "users")
+	assert.NoError(t, err)
+	err = src.Maintenance().Send(resetOp)
+	assert.NoError(t, err)
+
+	toggleOp, err := ravendb.NewToggleOngoingTaskStateOperation(taskID, ravendb.OngoingTaskTypeRavenEtl, true)
+	assert.NoError(t, err)
+	err = src.Maintenance().Send(toggleOp)
+	assert.NoError(t, err)
+	assert.Equal(t, taskID, toggleOp.Command.Result.TaskID)
+}
+
+func TestEtl(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	if !enableReplicationTests() {
+		fmt.Printf("Skipping TestEtl because RAVEN_License env variable is not set\n")
+		return
+	}
+
+	// tests unique to go
+	goEtlCanAddUpdateResetAndToggleRavenEtl(t, driver)
+}
@@ -1,9 +1,10 @@
 package tests
 
 import (
+	"testing"
+
 	"github.com/ravendb/ravendb-go-client"
 	"github.com/stretchr/testify/assert"
-	"testing"
 )
 
 func getDatabaseRecordCanGetDatabaseRecord(t *testing.T, driver *RavenTestDriver) {
@@ -17,6 +18,56 @@ func getDatabaseRecordCanGetDatabaseRecord(t *testing.T, driver *RavenTestDriver
 	assert.Equal(t, op.Command.Result.DatabaseName, store.GetDatabase())
 }
 
+func goGetDatabaseRecordCanCreateWithFullRecordAndToggleState(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	databaseName := store.GetDatabase() + "_full"
+	record := ravendb.NewDatabaseRecord()
+	record.DatabaseName = databaseName
+	record.Settings["Indexing.MapTimeoutInSec"] = "10"
+	record.Revisions = &ravendb.RevisionsConfiguration{
+		DefaultConfig: &ravendb.RevisionsCollectionConfiguration{
+			MinimumRevisionsToKeep: 5,
+		},
+	}
+	err = store.Maintenance().Server().Send(ravendb.NewCreateDatabaseOperation(record, 1))
+	assert.NoError(t, err)
+	defer func() {
+		_ = store.Maintenance().Server().Send(ravendb.NewDeleteDatabasesOperation(databaseName, true))
+	}()
+
+	getRecord := func() *ravendb.DatabaseRecordWithEtag {
+		op := ravendb.NewGetDatabaseRecordOperation(databaseName)
+		err := store.Maintenance().Server().Send(op)
+		assert.NoError(t, err)
+		return op.Command.Result
+	}
+	res := getRecord()
+	assert.Equal(t, "10", res.Settings["Indexing.MapTimeoutInSec"])
+	assert.Equal(t, int64(5), res.Revisions.DefaultConfig.MinimumRevisionsToKeep)
+	assert.False(t, res.Disabled)
+
+	{
+		op, err := ravendb.NewToggleDatabasesStateOperation([]string{databaseName}, true)
+		assert.NoError(t, err)
+		err = store.Maintenance().Server().Send(op)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(op.Command.Result))
+		assert.True(t, op.Command.Result[0].Success)
+		assert.True(t, op.Command.Result[0].Disabled)
+		assert.True(t, getRecord().Disabled)
+	}
+	{
+		op, err := ravendb.NewToggleDatabasesStateOperation([]string{databaseName}, false)
+		assert.NoError(t, err)
+		err = store.Maintenance().Server().Send(op)
+		assert.NoError(t, err)
+		assert.False(t, getRecord().Disabled)
+	}
+}
+
 func TestGetDatabaseRecord(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -24,4 +75,7 @@ func TestGetDatabaseRecord(t *testing.T) {
 
 	// matches order of Java tests
 	getDatabaseRecordCanGetDatabaseRecord(t, driver)
+
+	// tests unique to go
+	goGetDatabaseRecordCanCreateWithFullRecordAndToggleState(t, driver)
 }
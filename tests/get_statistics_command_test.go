@@ -53,10 +53,53 @@ func getStatisticsCommandTestCanGetStats(t *testing.T, driver *RavenTestDriver)
 	}
 }
 
+func goGetStatisticsCanGetDetailedStats(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		err = session.Store(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		op := ravendb.NewGetDetailedStatisticsOperation("")
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		stats := op.Command.Result
+		assert.Equal(t, int64(1), stats.CountOfDocuments)
+		assert.True(t, stats.LastDatabaseEtag > 0)
+		assert.Equal(t, int64(0), stats.CountOfCompareExchange)
+	}
+
+	{
+		op := ravendb.NewGetDetailedCollectionStatisticsOperation()
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		stats := op.Command.Result
+		assert.Equal(t, int64(1), stats.CountOfDocuments)
+		users := stats.Collections["Users"]
+		assert.NotNil(t, users)
+		assert.Equal(t, "Users", users.Name)
+		assert.Equal(t, int64(1), users.CountOfDocuments)
+		assert.True(t, users.Size.SizeInBytes > 0)
+	}
+}
+
 func TestGetStatisticsCommand(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
 	defer recoverTest(t, destroy)
 
 	getStatisticsCommandTestCanGetStats(t, driver)
+
+	// tests unique to go
+	goGetStatisticsCanGetDetailedStats(t, driver)
 }
@@ -753,7 +753,7 @@ func goTestBulkInsertCoverage(t *testing.T, driver *RavenTestDriver) {
 		o := &FooBar{
 			Name: "John Doe",
 		}
-		// trigger BulkInsertOperation.escapeID
+		// ids with quotes and backslashes are sent as is
 		err = bulkInsert.StoreWithID(o, `FooBars/my-"-\id`, nil)
 		assert.NoError(t, err)
 		err = bulkInsert.Close()
@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	ravendb "github.com/ravendb/ravendb-go-client"
 	"github.com/stretchr/testify/assert"
@@ -45,6 +47,44 @@ func testIndexCanDeleteIndex(t *testing.T, driver *RavenTestDriver) {
 	assert.Equal(t, len(indexNames), 0)
 }
 
+func goIndexGetResetAndInvalidNames(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	index := NewUsersIndex()
+	err = index.Execute(store, nil, "")
+	assert.NoError(t, err)
+
+	{
+		op := ravendb.NewGetIndexOperation("UsersIndex")
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.NotNil(t, op.Command.Result)
+		assert.Equal(t, "UsersIndex", op.Command.Result.Name)
+	}
+	{
+		op := ravendb.NewGetIndexOperation("DoesNotExist")
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.Nil(t, op.Command.Result)
+	}
+	{
+		op, err := ravendb.NewResetIndexOperation("UsersIndex")
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+	}
+
+	// invalid names are reported as errors, not panics
+	err = store.Maintenance().Send(ravendb.NewGetIndexOperation(""))
+	assert.Error(t, err)
+	err = store.Maintenance().Send(ravendb.NewDeleteIndexOperation(""))
+	assert.Error(t, err)
+	err = store.Maintenance().Send(ravendb.NewPutIndexesOperation(ravendb.NewIndexDefinition()))
+	assert.Error(t, err)
+}
+
 func testIndexCanDisableAndEnableIndex(t *testing.T, driver *RavenTestDriver) {
 	var err error
 	store := driver.getDocumentStoreMust(t)
@@ -87,6 +127,137 @@ func testIndexCanDisableAndEnableIndex(t *testing.T, driver *RavenTestDriver) {
 	}
 }
 
+func goIndexCanDisableAndEnableIndexClusterWide(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	index := NewUsersIndex()
+	err = index.Execute(store, nil, "")
+	assert.NoError(t, err)
+
+	getStatus := func() ravendb.IndexRunningStatus {
+		op := ravendb.NewGetIndexingStatusOperation()
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		return op.Command.Result.Indexes[0].Status
+	}
+
+	{
+		op, err := ravendb.NewDisableIndexOperationClusterWide("UsersIndex")
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, ravendb.IndexRunningStatusDisabled, getStatus())
+
+	{
+		op, err := ravendb.NewEnableIndexOperationClusterWide("UsersIndex")
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, ravendb.IndexRunningStatusRunning, getStatus())
+}
+
+func goIndexPerformanceAndStaleness(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	index := NewUsersIndex()
+	err = index.Execute(store, nil, "")
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		err = session.Store(&User{})
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+	err = driver.waitForIndexing(store, store.GetDatabase(), 0)
+	assert.NoError(t, err)
+
+	{
+		op := ravendb.NewGetIndexStalenessOperation("UsersIndex")
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.False(t, op.Command.Result.IsStale)
+	}
+	{
+		op := ravendb.NewGetIndexPerformanceStatisticsOperation("UsersIndex")
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(op.Command.Result))
+		assert.Equal(t, "UsersIndex", op.Command.Result[0].Name)
+		assert.True(t, len(op.Command.Result[0].Performance) > 0)
+	}
+	{
+		op := ravendb.NewGetIndexErrorsOperation([]string{"UsersIndex"})
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(op.Command.Result))
+	}
+}
+
+func goIndexJavaScriptMaps(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	index := ravendb.NewIndexCreationTask("Users/ByNameJS")
+	index.Maps = []string{"map('Users', function (u) { return { Name: u.Name }; })"}
+	err = index.Execute(store, nil, "")
+	assert.NoError(t, err)
+
+	op := ravendb.NewGetIndexOperation("Users/ByNameJS")
+	err = store.Maintenance().Send(op)
+	assert.NoError(t, err)
+	assert.Equal(t, ravendb.IndexTypeJavaScriptMap, op.Command.Result.GetType())
+}
+
+func goIndexLockedErrorPreventsRedefinition(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	index := NewUsersIndex()
+	err = index.Execute(store, nil, "")
+	assert.NoError(t, err)
+
+	{
+		op, err := ravendb.NewSetIndexesLockOperation("UsersIndex", ravendb.IndexLockModeLockedError)
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+	}
+
+	changed := NewUsersIndex()
+	changed.Map = "from user in docs.users select new { user.name, user.age }"
+	err = changed.Execute(store, nil, "")
+	assert.Error(t, err)
+
+	{
+		op, err := ravendb.NewSetIndexesPriorityOperationWithParameters(&ravendb.SetIndexesPriorityParameters{
+			IndexNames: []string{"UsersIndex"},
+			Priority:   ravendb.IndexPriorityHigh,
+		})
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+	}
+	{
+		op := ravendb.NewGetIndexOperation("UsersIndex")
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.Equal(t, ravendb.IndexLockModeLockedError, op.Command.Result.LockMode)
+		assert.Equal(t, ravendb.IndexPriorityHigh, op.Command.Result.Priority)
+		assert.Equal(t, []string{index.Map}, op.Command.Result.Maps)
+	}
+}
+
 func testIndexGetCanIndexes(t *testing.T, driver *RavenTestDriver) {
 	var err error
 	store := driver.getDocumentStoreMust(t)
@@ -384,6 +555,35 @@ func testIndexCanGetIndexStatistics(t *testing.T, driver *RavenTestDriver) {
 	assert.Equal(t, len(indexStats), 1)
 }
 
+func testIndexCanWaitForIndexing(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	userIndex := NewUsers_Index()
+	err = userIndex.Execute(store, nil, "")
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		err = session.Store(&User{})
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	err = store.Maintenance().WaitForIndexing(ctx, "", "Users_Index")
+	assert.NoError(t, err)
+
+	op := ravendb.NewGetStatisticsOperation("")
+	err = store.Maintenance().Send(op)
+	assert.NoError(t, err)
+	assert.Empty(t, op.Command.Result.GetStaleIndexes())
+}
+
 func TestIndexOperations(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -402,4 +602,12 @@ func TestIndexOperations(t *testing.T) {
 	testIndexCanStopStartIndex(t, driver)
 	testIndexCanSetIndexLockMode(t, driver)
 	testIndexGetTerms(t, driver)
+	testIndexCanWaitForIndexing(t, driver)
+
+	// tests unique to go
+	goIndexGetResetAndInvalidNames(t, driver)
+	goIndexCanDisableAndEnableIndexClusterWide(t, driver)
+	goIndexPerformanceAndStaleness(t, driver)
+	goIndexLockedErrorPreventsRedefinition(t, driver)
+	goIndexJavaScriptMaps(t, driver)
 }
@@ -339,6 +339,10 @@ func indexesFromClientTestGetTerms(t *testing.T, driver *RavenTestDriver) {
 		assert.NoError(t, err)
 
 		indexName = stats.IndexName
+		assert.Equal(t, 1, stats.TotalResults)
+		assert.Equal(t, int64(1), stats.LongTotalResults)
+		assert.Equal(t, 0, stats.SkippedResults)
+		assert.False(t, stats.IsStale)
 
 		session.Close()
 	}
@@ -465,6 +469,24 @@ func indexesFromClientTestCanExplain(t *testing.T, driver *RavenTestDriver) {
 	explanation := explanations[0]
 	assert.NotEmpty(t, explanation.Index)
 	assert.NotEmpty(t, explanation.Reason)
+
+	{
+		session := openSessionMust(t, store)
+
+		q := session.QueryCollectionForType(userType)
+		explanations, err = q.Explain()
+		assert.NoError(t, err)
+		assert.Equal(t, len(explanations), 1)
+
+		q = session.QueryCollectionForType(userType)
+		q = q.WhereEquals("name", "Arek")
+		entries, err := q.GetIndexEntries()
+		assert.NoError(t, err)
+		assert.Equal(t, len(entries), 1)
+		assert.Contains(t, entries[0], "name")
+
+		session.Close()
+	}
 }
 
 func indexesFromClientTestMoreLikeThis(t *testing.T, driver *RavenTestDriver) {
@@ -0,0 +1,224 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func migrationsRunPending(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	nCalled := 0
+	migrations := []*ravendb.Migration{
+		{
+			Version:     2,
+			Description: "set age",
+			Up: func(ctx *ravendb.MigrationContext) error {
+				nCalled++
+				_, err := ctx.PatchByQuery("from Users update { this.age = 30 }")
+				return err
+			},
+		},
+		{
+			Version:     1,
+			Description: "rename",
+			Up: func(ctx *ravendb.MigrationContext) error {
+				nCalled++
+				result, err := ctx.PatchByQuery("from Users update { this.name = 'Jack' }")
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, int64(1), result.Total)
+				return nil
+			},
+		},
+	}
+
+	runner, err := ravendb.NewMigrationRunner(store, "", migrations...)
+	assert.NoError(t, err)
+
+	runner.DryRun = true
+	pending, err := runner.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(pending))
+	assert.Equal(t, int64(1), pending[0].Version)
+	assert.Equal(t, 0, nCalled)
+
+	runner.DryRun = false
+	applied, err := runner.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(applied))
+	assert.Equal(t, 2, nCalled)
+
+	{
+		session := openSessionMust(t, store)
+		var user *User
+		err = session.Load(&user, "users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "Jack", *user.Name)
+		assert.Equal(t, 30, user.Age)
+
+		var state *ravendb.MigrationsState
+		err = session.Load(&state, "migrations/state")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(state.Applied))
+		session.Close()
+	}
+
+	// already applied migrations don't run again
+	applied, err = runner.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(applied))
+	assert.Equal(t, 2, nCalled)
+
+	// failed migration isn't recorded
+	errFailed := errors.New("failed")
+	failing := &ravendb.Migration{
+		Version: 3,
+		Up: func(ctx *ravendb.MigrationContext) error {
+			return errFailed
+		},
+	}
+	runner, err = ravendb.NewMigrationRunner(store, "", append(migrations, failing)...)
+	assert.NoError(t, err)
+	_, err = runner.Run()
+	assert.True(t, errors.Is(err, errFailed))
+	pending, err = runner.Pending()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pending))
+}
+
+func migrationsInvalidMigrations(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	up := func(ctx *ravendb.MigrationContext) error { return nil }
+	_, err := ravendb.NewMigrationRunner(store, "", &ravendb.Migration{Version: 0, Up: up})
+	assert.Error(t, err)
+	_, err = ravendb.NewMigrationRunner(store, "", &ravendb.Migration{Version: 1})
+	assert.Error(t, err)
+	_, err = ravendb.NewMigrationRunner(store, "", &ravendb.Migration{Version: 1, Up: up}, &ravendb.Migration{Version: 1, Up: up})
+	assert.Error(t, err)
+}
+
+func migrationsStaleLock(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	nCalled := 0
+	migration := &ravendb.Migration{
+		Version: 1,
+		Up: func(ctx *ravendb.MigrationContext) error {
+			nCalled++
+			return nil
+		},
+	}
+	runner, err := ravendb.NewMigrationRunner(store, "", migration)
+	assert.NoError(t, err)
+	// by default the lock never expires
+	assert.Equal(t, time.Duration(0), runner.LockTimeout)
+
+	// a lock left behind by a crashed run
+	lock := map[string]interface{}{
+		"Owner":    "crashed",
+		"LockedAt": time.Now().UTC().Add(-time.Hour),
+	}
+	op, err := ravendb.NewPutCompareExchangeValueOperation("migrations/lock", lock, 0)
+	assert.NoError(t, err)
+	err = store.Operations().Send(op, nil)
+	assert.NoError(t, err)
+	assert.True(t, op.Command.Result.IsSuccessful)
+
+	runner.LockTimeout = 0
+	_, err = runner.Run()
+	_, ok := err.(*ravendb.ConcurrencyError)
+	assert.True(t, ok)
+	assert.Equal(t, 0, nCalled)
+
+	runner.LockTimeout = 2 * time.Hour
+	_, err = runner.Run()
+	_, ok = err.(*ravendb.ConcurrencyError)
+	assert.True(t, ok)
+
+	// stale lock is taken over
+	runner.LockTimeout = time.Minute
+	applied, err := runner.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(applied))
+	assert.Equal(t, 1, nCalled)
+
+	// lock is released after a run
+	op, err = ravendb.NewPutCompareExchangeValueOperation("migrations/lock", lock, 0)
+	assert.NoError(t, err)
+	err = store.Operations().Send(op, nil)
+	assert.NoError(t, err)
+	assert.True(t, op.Command.Result.IsSuccessful)
+
+	runner.LockTimeout = 0
+	_, err = runner.Run()
+	assert.Error(t, err)
+	err = runner.ForceUnlock()
+	assert.NoError(t, err)
+	_, err = runner.Run()
+	assert.NoError(t, err)
+	// nothing to unlock
+	err = runner.ForceUnlock()
+	assert.NoError(t, err)
+
+	// a run whose lock was taken over in the meantime stops
+	takeOver := &ravendb.Migration{
+		Version: 2,
+		Up: func(ctx *ravendb.MigrationContext) error {
+			if err := runner.ForceUnlock(); err != nil {
+				return err
+			}
+			op, err := ravendb.NewPutCompareExchangeValueOperation("migrations/lock", lock, 0)
+			if err != nil {
+				return err
+			}
+			return store.Operations().Send(op, nil)
+		},
+	}
+	next := &ravendb.Migration{
+		Version: 3,
+		Up: func(ctx *ravendb.MigrationContext) error {
+			nCalled++
+			return nil
+		},
+	}
+	runner, err = ravendb.NewMigrationRunner(store, "", migration, takeOver, next)
+	assert.NoError(t, err)
+	runner.LockTimeout = time.Hour
+	applied, err = runner.Run()
+	_, ok = err.(*ravendb.ConcurrencyError)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(applied))
+	assert.Equal(t, 1, nCalled)
+}
+
+func TestMigrations(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	migrationsRunPending(t, driver)
+	migrationsInvalidMigrations(t, driver)
+	migrationsStaleLock(t, driver)
+}
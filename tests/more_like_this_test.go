@@ -3,6 +3,7 @@ package tests
 import (
 	"fmt"
 	"math/rand"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -654,6 +655,31 @@ func moreLikeThisAssertMoreLikeThisHasMatchesFor(t *testing.T, index *ravendb.In
 	session.Close()
 }
 
+func goMoreLikeThisInvalidBuilder(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	var data []*Data
+	q := session.QueryCollectionForType(reflect.TypeOf(&Data{}))
+	q = q.MoreLikeThisWithBuilder(nil)
+	err := q.GetResults(&data)
+	assert.Error(t, err)
+
+	// builder must pick the source document
+	q = session.QueryCollectionForType(reflect.TypeOf(&Data{}))
+	q = q.MoreLikeThisWithBuilder(func(f ravendb.IMoreLikeThisBuilderForDocumentQuery) {})
+	err = q.GetResults(&data)
+	assert.Error(t, err)
+
+	q = session.QueryCollectionForType(reflect.TypeOf(&Data{}))
+	q = q.MoreLikeThis(nil)
+	err = q.GetResults(&data)
+	assert.Error(t, err)
+}
+
 type Identity struct {
 	ID string
 }
@@ -739,4 +765,7 @@ func TestMoreLikeThis(t *testing.T) {
 	moreLikeThisDoNotPassFieldNames(t, driver)
 	moreLikeThisCanGetResultsUsingTermVectorsLazy(t, driver)
 	moreLikeThisCanGetResultsUsingTermVectorsWithDocumentQuery(t, driver)
+
+	// tests unique to go
+	goMoreLikeThisInvalidBuilder(t, driver)
 }
@@ -146,6 +146,60 @@ func nextAndSeedIdentitiesTestSeedIdentityFor(t *testing.T, driver *RavenTestDri
 	}
 }
 
+func goNextAndSeedIdentitiesOperationsAndSession(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user1 := &User{}
+		user1.setLastName("Adi")
+		user2 := &User{}
+		user2.setLastName("Avivi")
+		err = session.StoreWithID(user1, "users|")
+		assert.NoError(t, err)
+		err = session.StoreWithID(user2, "users|")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		assert.Equal(t, "users/1", user1.ID)
+		assert.Equal(t, "users/2", user2.ID)
+		assert.False(t, session.Advanced().IsLoaded("users|"))
+		session.Close()
+	}
+
+	{
+		op, err := ravendb.NewNextIdentityForOperation("users")
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, op.Command.Result)
+	}
+
+	{
+		op, err := ravendb.NewSeedIdentityForOperation("users", 100, false)
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.Equal(t, 100, op.Command.Result)
+	}
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		err = session.StoreWithID(user, "users|")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		assert.Equal(t, "users/101", user.ID)
+		session.Close()
+	}
+
+	_, err = ravendb.NewNextIdentityForOperation(" ")
+	assert.Error(t, err)
+}
+
 func TestNextAndSeedIdentities(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -153,4 +207,7 @@ func TestNextAndSeedIdentities(t *testing.T) {
 
 	nextAndSeedIdentitiesTestNextIdentityFor(t, driver)
 	nextAndSeedIdentitiesTestSeedIdentityFor(t, driver)
+
+	// tests unique to go
+	goNextAndSeedIdentitiesOperationsAndSession(t, driver)
 }
@@ -10,6 +10,9 @@ import (
 
 func TestNonNilTimeError(t *testing.T) {
 	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
 	test_case_string_non_nil_error(t, driver)
 }
 
@@ -15,7 +15,10 @@ type CustomerNilTime struct {
 
 func TestNilTimeError(t *testing.T) {
 	driver := createTestDriver(t)
-	test_case_string_nil_error(t, driver)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	test_case_nil_time_error(t, driver)
 }
 
 func test_case_nil_time_error(t *testing.T, driver *RavenTestDriver) {
@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func goOngoingTasksCanGetToggleAndDeleteSubscriptionTask(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	opts := &ravendb.SubscriptionCreationOptions{
+		Name:  "users-sub",
+		Query: "from Users",
+	}
+	_, err := store.Subscriptions().Create(opts, "")
+	assert.NoError(t, err)
+
+	var task *ravendb.OngoingTaskSubscription
+	{
+		op, err := ravendb.NewGetOngoingTaskInfoOperationByName("users-sub", ravendb.OngoingTaskTypeSubscription)
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		task = op.Command.Result.(*ravendb.OngoingTaskSubscription)
+		assert.Equal(t, "users-sub", task.SubscriptionName)
+		assert.Equal(t, "from Users", task.Query)
+		assert.Equal(t, ravendb.OngoingTaskTypeSubscription, task.GetTask().TaskType)
+		assert.False(t, task.Disabled)
+	}
+	{
+		op, err := ravendb.NewToggleOngoingTaskStateOperation(task.TaskID, ravendb.OngoingTaskTypeSubscription, true)
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+
+		getOp, err := ravendb.NewGetOngoingTaskInfoOperation(task.TaskID, ravendb.OngoingTaskTypeSubscription)
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(getOp)
+		assert.NoError(t, err)
+		disabled := getOp.Command.Result.(*ravendb.OngoingTaskSubscription)
+		assert.True(t, disabled.Disabled)
+		assert.Equal(t, ravendb.OngoingTaskStateDisabled, disabled.TaskState)
+	}
+	{
+		op, err := ravendb.NewDeleteOngoingTaskOperation(task.TaskID, ravendb.OngoingTaskTypeSubscription)
+		assert.NoError(t, err)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+
+		subscriptions, err := store.Subscriptions().GetSubscriptions(0, 10, "")
+		assert.NoError(t, err)
+		assert.Empty(t, subscriptions)
+	}
+}
+
+func TestOngoingTasks(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	// tests unique to go
+	goOngoingTasksCanGetToggleAndDeleteSubscriptionTask(t, driver)
+}
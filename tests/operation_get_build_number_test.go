@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/ravendb/ravendb-go-client/serverwide/operations"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func goGetBuildNumber(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	operation := operations.OperationGetBuildNumber{}
+	err = store.Maintenance().Server().Send(&operation)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, operation.ProductVersion)
+	assert.NotEmpty(t, operation.FullVersion)
+
+	ok, err := operation.IsAtLeast("4.0")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = operation.IsAtLeast(operation.FullVersion)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = operation.IsAtLeast("999.0")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, err = operation.IsAtLeast("latest")
+	assert.Error(t, err)
+}
+
+func goGetLicenseStatus(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	operation := operations.OperationGetLicenseStatus{}
+	err = store.Maintenance().Server().Send(&operation)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, operation.Type)
+	assert.NotEmpty(t, operation.Attributes)
+	assert.False(t, operation.HasFeature("noSuchFeature"))
+}
+
+func goGetServerWideOperationStateForUnknownOperation(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	operation := ravendb.NewGetServerWideOperationStateOperation(-1)
+	err = store.Maintenance().Server().Send(operation)
+	assert.NoError(t, err)
+	assert.Nil(t, operation.Command.Result)
+}
+
+func TestGetBuildNumber(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	// tests unique to go
+	goGetBuildNumber(t, driver)
+	goGetLicenseStatus(t, driver)
+	goGetServerWideOperationStateForUnknownOperation(t, driver)
+}
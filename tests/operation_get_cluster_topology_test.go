@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"github.com/ravendb/ravendb-go-client"
 	"github.com/ravendb/ravendb-go-client/serverwide/operations"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -25,10 +26,29 @@ func getClusterTopologyTestCanGetTopology(t *testing.T, driver *RavenTestDriver)
 	assert.Equal(t, 0, len(topology.Promotables))
 }
 
+func goGetClusterTopologyCommand(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	command := ravendb.NewGetClusterTopologyCommand()
+	err := store.GetRequestExecutor("").ExecuteCommand(command, nil)
+	assert.NoError(t, err)
+	res := command.Result
+	assert.NotEmpty(t, res.Leader)
+	assert.Equal(t, res.Leader, res.NodeTag)
+	assert.Equal(t, 1, len(res.Topology.Members))
+	assert.NotEmpty(t, res.Topology.Members[res.NodeTag])
+	assert.Empty(t, res.Topology.Promotables)
+	assert.Empty(t, res.Topology.Watchers)
+}
+
 func TestGetClusterTopology(t *testing.T) {
     	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
 	defer recoverTest(t, destroy)
 
 	getClusterTopologyTestCanGetTopology(t, driver)
+
+	// tests unique to go
+	goGetClusterTopologyCommand(t, driver)
 }
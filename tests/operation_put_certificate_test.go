@@ -38,6 +38,59 @@ func putCertificateTest(t *testing.T, driver *RavenTestDriver) {
 
 }
 
+func goClientCertificateManagementTest(t *testing.T, driver *RavenTestDriver) {
+	var err error
+
+	store := driver.getSecuredDocumentStoreMust(t)
+	assert.NotNil(t, store)
+	defer store.Close()
+
+	permissions := map[string]string{
+		store.GetDatabase(): certificates.ReadWrite.String(),
+	}
+	create := certificates.NewOperationCreateClientCertificate("client cert", permissions, certificates.ValidUser, "")
+	err = store.Maintenance().Server().Send(create)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, create.RawData)
+
+	getAll := certificates.NewOperationGetCertificates(0, 20)
+	err = store.Maintenance().Server().Send(getAll)
+	assert.NoError(t, err)
+
+	var created *certificates.CertificateDefinition
+	for _, cert := range getAll.Results {
+		if cert.Name == "client cert" {
+			created = cert
+		}
+	}
+	if !assert.NotNil(t, created) {
+		return
+	}
+	assert.Equal(t, certificates.ValidUser.String(), created.SecurityClearance)
+	assert.Equal(t, certificates.ReadWrite.String(), created.Permissions[store.GetDatabase()])
+
+	permissions[store.GetDatabase()] = certificates.Read.String()
+	edit := certificates.NewOperationEditClientCertificate(created.Thumbprint, "renamed cert", permissions, certificates.ValidUser)
+	err = store.Maintenance().Server().Send(edit)
+	assert.NoError(t, err)
+
+	get := certificates.NewOperationGetCertificate(created.Thumbprint)
+	err = store.Maintenance().Server().Send(get)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(get.Results)) {
+		assert.Equal(t, "renamed cert", get.Results[0].Name)
+		assert.Equal(t, certificates.Read.String(), get.Results[0].Permissions[store.GetDatabase()])
+	}
+
+	err = store.Maintenance().Server().Send(certificates.NewOperationDeleteCertificate(created.Thumbprint))
+	assert.NoError(t, err)
+
+	get = certificates.NewOperationGetCertificate(created.Thumbprint)
+	err = store.Maintenance().Server().Send(get)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(get.Results))
+}
+
 func TestPutCertificateTest(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() {
@@ -47,4 +100,7 @@ func TestPutCertificateTest(t *testing.T) {
 	defer recoverTest(t, destroy)
 
 	putCertificateTest(t, driver)
+
+	// tests unique to go
+	goClientCertificateManagementTest(t, driver)
 }
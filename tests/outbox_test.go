@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type userRegistered struct {
+	UserID string
+	Name   string
+}
+
+func outboxStoreWithDocument(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	var msgID string
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		msg, err := ravendb.StoreOutboxMessage(session, "UserRegistered", &userRegistered{UserID: "users/1", Name: "John"})
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		msgID = msg.ID
+		assert.NotEmpty(t, msgID)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		var msg *ravendb.OutboxMessage
+		err = session.Load(&msg, msgID)
+		assert.NoError(t, err)
+		assert.Equal(t, "UserRegistered", msg.Type)
+		var payload *userRegistered
+		err = msg.GetPayload(&payload)
+		assert.NoError(t, err)
+		assert.Equal(t, "users/1", payload.UserID)
+		assert.Equal(t, "John", payload.Name)
+		session.Close()
+	}
+
+	_, err = ravendb.StoreOutboxMessage(nil, "UserRegistered", nil)
+	assert.Error(t, err)
+}
+
+func outboxDispatchAndDelete(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		for _, name := range []string{"John", "Mary"} {
+			_, err = ravendb.StoreOutboxMessage(session, "UserRegistered", &userRegistered{Name: name})
+			assert.NoError(t, err)
+		}
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	dispatcher, err := ravendb.NewOutboxDispatcher(store, "outbox", "")
+	assert.NoError(t, err)
+	delivered := make(chan string, 2)
+	err = dispatcher.Start(func(msg *ravendb.OutboxMessage) error {
+		var payload *userRegistered
+		if err := msg.GetPayload(&payload); err != nil {
+			return err
+		}
+		delivered <- payload.Name
+		return nil
+	})
+	assert.NoError(t, err)
+
+	var names []string
+	for len(names) < 2 {
+		select {
+		case name := <-delivered:
+			names = append(names, name)
+		case <-time.After(_reasonableWaitTime):
+			assert.Fail(t, "timed out waiting for outbox messages")
+			names = append(names, "", "")
+		}
+	}
+	assert.ElementsMatch(t, []string{"John", "Mary"}, names)
+
+	err = dispatcher.Close()
+	assert.NoError(t, err)
+	assert.NoError(t, dispatcher.Err())
+}
+
+func TestOutbox(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	// tests unique to go
+	outboxStoreWithDocument(t, driver)
+	outboxDispatchAndDelete(t, driver)
+}
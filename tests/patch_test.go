@@ -163,6 +163,124 @@ func patchTestthrowsOnInvalidScript(t *testing.T, driver *RavenTestDriver) {
 
 }
 
+func goPatchTestPatchDoesNotPersist(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("RavenDB")
+
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	patchRequest := &ravendb.PatchRequest{
+		Script: `output("old name: " + this.name); this.name = "Patched"`,
+	}
+	operation, err := ravendb.NewTestPatchOperation("users/1", patchRequest, nil)
+	assert.NoError(t, err)
+	err = store.Operations().Send(operation, nil)
+	assert.NoError(t, err)
+
+	result := operation.Command.Result
+	assert.Equal(t, "RavenDB", result.OriginalDocument["name"])
+	assert.Equal(t, "Patched", result.ModifiedDocument["name"])
+	assert.Equal(t, []string{"old name: RavenDB"}, result.GetDebugOutput())
+
+	{
+		session := openSessionMust(t, store)
+		var loadedUser *User
+		err = session.Load(&loadedUser, "users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, *loadedUser.Name, "RavenDB")
+		session.Close()
+	}
+}
+
+func goPatchTestMissingDocumentAndPatchIfMissing(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	patchRequest := &ravendb.PatchRequest{
+		Script: `this.name = $name`,
+		Values: map[string]interface{}{"name": "Patched"},
+	}
+	{
+		patchOperation, err := ravendb.NewPatchOperation("users/missing", nil, patchRequest, nil, false)
+		assert.NoError(t, err)
+		patchResult, err := store.Operations().SendPatchOperation(patchOperation, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, ravendb.PatchStatusDocumentDoesNotExist, patchResult.Status)
+		var user *User
+		err = patchResult.GetResult(&user)
+		assert.NoError(t, err)
+		assert.Nil(t, user)
+	}
+
+	patchIfMissing := &ravendb.PatchRequest{
+		Script: `this.name = $name; this["@metadata"] = { "@collection": "Users" }`,
+		Values: map[string]interface{}{"name": "Created"},
+	}
+	patchOperation, err := ravendb.NewPatchOperation("users/missing", nil, patchRequest, patchIfMissing, false)
+	assert.NoError(t, err)
+	patchResult, err := store.Operations().SendPatchOperation(patchOperation, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ravendb.PatchStatusCreated, patchResult.Status)
+	var user *User
+	err = patchResult.GetResult(&user)
+	assert.NoError(t, err)
+	assert.Equal(t, "Created", *user.Name)
+}
+
+func goPatchTestKillPatchByQuery(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		bulkInsert := store.BulkInsert("")
+		for i := 0; i < 10000; i++ {
+			user := &User{}
+			user.setName("RavenDB")
+			_, err = bulkInsert.Store(user, nil)
+			assert.NoError(t, err)
+		}
+		err = bulkInsert.Close()
+		assert.NoError(t, err)
+	}
+
+	// slow enough to still be running when we kill it
+	operation := ravendb.NewPatchByQueryOperation(`from Users update {
+		var n = 0;
+		for (var i = 0; i < 1000; i++) { n += i; }
+		this.name = "Patched" + n;
+	}`)
+	op, err := store.Operations().SendAsync(operation, nil)
+	assert.NoError(t, err)
+
+	var progressEvents []*ravendb.OperationProgress
+	op.OnProgress = func(progress *ravendb.OperationProgress) {
+		progressEvents = append(progressEvents, progress)
+		if len(progressEvents) == 1 {
+			err := op.Kill()
+			assert.NoError(t, err)
+		}
+	}
+	err = op.WaitForCompletion()
+	assert.IsType(t, &ravendb.OperationCancelledError{}, err)
+	assert.NotEmpty(t, progressEvents)
+	if len(progressEvents) > 0 {
+		assert.True(t, progressEvents[0].Processed < 10000)
+	}
+}
+
 func TestPatch(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -175,4 +293,9 @@ func TestPatch(t *testing.T) {
 
 	// TODO: not in order of Java
 	patchTestCanWaitForIndexAfterPatch(t, driver)
+
+	// tests unique to go
+	goPatchTestPatchDoesNotPersist(t, driver)
+	goPatchTestMissingDocumentAndPatchIfMissing(t, driver)
+	goPatchTestKillPatchByQuery(t, driver)
 }
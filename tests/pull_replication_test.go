@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func goPullReplicationCanReplicateFromHubToSink(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	hub := driver.getDocumentStoreMust(t)
+	defer hub.Close()
+
+	sink := driver.getDocumentStoreMust(t)
+	defer sink.Close()
+
+	{
+		op, err := ravendb.NewPutPullReplicationAsHubOperation(ravendb.NewPullReplicationDefinition("hub"))
+		assert.NoError(t, err)
+		err = hub.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.True(t, op.Command.Result.TaskID > 0)
+	}
+	{
+		connectionString := ravendb.NewRavenConnectionString()
+		connectionString.Name = "ConnectionString-" + hub.GetDatabase()
+		connectionString.Database = hub.GetDatabase()
+		connectionString.TopologyDiscoveryUrls = hub.GetUrls()
+		err = sink.Maintenance().Send(ravendb.NewPutConnectionStringOperation(connectionString))
+		assert.NoError(t, err)
+
+		pullSink := ravendb.NewPullReplicationAsSink(hub.GetDatabase(), connectionString.Name, "hub")
+		op, err := ravendb.NewUpdatePullReplicationAsSinkOperation(pullSink)
+		assert.NoError(t, err)
+		err = sink.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.True(t, op.Command.Result.TaskID > 0)
+	}
+	var id string
+	{
+		session := openSessionMust(t, hub)
+		user := &User{}
+		user.setName("Arek")
+		err = session.Store(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		id = user.ID
+		session.Close()
+	}
+	var fetchedUser *User
+	err = driver.waitForDocumentToReplicate(sink, &fetchedUser, id, time.Second*10)
+	assert.NoError(t, err)
+	if assert.NotNil(t, fetchedUser) {
+		assert.Equal(t, "Arek", *fetchedUser.Name)
+	}
+}
+
+func TestPullReplication(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	if !enableReplicationTests() {
+		fmt.Printf("Skipping TestPullReplication because RAVEN_License env variable is not set\n")
+		return
+	}
+
+	// tests unique to go
+	goPullReplicationCanReplicateFromHubToSink(t, driver)
+}
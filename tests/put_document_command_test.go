@@ -38,10 +38,53 @@ func putDocumentCommandCanPutDocumentUsingCommand(t *testing.T, driver *RavenTes
 	}
 }
 
+func putDocumentCommandPutIfMatch(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	user := &User{}
+	user.setName("Marcin")
+
+	// empty change vector means the document must not exist
+	result, err := store.Operations().PutIfMatch("users/1", user, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "users/1", result.ID)
+	changeVector := *result.ChangeVector
+
+	_, err = store.Operations().PutIfMatch("users/1", user, "")
+	_, ok := err.(*ravendb.ConcurrencyError)
+	assert.True(t, ok)
+
+	user.setName("Marcin2")
+	result, err = store.Operations().PutIfMatch("users/1", user, changeVector)
+	assert.NoError(t, err)
+	assert.NotEqual(t, changeVector, *result.ChangeVector)
+
+	// stale change vector
+	_, err = store.Operations().PutIfMatch("users/1", user, changeVector)
+	_, ok = err.(*ravendb.ConcurrencyError)
+	assert.True(t, ok)
+
+	{
+		session := openSessionMust(t, store)
+		var loadedUser *User
+		err = session.Load(&loadedUser, "users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "Marcin2", *loadedUser.Name)
+		metadata, err := session.Advanced().GetMetadataFor(loadedUser)
+		assert.NoError(t, err)
+		collection, _ := metadata.Get(ravendb.MetadataCollection)
+		assert.Equal(t, "Users", collection)
+		session.Close()
+	}
+}
+
 func TestPutDocumentCommand(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
 	defer recoverTest(t, destroy)
 
 	putDocumentCommandCanPutDocumentUsingCommand(t, driver)
+	putDocumentCommandPutIfMatch(t, driver)
 }
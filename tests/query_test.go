@@ -9,6 +9,7 @@ import (
 	"time"
 
 	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/ravendb/ravendb-go-client/rql"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -239,6 +240,19 @@ func queryQueryMapReduceWithSum(t *testing.T, driver *RavenTestDriver) {
 
 		session.Close()
 	}
+
+	{
+		session := openSessionMust(t, store)
+
+		var results []*ReduceResult
+		q := session.QueryCollectionForType(reflect.TypeOf(&User{}))
+		q = q.GroupBy("name").SelectKey().SelectSum(nil)
+		err := q.GetResults(&results)
+		_, ok := err.(*ravendb.IllegalArgumentError)
+		assert.True(t, ok)
+
+		session.Close()
+	}
 }
 
 func queryQueryMapReduceIndex(t *testing.T, driver *RavenTestDriver) {
@@ -322,6 +336,30 @@ func queryQueryWithSelect(t *testing.T, driver *RavenTestDriver) {
 
 		session.Close()
 	}
+
+	{
+		session := openSessionMust(t, store)
+
+		type userName struct {
+			Name string `json:"name"`
+		}
+		queryData := &ravendb.QueryData{
+			Fields:             []string{"name"},
+			Projections:        []string{"name"},
+			ProjectionBehavior: ravendb.ProjectionBehaviorFromDocument,
+		}
+		q := session.QueryCollectionForType(userType)
+		q = q.SelectFieldsWithQueryData(reflect.TypeOf(&userName{}), queryData)
+		var names []*userName
+		err := q.GetResults(&names)
+		assert.NoError(t, err)
+		assert.Equal(t, len(names), 3)
+		for _, n := range names {
+			assert.NotEmpty(t, n.Name)
+		}
+
+		session.Close()
+	}
 }
 
 func queryQueryWithWhereIn(t *testing.T, driver *RavenTestDriver) {
@@ -343,6 +381,21 @@ func queryQueryWithWhereIn(t *testing.T, driver *RavenTestDriver) {
 
 		session.Close()
 	}
+
+	{
+		session := openSessionMust(t, store)
+
+		// nested typed slices are flattened
+		var users []*User
+		q := session.QueryCollectionForType(userType)
+		q = q.WhereIn("name", []interface{}{[]string{"Tarzan", "no_such"}})
+		err := q.GetResults(&users)
+		assert.NoError(t, err)
+
+		assert.Equal(t, len(users), 1)
+
+		session.Close()
+	}
 }
 
 func queryQueryWithWhereBetween(t *testing.T, driver *RavenTestDriver) {
@@ -568,6 +621,17 @@ func queryQuerySearchWithOr(t *testing.T, driver *RavenTestDriver) {
 
 		assert.Equal(t, len(uniqueNames), 3)
 
+		var users []*User
+		q = session.QueryCollectionForType(userType)
+		q = q.SearchWithOperator("name", "Tarzan John", ravendb.SearchOperatorAnd)
+		q = q.Boost(3)
+		iq, err := q.GetIndexQuery()
+		assert.NoError(t, err)
+		assert.Equal(t, "from Users where boost(search(name, $p0, and), 3.0)", iq.GetQuery())
+		err = q.GetResults(&users)
+		assert.NoError(t, err)
+		assert.Equal(t, len(users), 0)
+
 		session.Close()
 	}
 }
@@ -951,6 +1015,103 @@ func queryQueryParameters(t *testing.T, driver *RavenTestDriver) {
 
 		assert.Equal(t, count, 1)
 
+		q = session.RawQuery("from Users where name = $name")
+		q = q.AddParameter("name", "Tarzan")
+		longCount, err := q.LongCount()
+		assert.NoError(t, err)
+		assert.Equal(t, longCount, int64(1))
+
+		session.Close()
+	}
+}
+
+func goQueryRqlTemplate(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	queryAddUsers(t, store, driver)
+	{
+		session := openSessionMust(t, store)
+
+		byName := rql.MustNew("from Users where name = $name")
+		for _, name := range []string{"Tarzan", "' or true or name = '"} {
+			q, err := byName.RawQuery(session, ravendb.Parameters{"name": name})
+			assert.NoError(t, err)
+			var users []*User
+			err = q.GetResults(&users)
+			assert.NoError(t, err)
+			if name == "Tarzan" {
+				assert.Equal(t, 1, len(users))
+			} else {
+				assert.Equal(t, 0, len(users))
+			}
+		}
+
+		_, err := byName.RawQuery(session, ravendb.Parameters{})
+		assert.Error(t, err)
+
+		session.Close()
+	}
+}
+
+func goQueryTransform(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	queryAddUsers(t, store, driver)
+	{
+		session := openSessionMust(t, store)
+
+		type userView struct {
+			Name  string
+			Adult bool
+		}
+		toView, err := ravendb.NewResultTransformer(func(u *User) *userView {
+			return &userView{Name: *u.Name, Adult: u.Age >= 3}
+		})
+		assert.NoError(t, err)
+		adultsOnly, err := ravendb.NewResultTransformer(func(views []*userView) []*userView {
+			var res []*userView
+			for _, v := range views {
+				if v.Adult {
+					res = append(res, v)
+				}
+			}
+			return res
+		})
+		assert.NoError(t, err)
+
+		var views []*userView
+		q := session.QueryCollectionForType(userType).OrderBy("name").Transform(toView).Transform(adultsOnly)
+		err = q.GetResults(&views)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(views))
+		assert.Equal(t, "John", views[0].Name)
+		assert.Equal(t, "John", views[1].Name)
+
+		// results must match the output of the last transformer
+		var users []*User
+		q = session.QueryCollectionForType(userType).Transform(toView)
+		err = q.GetResults(&users)
+		assert.Error(t, err)
+
+		// a transformer must accept the output of the previous one
+		var names []string
+		toName, err := ravendb.NewResultTransformer(func(u *User) string { return *u.Name })
+		assert.NoError(t, err)
+		q = session.QueryCollectionForType(userType).Transform(toView).Transform(toName)
+		err = q.GetResults(&names)
+		assert.Error(t, err)
+
+		// per-item transformers can be chained
+		viewToName, err := ravendb.NewResultTransformer(func(v *userView) string { return v.Name })
+		assert.NoError(t, err)
+		q = session.QueryCollectionForType(userType).OrderBy("name").Transform(toView).Transform(viewToName)
+		names = nil
+		err = q.GetResults(&names)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"John", "John", "Tarzan"}, names)
+
 		session.Close()
 	}
 }
@@ -984,6 +1145,150 @@ func queryQueryRandomOrder(t *testing.T, driver *RavenTestDriver) {
 	}
 }
 
+func goQueryOrderByCustomSorter(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	queryAddUsers(t, store, driver)
+	{
+		session := openSessionMust(t, store)
+
+		q := session.QueryCollectionForType(userType)
+		q = q.OrderByWithSorter("name", "MySorter")
+		q = q.OrderByDescendingWithSorter("age", "It's")
+		iq, err := q.GetIndexQuery()
+		assert.NoError(t, err)
+		assert.Equal(t, "from Users order by custom(name, 'MySorter'), custom(age, 'It''s') desc", iq.GetQuery())
+
+		q = session.QueryCollectionForType(userType)
+		q = q.OrderByWithSorter("name", "")
+		_, err = q.GetIndexQuery()
+		assert.Error(t, err)
+
+		// the sorter is not registered on the server
+		var res []*User
+		q = session.QueryCollectionForType(userType)
+		q = q.OrderByWithSorter("name", "MySorter")
+		err = q.GetResults(&res)
+		assert.Error(t, err)
+
+		session.Close()
+	}
+
+	_, err := ravendb.NewPutSortersOperation()
+	assert.Error(t, err)
+	_, err = ravendb.NewDeleteSorterOperation("")
+	assert.Error(t, err)
+}
+
+func goQueryIncludeWithBuilder(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	op, err := ravendb.NewPutCompareExchangeValueOperation("emails/john", "users/1", 0)
+	assert.NoError(t, err)
+	err = store.Operations().Send(op, nil)
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		user.setLastName("emails/john")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+
+		from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.Add(time.Hour)
+		q := session.QueryCollectionForType(userType)
+		q = q.IncludeWithBuilder(func(b *ravendb.QueryIncludeBuilder) {
+			b.IncludeDocuments("friends").IncludeCounters("Likes", "Dislikes")
+			b.IncludeTimeSeries("HeartRate").IncludeTimeSeriesRange("Stocks", from, to)
+			b.IncludeCompareExchangeValue("lastName").IncludeRevisions("previous")
+		})
+		iq, err := q.GetIndexQuery()
+		assert.NoError(t, err)
+		exp := "from Users include friends,counters('Likes'),counters('Dislikes'),timeseries('HeartRate')," +
+			"timeseries('Stocks', '2020-01-01T00:00:00.0000000Z', '2020-01-01T01:00:00.0000000Z'),cmpxchg('lastName'),revisions('previous')"
+		assert.Equal(t, exp, iq.GetQuery())
+
+		q = session.QueryCollectionForType(userType)
+		q = q.IncludeWithBuilder(func(b *ravendb.QueryIncludeBuilder) {
+			b.IncludeCounter("Likes").IncludeAllCounters()
+		})
+		_, err = q.GetIndexQuery()
+		assert.Error(t, err)
+
+		var users []*User
+		q = session.QueryCollectionForType(userType)
+		q = q.IncludeWithBuilder(func(b *ravendb.QueryIncludeBuilder) {
+			b.IncludeCompareExchangeValue("lastName")
+		})
+		err = q.GetResults(&users)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(users))
+
+		value := session.GetIncludedCompareExchangeValue("emails/john")
+		assert.NotNil(t, value)
+		if value != nil {
+			assert.Equal(t, "users/1", value.Value)
+			assert.True(t, value.Index > 0)
+		}
+		assert.Nil(t, session.GetIncludedCompareExchangeValue("emails/mary"))
+
+		session.Close()
+	}
+}
+
+func goQueryFuzzyAndProximity(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	queryAddUsers(t, store, driver)
+	{
+		session := openSessionMust(t, store)
+
+		q := session.QueryCollectionForType(userType)
+		q = q.WhereEquals("name", "Tarzan").Fuzzy(0.5)
+		iq, err := q.GetIndexQuery()
+		assert.NoError(t, err)
+		assert.Equal(t, "from Users where fuzzy(name = $p0, 0.5)", iq.GetQuery())
+
+		q = session.QueryCollectionForType(userType)
+		q = q.Search("name", "Tarzan John").Proximity(2)
+		iq, err = q.GetIndexQuery()
+		assert.NoError(t, err)
+		assert.Equal(t, "from Users where proximity(search(name, $p0), 2)", iq.GetQuery())
+
+		// proximity only makes sense for search and fuzzy for equals
+		q = session.QueryCollectionForType(userType)
+		q = q.WhereEquals("name", "Tarzan").Proximity(2)
+		_, err = q.GetIndexQuery()
+		assert.Error(t, err)
+
+		q = session.QueryCollectionForType(userType)
+		q = q.Search("name", "Tarzan").Fuzzy(0.5)
+		_, err = q.GetIndexQuery()
+		assert.Error(t, err)
+
+		q = session.QueryCollectionForType(userType)
+		q = q.WhereEquals("name", "Tarzan").Fuzzy(1.5)
+		_, err = q.GetIndexQuery()
+		_, ok := err.(*ravendb.IllegalArgumentError)
+		assert.True(t, ok)
+
+		session.Close()
+	}
+}
+
 func queryQueryWhereExists(t *testing.T, driver *RavenTestDriver) {
 	store := driver.getDocumentStoreMust(t)
 	defer store.Close()
@@ -1408,4 +1713,11 @@ func TestQuery(t *testing.T) {
 	queryQueryWithWhereIn(t, driver)
 	queryQueryDistinct(t, driver)
 	queryQueryWithWhereLessThanOrEqual(t, driver)
+
+	// tests unique to go
+	goQueryOrderByCustomSorter(t, driver)
+	goQueryIncludeWithBuilder(t, driver)
+	goQueryFuzzyAndProximity(t, driver)
+	goQueryRqlTemplate(t, driver)
+	goQueryTransform(t, driver)
 }
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/go-multierror"
 	"github.com/ravendb/ravendb-go-client"
+	"github.com/ravendb/ravendb-go-client/ravendbtest"
 	"github.com/stretchr/testify/assert"
 	"io"
 	"io/ioutil"
@@ -561,59 +562,7 @@ func (d *RavenTestDriver) waitForIndexing(store *ravendb.DocumentStore, database
 }
 
 func waitForIndexing(store *ravendb.DocumentStore, database string, timeout time.Duration) error {
-	admin := store.Maintenance().ForDatabase(database)
-	if timeout == 0 {
-		timeout = time.Minute
-	}
-
-	sp := time.Now()
-	for time.Since(sp) < timeout {
-		op := ravendb.NewGetStatisticsOperation("")
-		err := admin.Send(op)
-		if err != nil {
-			return err
-		}
-		databaseStatistics := op.Command.Result
-		isDone := true
-		hasError := false
-		for _, index := range databaseStatistics.Indexes {
-			if index.State == ravendb.IndexStateDisabled {
-				continue
-			}
-			if index.IsStale || strings.HasPrefix(index.Name, ravendb.IndexingSideBySideIndexNamePrefix) {
-				isDone = false
-			}
-			if index.State == ravendb.IndexStateError {
-				hasError = true
-			}
-		}
-		if isDone {
-			return nil
-		}
-		if hasError {
-			break
-		}
-		time.Sleep(time.Millisecond * 100)
-	}
-
-	op := ravendb.NewGetIndexErrorsOperation(nil)
-	err := admin.Send(op)
-	if err != nil {
-		return err
-	}
-	allIndexErrorsText := ""
-	/*
-		// TODO: port this
-		Function<IndexErrors, String> formatIndexErrors = indexErrors -> {
-				String errorsListText = Arrays.stream(indexErrors.getErrors()).map(x -> "-" + x).collect(Collectors.joining(System.lineSeparator()));
-				return "Index " + indexErrors.GetName() + " (" + indexErrors.getErrors().length + " errors): "+ System.lineSeparator() + errorsListText;
-			};
-
-			if (errors != null && errors.length > 0) {
-				allIndexErrorsText = Arrays.stream(errors).map(x -> formatIndexErrors.apply(x)).collect(Collectors.joining(System.lineSeparator()));
-			}
-	*/
-	return ravendb.NewTimeoutError("The indexes stayed stale for more than %s.%s", timeout, allIndexErrorsText)
+	return ravendbtest.WaitForIndexing(store, database, timeout)
 }
 
 func (d *RavenTestDriver) killServerProcesses() {
@@ -828,7 +777,7 @@ func detectRavendbExePath() string {
 }
 
 func loadTestClientCertificate(path string) *tls.Certificate {
-	cert, err := loadCertficateAndKeyFromFile(path)
+	cert, err := ravendb.LoadCertificateFromPEMFile(path)
 	must(err)
 	return cert
 }
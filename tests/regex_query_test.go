@@ -53,6 +53,40 @@ func regexQueryWueriesWithRegexFromDocumentQuery(t *testing.T, driver *RavenTest
 	}
 }
 
+func goRegexQueryEscaping(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	clazz := reflect.TypeOf(&RegexMe{})
+	{
+		query := session.Advanced().QueryCollectionForType(clazz)
+		query = query.WhereRegex("my text", "') or true or regex(text, '")
+		iq, err := query.GetIndexQuery()
+		assert.NoError(t, err)
+		assert.Equal(t, "from RegexMes where regex('my text', $p0)", iq.GetQuery())
+		assert.Equal(t, "') or true or regex(text, '", iq.GetQueryParameters()["p0"])
+	}
+	{
+		query := session.Advanced().QueryCollectionForType(clazz)
+		query = query.WhereLucene("text", "dogs OR cats").AndAlso().WhereRegex("text", "love$")
+		iq, err := query.GetIndexQuery()
+		assert.NoError(t, err)
+		assert.Equal(t, "from RegexMes where lucene(text, $p0) and regex(text, $p1)", iq.GetQuery())
+	}
+	{
+		query := session.Advanced().QueryCollectionForType(clazz).WhereRegex("", "love")
+		_, err := query.GetIndexQuery()
+		assert.Error(t, err)
+
+		query = session.Advanced().QueryCollectionForType(clazz).WhereLucene("", "love")
+		_, err = query.GetIndexQuery()
+		assert.Error(t, err)
+	}
+}
+
 type RegexMe struct {
 	Text string `json:"text"`
 }
@@ -70,4 +104,7 @@ func TestRegexQuery(t *testing.T) {
 
 	// matches order of Java tests
 	regexQueryWueriesWithRegexFromDocumentQuery(t, driver)
+
+	// tests unique to go
+	goRegexQueryEscaping(t, driver)
 }
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ravendb/ravendb-go-client"
 	"github.com/stretchr/testify/assert"
@@ -177,6 +178,162 @@ func goRevisionsTest(t *testing.T, driver *RavenTestDriver) {
 	}
 }
 
+func goRevisionsCanGetConfiguration(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		op := ravendb.NewGetRevisionsConfigurationOperation()
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.Nil(t, op.Command.Result)
+	}
+
+	minAge := ravendb.Duration(time.Hour * 24 * 7)
+	configuration := &ravendb.RevisionsConfiguration{
+		DefaultConfig: &ravendb.RevisionsCollectionConfiguration{
+			MinimumRevisionsToKeep: 5,
+		},
+		Collections: map[string]*ravendb.RevisionsCollectionConfiguration{
+			"Users": {
+				PurgeOnDelete:            true,
+				MinimumRevisionAgeToKeep: &minAge,
+			},
+		},
+	}
+	err = store.Maintenance().Send(ravendb.NewConfigureRevisionsOperation(configuration))
+	assert.NoError(t, err)
+
+	op := ravendb.NewGetRevisionsConfigurationOperation()
+	err = store.Maintenance().Send(op)
+	assert.NoError(t, err)
+	res := op.Command.Result
+	assert.NotNil(t, res)
+	assert.Equal(t, int64(5), res.DefaultConfig.MinimumRevisionsToKeep)
+	users := res.Collections["Users"]
+	assert.True(t, users.PurgeOnDelete)
+	assert.Equal(t, minAge, *users.MinimumRevisionAgeToKeep)
+
+	err = store.Maintenance().Send(ravendb.NewConfigureRevisionsOperation(nil))
+	assert.Error(t, err)
+}
+
+func goRevisionsCountAndGetOperation(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	_, err = setupRevisions(store, false, 10)
+	assert.NoError(t, err)
+
+	createRevisions(t, store)
+
+	{
+		session := openSessionMust(t, store)
+		n, err := session.Advanced().Revisions().GetCountFor("users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), n)
+		n, err = session.Advanced().Revisions().GetCountFor("users/does-not-exist")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+		session.Close()
+	}
+
+	op := ravendb.NewGetRevisionsOperation("users/1", 1, 2)
+	err = store.Operations().Send(op, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), op.TotalResults())
+	var revisions []*User
+	err = op.GetResults(&revisions)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user2", "user3"}, collectUserNamesSorted(revisions))
+	assert.Equal(t, "users/1", revisions[0].ID)
+}
+
+func goRevisionsForceRevisionCreation(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	// revisions are not configured
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+
+		err = session.Advanced().Revisions().ForceRevisionCreationFor(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		n, err := session.Advanced().Revisions().GetCountFor("users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		// a revision of the content before the change
+		user.setName("Jon")
+		err = session.Advanced().Revisions().ForceRevisionCreationFor("users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		var revisions []*User
+		err = session.Advanced().Revisions().GetFor(&revisions, "users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(revisions))
+		assert.Equal(t, "John", *revisions[0].Name)
+
+		err = session.Advanced().Revisions().ForceRevisionCreationFor(&User{})
+		assert.Error(t, err)
+		session.Close()
+	}
+}
+
+func goRevisionsRevert(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	_, err = setupRevisions(store, false, 10)
+	assert.NoError(t, err)
+
+	createRevisions(t, store)
+	pointInTime := time.Now()
+	time.Sleep(time.Second)
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("broken")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	operation, err := store.Maintenance().SendAsync(ravendb.NewRevertRevisionsOperation(pointInTime, time.Hour, "Users"))
+	assert.NoError(t, err)
+	err = operation.WaitForCompletion()
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		var user *User
+		err = session.Load(&user, "users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "user4", *user.Name)
+		session.Close()
+	}
+}
+
 func TestRevisions(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -187,4 +344,8 @@ func TestRevisions(t *testing.T) {
 	revisionsTestCanListRevisionsBin(t, driver)
 
 	goRevisionsTest(t, driver)
+	goRevisionsCanGetConfiguration(t, driver)
+	goRevisionsCountAndGetOperation(t, driver)
+	goRevisionsForceRevisionCreation(t, driver)
+	goRevisionsRevert(t, driver)
 }
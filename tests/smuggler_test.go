@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func goSmugglerCanExportAndImport(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	var dump bytes.Buffer
+	exportOptions := ravendb.NewDatabaseSmugglerExportOptions()
+	exportOptions.OperateOnTypes = []ravendb.DatabaseItemType{ravendb.DatabaseItemTypeDocuments}
+	operation, err := store.Smuggler().ExportAsync(exportOptions, &dump)
+	assert.NoError(t, err)
+	err = operation.WaitForCompletion()
+	assert.NoError(t, err)
+	assert.True(t, dump.Len() > 0)
+
+	store2 := driver.getDocumentStoreMust(t)
+	defer store2.Close()
+
+	importOptions := ravendb.NewDatabaseSmugglerImportOptions()
+	importOptions.TransformScript = `this.name = "Imported " + this.name;`
+	operation, err = store2.Smuggler().ImportAsync(importOptions, &dump)
+	assert.NoError(t, err)
+	err = operation.WaitForCompletion()
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store2)
+		var user *User
+		err = session.Load(&user, "users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "Imported John", *user.Name)
+		session.Close()
+	}
+}
+
+func TestSmuggler(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	// tests unique to go
+	goSmugglerCanExportAndImport(t, driver)
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"errors"
 	"reflect"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -1094,11 +1095,11 @@ func TestSubscriptionsBasic(t *testing.T) {
 	subscriptionsBasic_shouldRespectCollectionCriteria(t, driver)
 	subscriptionsBasic_willAcknowledgeEmptyBatches(t, driver)
 
-	// subscriptionsBasic_shouldStopPullingDocsAndCloseSubscriptionOnSubscriberErrorByDefault(t, driver)
+	subscriptionsBasic_shouldStopPullingDocsAndCloseSubscriptionOnSubscriberErrorByDefault(t, driver)
 
 	subscriptionsBasic_disposingOneSubscriptionShouldNotAffectOnNotificationsOfOthers(t, driver)
 	subscriptionsBasic_shouldPullDocumentsAfterBulkInsert(t, driver)
-	//subscriptionsBasic_canSetToIgnoreSubscriberErrors(t, driver)
+	subscriptionsBasic_canSetToIgnoreSubscriberErrors(t, driver)
 	subscriptionsBasic_ravenDB_3452_ShouldStopPullingDocsIfReleased(t, driver)
 	subscriptionsBasic_canDeleteSubscription(t, driver)
 
@@ -1108,3 +1109,171 @@ func TestSubscriptionsBasic(t *testing.T) {
 	subscriptionsBasic_shouldSendAllNewAndModifiedDocs(t, driver)
 	subscriptionsBasic_ravenDB_3453_ShouldDeserializeTheWholeDocumentsAfterTypedSubscription(t, driver)
 }
+
+func goSubscriptionsCanEnableAndDisable(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	opts := &ravendb.SubscriptionCreationOptions{
+		Name: "users",
+	}
+	name, err := store.Subscriptions().CreateForType(reflect.TypeOf(&User{}), opts, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "users", name)
+
+	err = store.Subscriptions().Disable(name, "")
+	assert.NoError(t, err)
+	state, err := store.Subscriptions().GetSubscriptionState(name, "")
+	assert.NoError(t, err)
+	assert.True(t, state.Disabled)
+
+	err = store.Subscriptions().Enable(name, "")
+	assert.NoError(t, err)
+	state, err = store.Subscriptions().GetSubscriptionState(name, "")
+	assert.NoError(t, err)
+	assert.False(t, state.Disabled)
+
+	err = store.Subscriptions().Disable("", "")
+	assert.Error(t, err)
+	err = store.Subscriptions().Delete("", "")
+	assert.Error(t, err)
+
+	err = store.Subscriptions().DropConnection(name, "")
+	assert.NoError(t, err)
+	err = store.Subscriptions().Delete(name, "")
+	assert.NoError(t, err)
+}
+
+func goSubscriptionsConcurrentWorkers(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	id, err := store.Subscriptions().CreateForType(reflect.TypeOf(&User{}), nil, "")
+	assert.NoError(t, err)
+
+	const nDocs = 20
+	for i := 0; i < nDocs; i++ {
+		putUserDoc(t, store)
+	}
+
+	var mu sync.Mutex
+	seen := map[string]bool{}
+	done := make(chan bool, 1)
+	cb := func(batch *ravendb.SubscriptionBatch) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, item := range batch.Items {
+			seen[item.ID] = true
+		}
+		if len(seen) == nDocs {
+			select {
+			case done <- true:
+			default:
+			}
+		}
+		return nil
+	}
+
+	var workers []*ravendb.SubscriptionWorker
+	for i := 0; i < 2; i++ {
+		opts := ravendb.NewSubscriptionWorkerOptions(id)
+		opts.Strategy = ravendb.SubscriptionOpeningStrategyConcurrent
+		opts.MaxDocsPerBatch = 2
+		worker, err := store.Subscriptions().GetSubscriptionWorker(reflect.TypeOf(&User{}), opts, "")
+		assert.NoError(t, err)
+		err = worker.Run(cb)
+		assert.NoError(t, err)
+		workers = append(workers, worker)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(_reasonableWaitTime):
+		assert.Fail(t, "timed out waiting for documents")
+	}
+
+	for _, worker := range workers {
+		// a second worker must not be rejected with SubscriptionInUseError
+		assert.NoError(t, worker.Err())
+		err = worker.Close()
+		assert.NoError(t, err)
+	}
+}
+
+func goSubscriptionsBatchSessionCanModifyDocuments(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	id, err := store.Subscriptions().CreateForType(reflect.TypeOf(&User{}), nil, "")
+	assert.NoError(t, err)
+	putUserDoc(t, store)
+
+	worker, err := store.Subscriptions().GetSubscriptionWorker(reflect.TypeOf(&User{}), ravendb.NewSubscriptionWorkerOptions(id), "")
+	assert.NoError(t, err)
+
+	// the modified document is sent to the subscription again,
+	// we only care about the first batch
+	done := make(chan error, 1)
+	notify := func(err error) {
+		select {
+		case done <- err:
+		default:
+		}
+	}
+	cb := func(batch *ravendb.SubscriptionBatch) error {
+		session, err := batch.OpenSession()
+		if err != nil {
+			notify(err)
+			return err
+		}
+		defer session.Close()
+		for _, item := range batch.Items {
+			var u *User
+			if err = item.GetResult(&u); err != nil {
+				notify(err)
+				return err
+			}
+			u.setName("processed")
+		}
+		err = session.SaveChanges()
+		notify(err)
+		return err
+	}
+	err = worker.Run(cb)
+	assert.NoError(t, err)
+
+	select {
+	case err = <-done:
+		assert.NoError(t, err)
+	case <-time.After(_reasonableWaitTime):
+		assert.Fail(t, "timed out waiting for batch")
+	}
+	err = worker.Close()
+	assert.NoError(t, err)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+	var users []*User
+	err = session.QueryCollectionForType(reflect.TypeOf(&User{})).GetResults(&users)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(users)) {
+		assert.Equal(t, "processed", *users[0].Name)
+	}
+}
+
+func TestSubscriptionsManagement(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	// tests unique to go
+	goSubscriptionsCanEnableAndDisable(t, driver)
+	goSubscriptionsBatchSessionCanModifyDocuments(t, driver)
+	// concurrent subscriptions require a license
+	if enableReplicationTests() {
+		goSubscriptionsConcurrentWorkers(t, driver)
+	}
+}
@@ -267,6 +267,58 @@ func suggestionsCanGetSuggestions(t *testing.T, driver *RavenTestDriver) {
 	}
 }
 
+func goSuggestionsMultipleFieldsUsingBuilder(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	indexDefinition := ravendb.NewIndexDefinition()
+	indexDefinition.Name = "test"
+	indexDefinition.Maps = []string{"from doc in docs.User4s select new { doc.name, doc.email }"}
+	for _, field := range []string{"name", "email"} {
+		indexFieldOptions := ravendb.NewIndexFieldOptions()
+		indexFieldOptions.Suggestions = true
+		indexDefinition.Fields[field] = indexFieldOptions
+	}
+	err = store.Maintenance().Send(ravendb.NewPutIndexesOperation(indexDefinition))
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		err = session.Store(&User4{Name: "Oren", Email: "ayende"})
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	err = driver.waitForIndexing(store, "", 0)
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+
+		q := session.QueryIndex("test")
+		q2 := q.SuggestUsingBuilder(func(b *ravendb.SuggestionBuilder) {
+			b.ByField("name", "Owen")
+		}).AndSuggestUsingBuilder(func(b *ravendb.SuggestionBuilder) {
+			b.ByField("email", "ayend")
+		})
+		suggestionQueryResult, err := q2.Execute()
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"oren"}, suggestionQueryResult["name"].Suggestions)
+		assert.Equal(t, []string{"ayende"}, suggestionQueryResult["email"].Suggestions)
+
+		// builder must pick a field
+		q = session.QueryIndex("test")
+		_, err = q.SuggestUsingBuilder(func(b *ravendb.SuggestionBuilder) {}).Execute()
+		assert.Error(t, err)
+
+		session.Close()
+	}
+}
+
 func TestSuggestions(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -279,4 +331,7 @@ func TestSuggestions(t *testing.T) {
 	suggestionsUsingLinq(t, driver)
 	suggestionsUsingLinqWithOptions(t, driver)
 	suggestionsExactMatch(t, driver)
+
+	// tests unique to go
+	goSuggestionsMultipleFieldsUsingBuilder(t, driver)
 }
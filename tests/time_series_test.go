@@ -0,0 +1,359 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func timeSeriesAppendDeleteAndGet(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	baseline := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	op := ravendb.NewTimeSeriesOperation("Heartrate")
+	for i := 0; i < 10; i++ {
+		op.Append(baseline.Add(time.Duration(i)*time.Minute), []float64{float64(60 + i)}, "watches/1")
+	}
+	op2 := ravendb.NewTimeSeriesOperation("Stocks")
+	op2.Append(baseline, []float64{100, 101}, "")
+	for _, o := range []*ravendb.TimeSeriesOperation{op, op2} {
+		err = store.Operations().Send(ravendb.NewTimeSeriesBatchOperation("users/1", o), nil)
+		assert.NoError(t, err)
+	}
+
+	{
+		get := ravendb.NewGetTimeSeriesOperation("users/1", "Heartrate", nil, nil, 2, 3)
+		err = store.Operations().Send(get, nil)
+		assert.NoError(t, err)
+		entries := get.Command.Result.Entries
+		assert.Equal(t, 3, len(entries))
+		assert.Equal(t, 62.0, entries[0].GetValue())
+		assert.Equal(t, "watches/1", entries[0].Tag)
+		assert.True(t, baseline.Add(2*time.Minute).Equal(time.Time(entries[0].Timestamp)))
+	}
+
+	from := baseline.Add(time.Minute)
+	to := baseline.Add(8 * time.Minute)
+	op = ravendb.NewTimeSeriesOperation("Heartrate")
+	op.Delete(&from, &to)
+	err = store.Operations().Send(ravendb.NewTimeSeriesBatchOperation("users/1", op), nil)
+	assert.NoError(t, err)
+
+	{
+		ranges := []*ravendb.TimeSeriesRange{
+			{Name: "Heartrate"},
+			{Name: "Stocks", From: &baseline, To: &baseline},
+		}
+		get := ravendb.NewGetMultipleTimeSeriesOperation("users/1", ranges, 0, 0)
+		err = store.Operations().Send(get, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(get.Command.Result.Values["Heartrate"][0].Entries))
+		stocks := get.Command.Result.Values["Stocks"][0].Entries
+		assert.Equal(t, []float64{100, 101}, stocks[0].Values)
+	}
+
+	{
+		get := ravendb.NewGetTimeSeriesOperation("users/1", "DoesNotExist", nil, nil, 0, 0)
+		err = store.Operations().Send(get, nil)
+		assert.NoError(t, err)
+		if get.Command.Result != nil {
+			assert.Equal(t, 0, len(get.Command.Result.Entries))
+		}
+	}
+}
+
+func timeSeriesSessionAppendGetAndDelete(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	baseline := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		ts, err := session.TimeSeriesFor(user, "Heartrate")
+		assert.NoError(t, err)
+		for i := 0; i < 5; i++ {
+			err = ts.Append(baseline.Add(time.Duration(i)*time.Minute), []float64{float64(60 + i)}, "watches/1")
+			assert.NoError(t, err)
+		}
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		ts, err := session.TimeSeriesFor("users/1", "Heartrate")
+		assert.NoError(t, err)
+		entries, err := ts.Get(nil, nil, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, len(entries))
+		assert.Equal(t, "watches/1", entries[0].Tag)
+
+		// entries of the whole series are cached
+		n := session.Advanced().GetNumberOfRequests()
+		from := baseline.Add(time.Minute)
+		to := baseline.Add(3 * time.Minute)
+		entries, err = ts.Get(&from, &to, 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(entries))
+		assert.Equal(t, 62.0, entries[0].GetValue())
+		assert.Equal(t, n, session.Advanced().GetNumberOfRequests())
+
+		err = ts.Delete(&from, &to)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+
+		// SaveChanges evicts the cache
+		entries, err = ts.Get(nil, nil, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(entries))
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		var user *User
+		err = session.Load(&user, "users/1")
+		assert.NoError(t, err)
+		err = session.Delete(user)
+		assert.NoError(t, err)
+		ts, err := session.TimeSeriesFor(user, "Heartrate")
+		assert.NoError(t, err)
+		err = ts.Append(baseline, []float64{1}, "")
+		assert.Error(t, err)
+		session.Close()
+	}
+}
+
+type HeartRate struct {
+	BPM float64 `timeseries:"0"`
+}
+
+func timeSeriesTypedAppendAndGet(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	baseline := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clazz := reflect.TypeOf(&HeartRate{})
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		ts, err := session.TypedTimeSeriesFor(clazz, "users/1", "Heartrate")
+		assert.NoError(t, err)
+		err = ts.Append(baseline, &HeartRate{BPM: 60}, "watches/1")
+		assert.NoError(t, err)
+		err = ts.Append(baseline.Add(time.Minute), HeartRate{BPM: 70}, "")
+		assert.NoError(t, err)
+		err = ts.Append(baseline, &User{}, "")
+		assert.Error(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		ts, err := session.TypedTimeSeriesFor(clazz, "users/1", "Heartrate")
+		assert.NoError(t, err)
+		entries, err := ts.Get(nil, nil, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(entries))
+		assert.Equal(t, 60.0, entries[0].Value.(*HeartRate).BPM)
+		assert.Equal(t, "watches/1", entries[0].Tag)
+		assert.Equal(t, 70.0, entries[1].Value.(*HeartRate).BPM)
+		assert.True(t, baseline.Add(time.Minute).Equal(entries[1].Timestamp))
+		session.Close()
+	}
+}
+
+func timeSeriesConfigurePoliciesAndGetRollup(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	byHour := ravendb.NewTimeSeriesPolicy("ByHour", ravendb.TimeValueFromHours(1), ravendb.TimeValueFromDays(30))
+	config := &ravendb.TimeSeriesConfiguration{
+		Collections: map[string]*ravendb.TimeSeriesCollectionConfiguration{
+			"Users": {
+				Policies:  []*ravendb.TimeSeriesPolicy{byHour},
+				RawPolicy: ravendb.NewRawTimeSeriesPolicy(ravendb.TimeValueFromDays(7)),
+			},
+		},
+	}
+	op := ravendb.NewConfigureTimeSeriesOperation(config)
+	err = store.Maintenance().Send(op)
+	assert.NoError(t, err)
+	assert.True(t, op.Command.Result.RaftCommandIndex > 0)
+
+	getRecord := ravendb.NewGetDatabaseRecordOperation(store.GetDatabase())
+	err = store.Maintenance().Server().Send(getRecord)
+	assert.NoError(t, err)
+	users := getRecord.Command.Result.TimeSeries.Collections["Users"]
+	assert.Equal(t, "ByHour", users.Policies[0].Name)
+	assert.Equal(t, ravendb.TimeValueFromDays(7), users.RawPolicy.RetentionTime)
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+
+		// rollups are created in the background, there may be nothing yet
+		rollup, err := session.TimeSeriesRollupFor("users/1", "Heartrate", "ByHour")
+		assert.NoError(t, err)
+		_, err = rollup.Get(nil, nil, 0, 0)
+		assert.NoError(t, err)
+		session.Close()
+	}
+}
+
+func timeSeriesIncremental(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	baseline := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		ts, err := session.TimeSeriesFor("users/1", "INC:Downloads")
+		assert.NoError(t, err)
+		err = ts.Increment(baseline, []float64{1})
+		assert.NoError(t, err)
+		err = ts.Increment(baseline, []float64{2})
+		assert.NoError(t, err)
+		err = ts.Append(baseline, []float64{1}, "")
+		assert.Error(t, err)
+
+		raw, err := session.TimeSeriesFor("users/1", "Downloads")
+		assert.NoError(t, err)
+		err = raw.Increment(baseline, []float64{1})
+		assert.Error(t, err)
+
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	op := ravendb.NewTimeSeriesOperation("INC:Downloads")
+	op.Increment(baseline, []float64{4})
+	op.Increment(baseline.Add(time.Minute), []float64{1})
+	err = store.Operations().Send(ravendb.NewTimeSeriesBatchOperation("users/1", op), nil)
+	assert.NoError(t, err)
+
+	get := ravendb.NewGetTimeSeriesOperation("users/1", "INC:Downloads", nil, nil, 0, 0)
+	err = store.Operations().Send(get, nil)
+	assert.NoError(t, err)
+	entries := get.Command.Result.Entries
+	assert.Equal(t, 2, len(entries))
+	assert.Equal(t, 7.0, entries[0].GetValue())
+	assert.Equal(t, 1.0, entries[1].GetValue())
+}
+
+func timeSeriesQueryAggregationAndRaw(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	baseline := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		ts, err := session.TimeSeriesFor("users/1", "Heartrate")
+		assert.NoError(t, err)
+		// two hours, an entry every 30 minutes
+		for i := 0; i < 4; i++ {
+			err = ts.Append(baseline.Add(time.Duration(i)*30*time.Minute), []float64{float64(60 + 10*i)}, "watches/1")
+			assert.NoError(t, err)
+		}
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		q := session.QueryCollection("Users").SelectTimeSeries(reflect.TypeOf(&ravendb.TimeSeriesAggregationResult{}), func(b *ravendb.TimeSeriesQueryBuilder) {
+			b.From("Heartrate").Between(baseline, baseline.Add(24*time.Hour)).GroupBy("1 hour").Select(ravendb.TimeSeriesAggregationMin, ravendb.TimeSeriesAggregationMax, ravendb.TimeSeriesAggregationAverage)
+		})
+		var results []*ravendb.TimeSeriesAggregationResult
+		err = q.GetResults(&results)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(results))
+		agg := results[0]
+		assert.Equal(t, int64(4), agg.Count)
+		assert.Equal(t, 2, len(agg.Results))
+		assert.Equal(t, []float64{60}, agg.Results[0].Min)
+		assert.Equal(t, []float64{70}, agg.Results[0].Max)
+		assert.Equal(t, []float64{85}, agg.Results[1].Average)
+		assert.True(t, baseline.Add(time.Hour).Equal(time.Time(agg.Results[1].From)))
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		q := session.QueryCollection("Users").SelectTimeSeries(reflect.TypeOf(&ravendb.TimeSeriesRawResult{}), func(b *ravendb.TimeSeriesQueryBuilder) {
+			b.From("Heartrate").Where("Values[0] > 65")
+		})
+		var results []*ravendb.TimeSeriesRawResult
+		err = q.GetResults(&results)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, int64(3), results[0].Count)
+		assert.Equal(t, 70.0, results[0].Results[0].GetValue())
+		assert.Equal(t, "watches/1", results[0].Results[0].Tag)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		q := session.QueryCollection("Users").SelectTimeSeries(reflect.TypeOf(&ravendb.TimeSeriesAggregationResult{}), func(b *ravendb.TimeSeriesQueryBuilder) {
+			b.Declare("heartrate", "u").From("Heartrate").GroupBy("1 hour").Select(ravendb.TimeSeriesAggregationMax)
+		})
+		var results []*ravendb.TimeSeriesAggregationResult
+		err = q.GetResults(&results)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, int64(4), results[0].Count)
+		assert.Equal(t, 2, len(results[0].Results))
+		assert.Equal(t, []float64{90}, results[0].Results[1].Max)
+		session.Close()
+	}
+}
+
+func TestTimeSeries(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	timeSeriesAppendDeleteAndGet(t, driver)
+	timeSeriesSessionAppendGetAndDelete(t, driver)
+	timeSeriesTypedAppendAndGet(t, driver)
+	timeSeriesConfigurePoliciesAndGetRollup(t, driver)
+	timeSeriesIncremental(t, driver)
+	timeSeriesQueryAggregationAndRaw(t, driver)
+}
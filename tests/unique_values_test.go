@@ -291,6 +291,34 @@ func uniqueValuesCanGetIndexValue(t *testing.T, driver *RavenTestDriver) {
 	}
 }
 
+func goUniqueValuesKeysWithSpecialCharactersAndMetadata(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	key := "emails/john+doe@example.com&more"
+	metadata := map[string]interface{}{"Source": "signup"}
+	putOp, err := ravendb.NewPutCompareExchangeValueOperationWithMetadata(key, "users/1", 0, metadata)
+	assert.NoError(t, err)
+	err = store.Operations().Send(putOp, nil)
+	assert.NoError(t, err)
+	assert.True(t, putOp.Command.Result.IsSuccessful)
+
+	getOp, err := ravendb.NewGetCompareExchangeValueOperation(reflect.TypeOf(""), key)
+	assert.NoError(t, err)
+	err = store.Operations().Send(getOp, nil)
+	assert.NoError(t, err)
+	res := getOp.Command.Result
+	assert.Equal(t, key, res.Key)
+	assert.Equal(t, "users/1", res.Value)
+	assert.Equal(t, "signup", res.Metadata["Source"])
+
+	deleteOp, err := ravendb.NewDeleteCompareExchangeValueOperation(reflect.TypeOf(""), key, res.Index)
+	assert.NoError(t, err)
+	err = store.Operations().Send(deleteOp, nil)
+	assert.NoError(t, err)
+	assert.True(t, deleteOp.Command.Result.IsSuccessful)
+}
+
 func TestUniqueValues(t *testing.T) {
 	driver := createTestDriver(t)
 	destroy := func() { destroyDriver(t, driver) }
@@ -306,4 +334,7 @@ func TestUniqueValues(t *testing.T) {
 	uniqueValuesCanPutUniqueString(t, driver)
 	uniqueValuesCanListCompareExchange(t, driver)
 	uniqueValuesReturnCurrentValueWhenPuttingConcurrently(t, driver)
+
+	// tests unique to go
+	goUniqueValuesKeysWithSpecialCharactersAndMetadata(t, driver)
 }
@@ -0,0 +1,40 @@
+package ravendb
+
+var _ ICommandData = &TimeSeriesBatchCommandData{}
+
+// TimeSeriesBatchCommandData is a command for SaveChanges that appends
+// and deletes entries of a time series of a document
+type TimeSeriesBatchCommandData struct {
+	*CommandData
+	timeSeries *TimeSeriesOperation
+}
+
+// NewTimeSeriesBatchCommandData returns a command that executes operation
+// on a time series of a document
+func NewTimeSeriesBatchCommandData(documentID string, operation *TimeSeriesOperation) (*TimeSeriesBatchCommandData, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be null or empty")
+	}
+	if operation == nil || stringIsBlank(operation.Name) {
+		return nil, newIllegalArgumentError("Name cannot be null or empty")
+	}
+
+	res := &TimeSeriesBatchCommandData{
+		CommandData: &CommandData{
+			Type: CommandTimeSeries,
+			ID:   documentID,
+			Name: operation.Name,
+		},
+		timeSeries: operation,
+	}
+	return res, nil
+}
+
+func (d *TimeSeriesBatchCommandData) serialize(conventions *DocumentConventions) (interface{}, error) {
+	if err := d.timeSeries.validate(); err != nil {
+		return nil, err
+	}
+	res := d.baseJSON()
+	res["TimeSeries"] = d.timeSeries.serialize()
+	return res, nil
+}
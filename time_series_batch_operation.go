@@ -0,0 +1,75 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IOperation = &TimeSeriesBatchOperation{}
+)
+
+// TimeSeriesBatchOperation appends and deletes entries of a time series of a document
+type TimeSeriesBatchOperation struct {
+	Command *TimeSeriesBatchCommand
+
+	documentID string
+	operation  *TimeSeriesOperation
+}
+
+// NewTimeSeriesBatchOperation returns new TimeSeriesBatchOperation
+//
+// Deprecated: use operations.NewTimeSeriesBatchOperation.
+func NewTimeSeriesBatchOperation(documentID string, operation *TimeSeriesOperation) *TimeSeriesBatchOperation {
+	return &TimeSeriesBatchOperation{
+		documentID: documentID,
+		operation:  operation,
+	}
+}
+
+func (o *TimeSeriesBatchOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewTimeSeriesBatchCommand(o.documentID, o.operation)
+	return o.Command, err
+}
+
+var _ RavenCommand = &TimeSeriesBatchCommand{}
+
+// TimeSeriesBatchCommand is a command for TimeSeriesBatchOperation
+type TimeSeriesBatchCommand struct {
+	RavenCommandBase
+
+	documentID string
+	operation  *TimeSeriesOperation
+}
+
+// NewTimeSeriesBatchCommand returns new TimeSeriesBatchCommand
+func NewTimeSeriesBatchCommand(documentID string, operation *TimeSeriesOperation) (*TimeSeriesBatchCommand, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("Document id cannot be empty")
+	}
+	if operation == nil {
+		return nil, newIllegalArgumentError("Operation cannot be nil")
+	}
+	if err := operation.validate(); err != nil {
+		return nil, err
+	}
+
+	cmd := &TimeSeriesBatchCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		documentID: documentID,
+		operation:  operation,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+func (c *TimeSeriesBatchCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/timeseries?docId=" + urlUtilsEscapeDataString(c.documentID)
+
+	d, err := jsonMarshal(c.operation.serialize())
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
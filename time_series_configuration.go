@@ -0,0 +1,69 @@
+package ravendb
+
+import (
+	"strings"
+)
+
+// TimeSeriesRawPolicyName is the name of the policy for raw time series
+const TimeSeriesRawPolicyName = "rawpolicy"
+
+// TimeSeriesConfiguration describes rollup and retention policies of
+// time series, per collection
+type TimeSeriesConfiguration struct {
+	Collections map[string]*TimeSeriesCollectionConfiguration `json:"Collections"`
+	// how often the server applies the policies. nil means the server's default
+	PolicyCheckFrequency *Duration `json:"PolicyCheckFrequency,omitempty"`
+	// maps collection to time series name to names of its values
+	NamedValues map[string]map[string][]string `json:"NamedValues,omitempty"`
+}
+
+// TimeSeriesCollectionConfiguration describes policies of time series of a collection.
+// Policies should be ordered by increasing AggregationTime, each one
+// aggregating the series created by the previous one
+type TimeSeriesCollectionConfiguration struct {
+	Disabled  bool                `json:"Disabled"`
+	Policies  []*TimeSeriesPolicy `json:"Policies"`
+	RawPolicy *TimeSeriesPolicy   `json:"RawPolicy,omitempty"`
+}
+
+// NewRawTimeSeriesPolicy returns a policy that keeps raw time series
+// entries for retentionTime (zero means forever)
+func NewRawTimeSeriesPolicy(retentionTime TimeValue) *TimeSeriesPolicy {
+	return &TimeSeriesPolicy{
+		Name:            TimeSeriesRawPolicyName,
+		RetentionTime:   retentionTime,
+		AggregationTime: TimeValueZero(),
+	}
+}
+
+func (c *TimeSeriesConfiguration) validate() error {
+	for collection, config := range c.Collections {
+		if config == nil {
+			return newIllegalArgumentError("configuration of collection %s cannot be nil", collection)
+		}
+		if p := config.RawPolicy; p != nil && !strings.EqualFold(p.Name, TimeSeriesRawPolicyName) {
+			return newIllegalArgumentError("raw policy of collection %s must be named %s, got %s", collection, TimeSeriesRawPolicyName, p.Name)
+		}
+		seen := map[string]bool{}
+		for _, p := range config.Policies {
+			if p == nil || stringIsBlank(p.Name) {
+				return newIllegalArgumentError("policies of collection %s must have a name", collection)
+			}
+			name := strings.ToLower(p.Name)
+			if name == TimeSeriesRawPolicyName {
+				return newIllegalArgumentError("policy name %s is reserved for the raw policy", p.Name)
+			}
+			if strings.Contains(p.Name, TimeSeriesRollupSeparator) {
+				return newIllegalArgumentError("policy name %s cannot contain '%s'", p.Name, TimeSeriesRollupSeparator)
+			}
+			if seen[name] {
+				return newIllegalArgumentError("collection %s has more than one policy named %s", collection, p.Name)
+			}
+			seen[name] = true
+			if p.AggregationTime.IsZero() {
+				return newIllegalArgumentError("AggregationTime of policy %s cannot be zero", p.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeSeriesConfigurationValidate(t *testing.T) {
+	newConfig := func(policies ...*TimeSeriesPolicy) *TimeSeriesConfiguration {
+		return &TimeSeriesConfiguration{
+			Collections: map[string]*TimeSeriesCollectionConfiguration{
+				"Users": {
+					Policies:  policies,
+					RawPolicy: NewRawTimeSeriesPolicy(TimeValueFromDays(7)),
+				},
+			},
+		}
+	}
+	byHour := NewTimeSeriesPolicy("ByHour", TimeValueFromHours(1), TimeValueFromMonths(6))
+	byDay := NewTimeSeriesPolicy("ByDay", TimeValueFromDays(1), TimeValueZero())
+	assert.NoError(t, newConfig(byHour, byDay).validate())
+
+	invalid := []*TimeSeriesConfiguration{
+		newConfig(byHour, NewTimeSeriesPolicy("byhour", TimeValueFromHours(2), TimeValueZero())),
+		newConfig(NewTimeSeriesPolicy("", TimeValueFromHours(1), TimeValueZero())),
+		newConfig(NewTimeSeriesPolicy("By@Hour", TimeValueFromHours(1), TimeValueZero())),
+		newConfig(NewTimeSeriesPolicy("ByNothing", TimeValueZero(), TimeValueZero())),
+		newConfig(NewTimeSeriesPolicy(TimeSeriesRawPolicyName, TimeValueFromHours(1), TimeValueZero())),
+		{Collections: map[string]*TimeSeriesCollectionConfiguration{"Users": nil}},
+		{Collections: map[string]*TimeSeriesCollectionConfiguration{"Users": {RawPolicy: byHour}}},
+	}
+	for _, config := range invalid {
+		assert.Error(t, config.validate())
+	}
+
+	_, err := NewConfigureTimeSeriesOperation(nil).GetCommand(nil)
+	assert.Error(t, err)
+	_, err = NewConfigureTimeSeriesOperation(invalid[0]).GetCommand(nil)
+	assert.Error(t, err)
+
+	op := NewConfigureTimeSeriesOperation(newConfig(byHour))
+	cmd, err := op.GetCommand(nil)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(&ServerNode{URL: "http://127.0.0.1:8080", Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/databases/db/admin/timeseries/config", req.URL.Path)
+	d, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(d), `"RawPolicy":{"Name":"rawpolicy","RetentionTime":{"Value":604800,"Unit":"Second"}`)
+	assert.Contains(t, string(d), `"Policies":[{"Name":"ByHour","RetentionTime":{"Value":6,"Unit":"Month"},"AggregationTime":{"Value":3600,"Unit":"Second"}}]`)
+
+	assert.Error(t, op.Command.SetResponse(nil, false))
+	assert.NoError(t, op.Command.SetResponse([]byte(`{"RaftCommandIndex":12}`), false))
+	assert.Equal(t, int64(12), op.Command.Result.RaftCommandIndex)
+}
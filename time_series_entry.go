@@ -0,0 +1,19 @@
+package ravendb
+
+// TimeSeriesEntry is a single entry of a time series
+type TimeSeriesEntry struct {
+	Timestamp Time      `json:"Timestamp"`
+	Tag       string    `json:"Tag"`
+	Values    []float64 `json:"Values"`
+	// true if the entry belongs to a rollup series, in which case
+	// Values contain aggregated values
+	IsRollup bool `json:"IsRollup"`
+}
+
+// GetValue returns the first value of the entry
+func (e *TimeSeriesEntry) GetValue() float64 {
+	if len(e.Values) == 0 {
+		return 0
+	}
+	return e.Values[0]
+}
@@ -0,0 +1,184 @@
+package ravendb
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// TimeSeriesIncrementalPrefix starts names of incremental time series.
+// Entries of incremental series are modified with Increment instead of Append
+const TimeSeriesIncrementalPrefix = "INC:"
+
+// IsIncrementalTimeSeries returns true if name is a name of an incremental time series
+func IsIncrementalTimeSeries(name string) bool {
+	return len(name) >= len(TimeSeriesIncrementalPrefix) && strings.EqualFold(name[:len(TimeSeriesIncrementalPrefix)], TimeSeriesIncrementalPrefix)
+}
+
+// TimeSeriesOperation describes appends and deletes of entries of a time series.
+// It's sent with TimeSeriesBatchOperation
+type TimeSeriesOperation struct {
+	Name string
+
+	appends    []*TimeSeriesAppendOperation
+	deletes    []*TimeSeriesDeleteOperation
+	increments []*TimeSeriesIncrementOperation
+}
+
+// TimeSeriesAppendOperation appends an entry to a time series
+type TimeSeriesAppendOperation struct {
+	Timestamp time.Time
+	Values    []float64
+	Tag       string
+}
+
+// TimeSeriesDeleteOperation deletes entries of a time series from From
+// to To (inclusive). nil From or To means the range is unbounded
+type TimeSeriesDeleteOperation struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// TimeSeriesIncrementOperation adds Values to values of an entry
+// of an incremental time series, creating the entry if needed
+type TimeSeriesIncrementOperation struct {
+	Timestamp time.Time
+	Values    []float64
+}
+
+// NewTimeSeriesOperation returns an operation on time series name
+//
+// Deprecated: use operations.NewTimeSeriesOperation.
+func NewTimeSeriesOperation(name string) *TimeSeriesOperation {
+	return &TimeSeriesOperation{
+		Name: name,
+	}
+}
+
+// Append appends an entry. tag is optional
+func (o *TimeSeriesOperation) Append(timestamp time.Time, values []float64, tag string) {
+	o.appends = append(o.appends, &TimeSeriesAppendOperation{
+		Timestamp: timestamp,
+		Values:    values,
+		Tag:       tag,
+	})
+}
+
+// Increment adds values to the entry at timestamp of an incremental time series.
+// Increments of the same timestamp are summed up
+func (o *TimeSeriesOperation) Increment(timestamp time.Time, values []float64) {
+	for _, inc := range o.increments {
+		if !inc.Timestamp.Equal(timestamp) {
+			continue
+		}
+		for i, v := range values {
+			if i < len(inc.Values) {
+				inc.Values[i] += v
+			} else {
+				inc.Values = append(inc.Values, v)
+			}
+		}
+		return
+	}
+	o.increments = append(o.increments, &TimeSeriesIncrementOperation{
+		Timestamp: timestamp,
+		Values:    append([]float64(nil), values...),
+	})
+}
+
+// Delete deletes entries between from and to (inclusive). nil means unbounded
+func (o *TimeSeriesOperation) Delete(from *time.Time, to *time.Time) {
+	o.deletes = append(o.deletes, &TimeSeriesDeleteOperation{
+		From: from,
+		To:   to,
+	})
+}
+
+func (o *TimeSeriesOperation) validate() error {
+	if stringIsBlank(o.Name) {
+		return newIllegalArgumentError("Name cannot be empty")
+	}
+	if len(o.appends) == 0 && len(o.deletes) == 0 && len(o.increments) == 0 {
+		return newIllegalArgumentError("time series operation must append, increment or delete entries")
+	}
+	incremental := IsIncrementalTimeSeries(o.Name)
+	if incremental && len(o.appends) > 0 {
+		return newIllegalArgumentError("Can't append to incremental time series %s, use Increment instead", o.Name)
+	}
+	if !incremental && len(o.increments) > 0 {
+		return newIllegalArgumentError("Can't increment time series %s, names of incremental time series must start with %s", o.Name, TimeSeriesIncrementalPrefix)
+	}
+	for _, a := range o.appends {
+		if len(a.Values) == 0 {
+			return newIllegalArgumentError("values of an appended entry cannot be empty")
+		}
+	}
+	for _, inc := range o.increments {
+		if len(inc.Values) == 0 {
+			return newIllegalArgumentError("values of an incremented entry cannot be empty")
+		}
+	}
+	return nil
+}
+
+// timeSeriesTime formats t in a way the server understands
+func timeSeriesTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return Time(t.UTC()).Format()
+}
+
+func (o *TimeSeriesOperation) serialize() map[string]interface{} {
+	// the server expects appends sorted by timestamp. When appending
+	// more than once at the same timestamp, the last append wins
+	appends := make([]*TimeSeriesAppendOperation, len(o.appends))
+	copy(appends, o.appends)
+	sort.SliceStable(appends, func(i, j int) bool {
+		return appends[i].Timestamp.Before(appends[j].Timestamp)
+	})
+	var appendsJSON []interface{}
+	for i, a := range appends {
+		if i+1 < len(appends) && appends[i+1].Timestamp.Equal(a.Timestamp) {
+			continue
+		}
+		m := map[string]interface{}{
+			"Timestamp": timeSeriesTime(&a.Timestamp),
+			"Values":    a.Values,
+		}
+		if a.Tag != "" {
+			m["Tag"] = a.Tag
+		}
+		appendsJSON = append(appendsJSON, m)
+	}
+
+	var deletesJSON []interface{}
+	for _, d := range o.deletes {
+		deletesJSON = append(deletesJSON, map[string]interface{}{
+			"From": timeSeriesTime(d.From),
+			"To":   timeSeriesTime(d.To),
+		})
+	}
+
+	res := map[string]interface{}{
+		"Name":    o.Name,
+		"Appends": appendsJSON,
+		"Deletes": deletesJSON,
+	}
+	if len(o.increments) > 0 {
+		increments := make([]*TimeSeriesIncrementOperation, len(o.increments))
+		copy(increments, o.increments)
+		sort.SliceStable(increments, func(i, j int) bool {
+			return increments[i].Timestamp.Before(increments[j].Timestamp)
+		})
+		var incrementsJSON []interface{}
+		for _, inc := range increments {
+			incrementsJSON = append(incrementsJSON, map[string]interface{}{
+				"Timestamp": timeSeriesTime(&inc.Timestamp),
+				"Values":    inc.Values,
+			})
+		}
+		res["Increments"] = incrementsJSON
+	}
+	return res
+}
@@ -0,0 +1,183 @@
+package ravendb
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeSeriesOperationSerialize(t *testing.T) {
+	t0 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	op := NewTimeSeriesOperation("Heartrate")
+	op.Append(t0.Add(time.Minute), []float64{70}, "watches/1")
+	op.Append(t0, []float64{60}, "")
+	op.Append(t0.Add(time.Minute), []float64{75}, "")
+	op.Delete(&t0, nil)
+	assert.NoError(t, op.validate())
+
+	d, err := jsonMarshal(op.serialize())
+	assert.NoError(t, err)
+	exp := `{"Appends":[{"Timestamp":"2020-01-02T03:04:05.0000000Z","Values":[60]},{"Timestamp":"2020-01-02T03:05:05.0000000Z","Values":[75]}],"Deletes":[{"From":"2020-01-02T03:04:05.0000000Z","To":null}],"Name":"Heartrate"}`
+	assert.Equal(t, exp, string(d))
+
+	assert.Error(t, NewTimeSeriesOperation("Heartrate").validate())
+	assert.Error(t, NewTimeSeriesOperation("").validate())
+	op = NewTimeSeriesOperation("Heartrate")
+	op.Append(t0, nil, "")
+	assert.Error(t, op.validate())
+}
+
+func TestTimeSeriesBatchCommandCreateRequest(t *testing.T) {
+	node := &ServerNode{URL: "http://127.0.0.1:8080", Database: "db"}
+	op := NewTimeSeriesOperation("Heartrate")
+	op.Append(time.Now(), []float64{60}, "")
+
+	_, err := NewTimeSeriesBatchCommand("", op)
+	assert.Error(t, err)
+	_, err = NewTimeSeriesBatchCommand("users/1", nil)
+	assert.Error(t, err)
+
+	cmd, err := NewTimeSeriesBatchCommand("users/1", op)
+	assert.NoError(t, err)
+	req, err := cmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "/databases/db/timeseries", req.URL.Path)
+	assert.Equal(t, "docId=users%2F1", req.URL.RawQuery)
+	d, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(d), `"Name":"Heartrate"`)
+}
+
+func TestGetTimeSeriesCommands(t *testing.T) {
+	node := &ServerNode{URL: "http://127.0.0.1:8080", Database: "db"}
+	from := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	cmd, err := NewGetTimeSeriesCommand("users/1", "Heartrate", &from, &to, 10, 20)
+	assert.NoError(t, err)
+	assert.True(t, cmd.IsReadRequest)
+	req, err := cmd.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodGet, req.Method)
+	assert.Equal(t, "/databases/db/timeseries", req.URL.Path)
+	assert.Equal(t, "docId=users%2F1&start=10&pageSize=20&name=Heartrate&from=2020-01-02T03%3A04%3A05.0000000Z&to=2020-01-02T04%3A04%3A05.0000000Z", req.URL.RawQuery)
+
+	assert.NoError(t, cmd.SetResponse(nil, false))
+	assert.Nil(t, cmd.Result)
+	rsp := `{"From":"2020-01-02T03:04:05.0000000Z","To":null,"Entries":[{"Timestamp":"2020-01-02T03:04:05.0000000Z","Tag":"watches/1","Values":[60,1],"IsRollup":false}],"TotalResults":1}`
+	assert.NoError(t, cmd.SetResponse([]byte(rsp), false))
+	assert.Equal(t, int64(1), cmd.Result.TotalResults)
+	assert.Nil(t, cmd.Result.To)
+	entry := cmd.Result.Entries[0]
+	assert.True(t, from.Equal(time.Time(entry.Timestamp)))
+	assert.Equal(t, "watches/1", entry.Tag)
+	assert.Equal(t, 60.0, entry.GetValue())
+
+	_, err = NewGetTimeSeriesCommand("users/1", "", nil, nil, 0, 0)
+	assert.Error(t, err)
+	_, err = NewGetTimeSeriesCommand("users/1", "Heartrate", nil, nil, -1, 0)
+	assert.Error(t, err)
+
+	ranges := []*TimeSeriesRange{
+		{Name: "Heartrate", From: &from},
+		{Name: "Stocks"},
+	}
+	multi, err := NewGetMultipleTimeSeriesCommand("users/1", ranges, 0, 0)
+	assert.NoError(t, err)
+	req, err = multi.CreateRequest(node)
+	assert.NoError(t, err)
+	assert.Equal(t, "/databases/db/timeseries/ranges", req.URL.Path)
+	assert.Equal(t, "docId=users%2F1&name=Heartrate&from=2020-01-02T03%3A04%3A05.0000000Z&name=Stocks", req.URL.RawQuery)
+	rsp = `{"Id":"users/1","Values":{"Heartrate":[` + rsp + `]}}`
+	assert.NoError(t, multi.SetResponse([]byte(rsp), false))
+	assert.Equal(t, "users/1", multi.Result.ID)
+	assert.Equal(t, 1, len(multi.Result.Values["Heartrate"][0].Entries))
+
+	_, err = NewGetMultipleTimeSeriesCommand("users/1", nil, 0, 0)
+	assert.Error(t, err)
+	_, err = NewGetMultipleTimeSeriesCommand("users/1", []*TimeSeriesRange{{}}, 0, 0)
+	assert.Error(t, err)
+}
+
+func TestTimeSeriesBatchCommandData(t *testing.T) {
+	_, err := NewTimeSeriesBatchCommandData("users/1", nil)
+	assert.Error(t, err)
+	_, err = NewTimeSeriesBatchCommandData("", NewTimeSeriesOperation("Heartrate"))
+	assert.Error(t, err)
+
+	op := NewTimeSeriesOperation("Heartrate")
+	cmd, err := NewTimeSeriesBatchCommandData("users/1", op)
+	assert.NoError(t, err)
+	assert.Equal(t, "Heartrate", cmd.getName())
+	// nothing to do
+	_, err = cmd.serialize(nil)
+	assert.Error(t, err)
+
+	op.Append(time.Now(), []float64{1}, "")
+	v, err := cmd.serialize(nil)
+	assert.NoError(t, err)
+	m := v.(map[string]interface{})
+	assert.Equal(t, CommandTimeSeries, m["Type"])
+	assert.Equal(t, "users/1", m["Id"])
+	assert.Equal(t, "Heartrate", m["TimeSeries"].(map[string]interface{})["Name"])
+}
+
+func TestTimeSeriesRangeCoversAndPaging(t *testing.T) {
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) *time.Time {
+		t := t0.Add(time.Duration(minutes) * time.Minute)
+		return &t
+	}
+	from, to := Time(*at(1)), Time(*at(5))
+	r := &TimeSeriesRangeResult{From: &from, To: &to}
+	assert.True(t, timeSeriesRangeCovers(r, at(1), at(5)))
+	assert.True(t, timeSeriesRangeCovers(r, at(2), at(3)))
+	assert.False(t, timeSeriesRangeCovers(r, at(0), at(3)))
+	assert.False(t, timeSeriesRangeCovers(r, at(2), nil))
+	assert.True(t, timeSeriesRangeCovers(&TimeSeriesRangeResult{}, nil, nil))
+
+	var entries []*TimeSeriesEntry
+	for i := 0; i < 6; i++ {
+		entries = append(entries, &TimeSeriesEntry{Timestamp: Time(*at(i)), Values: []float64{float64(i)}})
+	}
+	page := pageTimeSeriesEntries(entries, at(1), at(4), 1, 2)
+	assert.Equal(t, 2, len(page))
+	assert.Equal(t, 2.0, page[0].GetValue())
+	assert.Equal(t, 3.0, page[1].GetValue())
+	assert.Equal(t, 6, len(pageTimeSeriesEntries(entries, nil, nil, 0, 0)))
+}
+
+func TestTimeSeriesOperationIncrement(t *testing.T) {
+	t0 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	assert.True(t, IsIncrementalTimeSeries("INC:Downloads"))
+	assert.True(t, IsIncrementalTimeSeries("inc:Downloads"))
+	assert.False(t, IsIncrementalTimeSeries("Downloads"))
+	assert.False(t, IsIncrementalTimeSeries("INC"))
+
+	op := NewTimeSeriesOperation("INC:Downloads")
+	op.Increment(t0.Add(time.Minute), []float64{1})
+	op.Increment(t0, []float64{1})
+	op.Increment(t0, []float64{2, 5})
+	assert.NoError(t, op.validate())
+	d, err := jsonMarshal(op.serialize())
+	assert.NoError(t, err)
+	exp := `{"Appends":null,"Deletes":null,"Increments":[{"Timestamp":"2020-01-02T03:04:05.0000000Z","Values":[3,5]},{"Timestamp":"2020-01-02T03:05:05.0000000Z","Values":[1]}],"Name":"INC:Downloads"}`
+	assert.Equal(t, exp, string(d))
+
+	op.Append(t0, []float64{1}, "")
+	assert.Error(t, op.validate())
+
+	op = NewTimeSeriesOperation("Downloads")
+	op.Increment(t0, []float64{1})
+	assert.Error(t, op.validate())
+
+	op = NewTimeSeriesOperation("INC:Downloads")
+	op.Increment(t0, nil)
+	assert.Error(t, op.validate())
+}
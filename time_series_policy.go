@@ -0,0 +1,63 @@
+package ravendb
+
+import "time"
+
+// TimeSeriesRollupSeparator separates the name of a time series
+// from the name of the policy in the name of a rollup series
+const TimeSeriesRollupSeparator = "@"
+
+// TimeSeriesPolicy describes a rollup policy: values of a time series are
+// aggregated in windows of AggregationTime into a rollup series which is kept
+// for RetentionTime (zero means forever)
+type TimeSeriesPolicy struct {
+	Name            string    `json:"Name"`
+	RetentionTime   TimeValue `json:"RetentionTime"`
+	AggregationTime TimeValue `json:"AggregationTime"`
+}
+
+// NewTimeSeriesPolicy returns a new TimeSeriesPolicy
+func NewTimeSeriesPolicy(name string, aggregationTime TimeValue, retentionTime TimeValue) *TimeSeriesPolicy {
+	return &TimeSeriesPolicy{
+		Name:            name,
+		AggregationTime: aggregationTime,
+		RetentionTime:   retentionTime,
+	}
+}
+
+// GetTimeSeriesName returns name of rollup series created by this policy
+// for a raw time series, e.g. "HeartRate@By1Hour"
+func (p *TimeSeriesPolicy) GetTimeSeriesName(rawName string) string {
+	return rawName + TimeSeriesRollupSeparator + p.Name
+}
+
+// GetTimeSeriesNameForRange picks the time series to query for values starting
+// at from when a resolution of one value per resolution is good enough.
+// It returns the rollup series of the coarsest policy whose aggregation
+// window fits in resolution and which still retains data for from.
+// If no policy qualifies, it returns the raw series name.
+func GetTimeSeriesNameForRange(name string, policies []*TimeSeriesPolicy, from time.Time, resolution time.Duration) string {
+	return getTimeSeriesNameForRange(name, policies, from, resolution, time.Now())
+}
+
+func getTimeSeriesNameForRange(name string, policies []*TimeSeriesPolicy, from time.Time, resolution time.Duration, now time.Time) string {
+	var best *TimeSeriesPolicy
+	for _, policy := range policies {
+		if policy == nil || policy.AggregationTime.IsZero() {
+			continue
+		}
+		aggregation := policy.AggregationTime.approximateDuration()
+		if aggregation > resolution {
+			continue
+		}
+		if !policy.RetentionTime.IsZero() && from.Before(policy.RetentionTime.subtractFrom(now)) {
+			continue
+		}
+		if best == nil || aggregation > best.AggregationTime.approximateDuration() {
+			best = policy
+		}
+	}
+	if best == nil {
+		return name
+	}
+	return best.GetTimeSeriesName(name)
+}
@@ -0,0 +1,38 @@
+package ravendb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTimeSeriesNameForRange(t *testing.T) {
+	now := time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC)
+	policies := []*TimeSeriesPolicy{
+		NewTimeSeriesPolicy("By1Minute", TimeValueFromMinutes(1), TimeValueFromDays(2)),
+		NewTimeSeriesPolicy("By1Hour", TimeValueFromHours(1), TimeValueFromMonths(3)),
+		NewTimeSeriesPolicy("By1Day", TimeValueFromDays(1), TimeValueZero()),
+	}
+
+	tests := []struct {
+		from       time.Time
+		resolution time.Duration
+		exp        string
+	}{
+		// resolution finer than any policy
+		{now.Add(-time.Hour), time.Second, "HeartRate"},
+		{now.Add(-time.Hour), time.Minute * 5, "HeartRate@By1Minute"},
+		{now.Add(-time.Hour), time.Hour, "HeartRate@By1Hour"},
+		{now.Add(-time.Hour), time.Hour * 24 * 7, "HeartRate@By1Day"},
+		// By1Minute data is only kept for 2 days
+		{now.AddDate(0, 0, -3), time.Minute * 5, "HeartRate"},
+		// By1Hour data is only kept for 3 months, By1Day is kept forever
+		{now.AddDate(0, -4, 0), time.Hour * 2, "HeartRate"},
+		{now.AddDate(0, -4, 0), time.Hour * 24, "HeartRate@By1Day"},
+	}
+	for _, test := range tests {
+		got := getTimeSeriesNameForRange("HeartRate", policies, test.from, test.resolution, now)
+		assert.Equal(t, test.exp, got, "from: %s, resolution: %s", test.from, test.resolution)
+	}
+}
@@ -0,0 +1,228 @@
+package ravendb
+
+import (
+	"strings"
+	"time"
+)
+
+// timeSeriesQueryFunction is the name of the projection holding
+// the result of a time series query
+const timeSeriesQueryFunction = "__timeSeriesQueryFunction"
+
+// TimeSeriesAggregation is an aggregation function of time series queries
+type TimeSeriesAggregation = string
+
+const (
+	TimeSeriesAggregationMin     = "min"
+	TimeSeriesAggregationMax     = "max"
+	TimeSeriesAggregationAverage = "avg"
+	TimeSeriesAggregationSum     = "sum"
+	TimeSeriesAggregationFirst   = "first"
+	TimeSeriesAggregationLast    = "last"
+	TimeSeriesAggregationCount   = "count"
+)
+
+// TimeSeriesQueryBuilder builds a query over a time series of documents
+// returned by a query, e.g.:
+//
+//	from Heartrate between '2020-01-01T00:00:00.0000000Z' and '2020-01-02T00:00:00.0000000Z'
+//	where Tag = 'watches/1' group by '1 hour' select min(), max()
+//
+// Without GroupBy the query returns raw entries (TimeSeriesRawResult),
+// with GroupBy it returns aggregated values (TimeSeriesAggregationResult)
+//
+// By default the query is sent as an inline timeseries() projection,
+// Declare sends it as a declared time series function instead
+type TimeSeriesQueryBuilder struct {
+	name         string
+	function     string
+	alias        string
+	from         *time.Time
+	to           *time.Time
+	where        string
+	groupBy      string
+	aggregations []TimeSeriesAggregation
+	offset       string
+	raw          string
+}
+
+// From sets the name of the queried time series
+func (b *TimeSeriesQueryBuilder) From(name string) *TimeSeriesQueryBuilder {
+	b.name = name
+	return b
+}
+
+// Between limits the query to entries between from and to (inclusive)
+func (b *TimeSeriesQueryBuilder) Between(from time.Time, to time.Time) *TimeSeriesQueryBuilder {
+	b.from = &from
+	b.to = &to
+	return b
+}
+
+// Where filters entries with an RQL condition, e.g. "Tag = 'watches/1'"
+// or "Values[0] > 80"
+func (b *TimeSeriesQueryBuilder) Where(condition string) *TimeSeriesQueryBuilder {
+	b.where = condition
+	return b
+}
+
+// GroupBy aggregates entries in time windows, e.g. "1 hour" or "7 days"
+func (b *TimeSeriesQueryBuilder) GroupBy(window string) *TimeSeriesQueryBuilder {
+	b.groupBy = window
+	return b
+}
+
+// Select picks aggregations computed for every time window.
+// If not called, the server computes all of them
+func (b *TimeSeriesQueryBuilder) Select(aggregations ...TimeSeriesAggregation) *TimeSeriesQueryBuilder {
+	b.aggregations = append(b.aggregations, aggregations...)
+	return b
+}
+
+// Offset shifts timestamps of results by a time zone offset, e.g. "02:00"
+func (b *TimeSeriesQueryBuilder) Offset(offset string) *TimeSeriesQueryBuilder {
+	b.offset = offset
+	return b
+}
+
+// Declare sends the query as a time series function declared with
+// "declare timeseries function(alias)" and called from the select clause.
+// alias becomes the alias of the queried collection or index and
+// the time series is read from it, e.g.:
+//
+//	declare timeseries heartrate(u) {
+//	from u.Heartrate group by '1 hour' select max()
+//	}
+//	from Users as u select heartrate(u) as __timeSeriesQueryFunction
+func (b *TimeSeriesQueryBuilder) Declare(function string, alias string) *TimeSeriesQueryBuilder {
+	b.function = function
+	b.alias = alias
+	return b
+}
+
+// Raw sets the text of the query, overriding everything else
+func (b *TimeSeriesQueryBuilder) Raw(queryText string) *TimeSeriesQueryBuilder {
+	b.raw = queryText
+	return b
+}
+
+func isTimeSeriesNameIdentifier(name string) bool {
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !(c == '_' || c == '.' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+// queryData returns the projection of the time series query
+func (b *TimeSeriesQueryBuilder) queryData() (*QueryData, error) {
+	if b.function != "" || b.alias != "" {
+		if stringIsBlank(b.function) || !isTimeSeriesNameIdentifier(b.function) {
+			return nil, newIllegalArgumentError("Time series function name must be an identifier")
+		}
+		if stringIsBlank(b.alias) || !isTimeSeriesNameIdentifier(b.alias) {
+			return nil, newIllegalArgumentError("Time series function alias must be an identifier")
+		}
+	}
+	queryText, err := b.string()
+	if err != nil {
+		return nil, err
+	}
+	if b.function == "" {
+		return &QueryData{
+			Fields:      []string{"timeseries(" + queryText + ")"},
+			Projections: []string{timeSeriesQueryFunction},
+		}, nil
+	}
+	return &QueryData{
+		Fields:       []string{b.function + "(" + b.alias + ")"},
+		Projections:  []string{timeSeriesQueryFunction},
+		fromAlias:    b.alias,
+		declareToken: newDeclareTokenTimeSeries(b.function, queryText, b.alias),
+	}, nil
+}
+
+func (b *TimeSeriesQueryBuilder) string() (string, error) {
+	if b.raw != "" {
+		return b.raw, nil
+	}
+	if stringIsBlank(b.name) {
+		return "", newIllegalArgumentError("Time series name cannot be empty")
+	}
+	if len(b.aggregations) > 0 && b.groupBy == "" {
+		return "", newIllegalArgumentError("Aggregations require GroupBy")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("from ")
+	if b.alias != "" {
+		if !isTimeSeriesNameIdentifier(b.name) {
+			return "", newIllegalArgumentError("Time series name '%s' must be an identifier in a declared function", b.name)
+		}
+		sb.WriteString(b.alias)
+		sb.WriteString(".")
+		sb.WriteString(b.name)
+	} else if isTimeSeriesNameIdentifier(b.name) {
+		sb.WriteString(b.name)
+	} else {
+		sb.WriteString(quoteIncludeString(b.name))
+	}
+	if b.from != nil {
+		sb.WriteString(" between ")
+		sb.WriteString(timeIncludeString(b.from))
+		sb.WriteString(" and ")
+		sb.WriteString(timeIncludeString(b.to))
+	}
+	if b.where != "" {
+		sb.WriteString(" where ")
+		sb.WriteString(b.where)
+	}
+	if b.groupBy != "" {
+		sb.WriteString(" group by ")
+		sb.WriteString(quoteIncludeString(b.groupBy))
+	}
+	for i, aggregation := range b.aggregations {
+		if i == 0 {
+			sb.WriteString(" select ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(aggregation)
+		sb.WriteString("()")
+	}
+	if b.offset != "" {
+		sb.WriteString(" offset ")
+		sb.WriteString(quoteIncludeString(b.offset))
+	}
+	return sb.String(), nil
+}
+
+// TimeSeriesAggregationResult is a result of a time series query with GroupBy
+type TimeSeriesAggregationResult struct {
+	Count   int64                         `json:"Count"`
+	Results []*TimeSeriesRangeAggregation `json:"Results"`
+}
+
+// TimeSeriesRangeAggregation has aggregated values of a time window.
+// Every aggregation has a value for every value slot of the entries.
+// Aggregations not selected by the query are nil
+type TimeSeriesRangeAggregation struct {
+	From    Time      `json:"From"`
+	To      Time      `json:"To"`
+	Key     string    `json:"Key"`
+	Count   []int64   `json:"Count"`
+	Min     []float64 `json:"Min"`
+	Max     []float64 `json:"Max"`
+	Average []float64 `json:"Average"`
+	Sum     []float64 `json:"Sum"`
+	First   []float64 `json:"First"`
+	Last    []float64 `json:"Last"`
+}
+
+// TimeSeriesRawResult is a result of a time series query without GroupBy
+type TimeSeriesRawResult struct {
+	Count   int64              `json:"Count"`
+	Results []*TimeSeriesEntry `json:"Results"`
+}
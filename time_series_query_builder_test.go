@@ -0,0 +1,88 @@
+package ravendb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeSeriesQueryBuilder(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := &TimeSeriesQueryBuilder{}
+	b.From("Heartrate").Between(from, from.Add(24*time.Hour)).Where("Tag = 'watches/1'").GroupBy("1 hour").Select(TimeSeriesAggregationMin, TimeSeriesAggregationMax).Offset("02:00")
+	s, err := b.string()
+	assert.NoError(t, err)
+	exp := "from Heartrate between '2020-01-01T00:00:00.0000000Z' and '2020-01-02T00:00:00.0000000Z' where Tag = 'watches/1' group by '1 hour' select min(), max() offset '02:00'"
+	assert.Equal(t, exp, s)
+
+	s, err = (&TimeSeriesQueryBuilder{}).From("INC:Downloads").string()
+	assert.NoError(t, err)
+	assert.Equal(t, "from 'INC:Downloads'", s)
+
+	s, err = (&TimeSeriesQueryBuilder{}).Raw("from Stocks last 1 hour").string()
+	assert.NoError(t, err)
+	assert.Equal(t, "from Stocks last 1 hour", s)
+
+	_, err = (&TimeSeriesQueryBuilder{}).string()
+	assert.Error(t, err)
+	_, err = (&TimeSeriesQueryBuilder{}).From("Heartrate").Select(TimeSeriesAggregationSum).string()
+	assert.Error(t, err)
+}
+
+func TestDocumentQuerySelectTimeSeries(t *testing.T) {
+	store := NewDocumentStore([]string{"http://127.0.0.1:8080"}, "db")
+	assert.NoError(t, store.Initialize())
+	defer store.Close()
+	session, err := store.OpenSession("")
+	assert.NoError(t, err)
+	defer session.Close()
+
+	q := session.QueryCollection("Users").SelectTimeSeries(reflect.TypeOf(&TimeSeriesAggregationResult{}), func(b *TimeSeriesQueryBuilder) {
+		b.From("Heartrate").GroupBy("1 day").Select(TimeSeriesAggregationAverage)
+	})
+	iq, err := q.GetIndexQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "from Users select timeseries(from Heartrate group by '1 day' select avg()) as __timeSeriesQueryFunction", iq.query)
+
+	declared := session.QueryCollection("Users").SelectTimeSeries(reflect.TypeOf(&TimeSeriesAggregationResult{}), func(b *TimeSeriesQueryBuilder) {
+		b.Declare("heartrate", "u").From("Heartrate").GroupBy("1 day").Select(TimeSeriesAggregationAverage)
+	})
+	iq, err = declared.GetIndexQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "declare timeseries heartrate(u) {\nfrom u.Heartrate group by '1 day' select avg()\n}\nfrom Users as u select heartrate(u) as __timeSeriesQueryFunction", iq.query)
+
+	invalid := session.QueryCollection("Users").SelectTimeSeries(reflect.TypeOf(&TimeSeriesRawResult{}), func(b *TimeSeriesQueryBuilder) {
+		b.Declare("heartrate", "").From("Heartrate")
+	})
+	_, err = invalid.GetIndexQuery()
+	assert.Error(t, err)
+	invalid = session.QueryCollection("Users").SelectTimeSeries(reflect.TypeOf(&TimeSeriesRawResult{}), func(b *TimeSeriesQueryBuilder) {
+		b.Declare("heartrate", "u").From("INC:Downloads")
+	})
+	_, err = invalid.GetIndexQuery()
+	assert.Error(t, err)
+
+	document := map[string]interface{}{
+		timeSeriesQueryFunction: map[string]interface{}{
+			"Count": 2.0,
+			"Results": []interface{}{
+				map[string]interface{}{
+					"From":    "2020-01-01T00:00:00.0000000Z",
+					"To":      "2020-01-02T00:00:00.0000000Z",
+					"Count":   []interface{}{2.0},
+					"Average": []interface{}{65.0},
+				},
+			},
+		},
+	}
+	metadata := map[string]interface{}{MetadataProjection: true}
+	var result *TimeSeriesAggregationResult
+	err = queryOperationDeserialize(&result, "users/1", document, metadata, q.fieldsToFetchToken, false, session.InMemoryDocumentSessionOperations)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), result.Count)
+	assert.Equal(t, []float64{65}, result.Results[0].Average)
+	assert.Nil(t, result.Results[0].Max)
+	assert.Equal(t, []int64{2}, result.Results[0].Count)
+}
@@ -0,0 +1,10 @@
+package ravendb
+
+// TimeSeriesRangeResult describes entries of a time series in a time range.
+// From and To are nil if the range is unbounded
+type TimeSeriesRangeResult struct {
+	From         *Time              `json:"From"`
+	To           *Time              `json:"To"`
+	Entries      []*TimeSeriesEntry `json:"Entries"`
+	TotalResults int64              `json:"TotalResults"`
+}
@@ -0,0 +1,294 @@
+package ravendb
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maximum number of values of a time series entry supported by the server
+const timeSeriesMaxValues = 32
+
+// timeSeriesValueField maps a struct field to a value slot of time series entries.
+// Fields are marked with a tag `timeseries:"<index>"` or
+// `timeseries:"<index>,<name>"`, e.g.:
+//
+//	type HeartRate struct {
+//		BPM float64 `timeseries:"0"`
+//	}
+type timeSeriesValueField struct {
+	name       string
+	fieldIndex []int
+}
+
+// getTimeSeriesValueFields returns fields of a struct type (or pointer to struct)
+// ordered by value index
+func getTimeSeriesValueFields(clazz reflect.Type) ([]*timeSeriesValueField, error) {
+	if clazz == nil {
+		return nil, newIllegalArgumentError("clazz cannot be nil")
+	}
+	typ := clazz
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil, newIllegalArgumentError("%s is not a struct or a pointer to struct", clazz)
+	}
+
+	var res []*timeSeriesValueField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, ok := field.Tag.Lookup("timeseries")
+		if !ok {
+			continue
+		}
+		if field.PkgPath != "" || field.Type.Kind() != reflect.Float64 {
+			return nil, newIllegalArgumentError("field %s.%s with timeseries tag must be an exported float64", typ.Name(), field.Name)
+		}
+		name := field.Name
+		if idx := strings.IndexByte(tag, ','); idx != -1 {
+			if n := tag[idx+1:]; n != "" {
+				name = n
+			}
+			tag = tag[:idx]
+		}
+		index, err := strconv.Atoi(tag)
+		if err != nil || index < 0 || index >= timeSeriesMaxValues {
+			return nil, newIllegalArgumentError("field %s.%s has invalid timeseries index '%s'", typ.Name(), field.Name, tag)
+		}
+		for len(res) <= index {
+			res = append(res, nil)
+		}
+		if res[index] != nil {
+			return nil, newIllegalArgumentError("fields %s and %s of %s have the same timeseries index %d", res[index].name, name, typ.Name(), index)
+		}
+		res[index] = &timeSeriesValueField{
+			name:       name,
+			fieldIndex: field.Index,
+		}
+	}
+	if len(res) == 0 {
+		return nil, newIllegalArgumentError("%s has no fields with timeseries tag", typ.Name())
+	}
+	for i, f := range res {
+		if f == nil {
+			return nil, newIllegalArgumentError("%s has no field with timeseries index %d, indexes must be consecutive", typ.Name(), i)
+		}
+	}
+	return res, nil
+}
+
+// GetTimeSeriesValueNames returns names of values of time series mapped to
+// struct type clazz, ordered by value index
+func GetTimeSeriesValueNames(clazz reflect.Type) ([]string, error) {
+	fields, err := getTimeSeriesValueFields(clazz)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, len(fields))
+	for i, f := range fields {
+		res[i] = f.name
+	}
+	return res, nil
+}
+
+// timeSeriesValuesFromStruct returns values of time series entry from struct
+// (or pointer to struct) v
+func timeSeriesValuesFromStruct(clazz reflect.Type, v interface{}) ([]float64, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	typ := clazz
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if !rv.IsValid() || rv.Type() != typ {
+		return nil, newIllegalArgumentError("value must be %s, got %T", typ, v)
+	}
+	fields, err := getTimeSeriesValueFields(clazz)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]float64, len(fields))
+	for i, f := range fields {
+		res[i] = rv.FieldByIndex(f.fieldIndex).Float()
+	}
+	return res, nil
+}
+
+// timeSeriesValuesToStruct returns a pointer to a new struct clazz with
+// fields set from values. Missing values are NaN
+func timeSeriesValuesToStruct(clazz reflect.Type, values []float64) (interface{}, error) {
+	fields, err := getTimeSeriesValueFields(clazz)
+	if err != nil {
+		return nil, err
+	}
+	typ := clazz
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	rv := reflect.New(typ)
+	for i, f := range fields {
+		v := math.NaN()
+		if i < len(values) {
+			v = values[i]
+		}
+		rv.Elem().FieldByIndex(f.fieldIndex).SetFloat(v)
+	}
+	return rv.Interface(), nil
+}
+
+// TypedTimeSeriesEntry is a time series entry with values mapped to a struct
+type TypedTimeSeriesEntry struct {
+	Timestamp time.Time
+	Tag       string
+	IsRollup  bool
+	// Value is a pointer to a struct with fields set from entry values
+	Value interface{}
+}
+
+// AsTypedEntry returns the entry with values mapped to struct type clazz.
+// Rollup entries have several aggregated values for every value slot,
+// use AsTypedRollupEntry for them
+func (e *TimeSeriesEntry) AsTypedEntry(clazz reflect.Type) (*TypedTimeSeriesEntry, error) {
+	if e.IsRollup {
+		return nil, newIllegalStateError("entry at %s is a rollup entry, use AsTypedRollupEntry", time.Time(e.Timestamp).Format(time.RFC3339Nano))
+	}
+	value, err := timeSeriesValuesToStruct(clazz, e.Values)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedTimeSeriesEntry{
+		Timestamp: time.Time(e.Timestamp),
+		Tag:       e.Tag,
+		IsRollup:  e.IsRollup,
+		Value:     value,
+	}, nil
+}
+
+// number of aggregated values a rollup entry has for every value slot:
+// first, last, min, max, sum and count, in that order
+const timeSeriesRollupAggregations = 6
+
+// TypedTimeSeriesRollupEntry is a rollup time series entry with aggregated
+// values mapped to structs. Each of First, Last, Min, Max, Sum and Count
+// is a pointer to a struct whose fields are set from the given aggregation
+// of the corresponding value slot
+type TypedTimeSeriesRollupEntry struct {
+	Timestamp time.Time
+	Tag       string
+
+	First interface{}
+	Last  interface{}
+	Min   interface{}
+	Max   interface{}
+	Sum   interface{}
+	Count interface{}
+}
+
+// AsTypedRollupEntry returns a rollup entry with aggregated values mapped
+// to struct type clazz
+func (e *TimeSeriesEntry) AsTypedRollupEntry(clazz reflect.Type) (*TypedTimeSeriesRollupEntry, error) {
+	if !e.IsRollup {
+		return nil, newIllegalStateError("entry at %s is not a rollup entry", time.Time(e.Timestamp).Format(time.RFC3339Nano))
+	}
+	if len(e.Values)%timeSeriesRollupAggregations != 0 {
+		return nil, newIllegalStateError("rollup entry has %d values, expected a multiple of %d", len(e.Values), timeSeriesRollupAggregations)
+	}
+	n := len(e.Values) / timeSeriesRollupAggregations
+	var aggregated [timeSeriesRollupAggregations]interface{}
+	for k := range aggregated {
+		values := make([]float64, n)
+		for i := range values {
+			values[i] = e.Values[i*timeSeriesRollupAggregations+k]
+		}
+		value, err := timeSeriesValuesToStruct(clazz, values)
+		if err != nil {
+			return nil, err
+		}
+		aggregated[k] = value
+	}
+	return &TypedTimeSeriesRollupEntry{
+		Timestamp: time.Time(e.Timestamp),
+		Tag:       e.Tag,
+		First:     aggregated[0],
+		Last:      aggregated[1],
+		Min:       aggregated[2],
+		Max:       aggregated[3],
+		Sum:       aggregated[4],
+		Count:     aggregated[5],
+	}, nil
+}
+
+// SessionDocumentTypedTimeSeries is like SessionDocumentTimeSeries but entry
+// values are mapped to fields of a struct
+type SessionDocumentTypedTimeSeries struct {
+	timeSeries *SessionDocumentTimeSeries
+	clazz      reflect.Type
+}
+
+// TypedTimeSeriesFor returns time series name of a document with entry
+// values mapped to fields of struct type clazz marked with timeseries tag.
+// entityOrID is either a document id or an entity tracked by the session
+func (s *DocumentSession) TypedTimeSeriesFor(clazz reflect.Type, entityOrID interface{}, name string) (*SessionDocumentTypedTimeSeries, error) {
+	if _, err := getTimeSeriesValueFields(clazz); err != nil {
+		return nil, err
+	}
+	ts, err := s.TimeSeriesFor(entityOrID, name)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionDocumentTypedTimeSeries{
+		timeSeries: ts,
+		clazz:      clazz,
+	}, nil
+}
+
+// Append appends an entry with values from value, which is a struct
+// (or pointer to struct) of the type of the time series. tag is optional
+func (ts *SessionDocumentTypedTimeSeries) Append(timestamp time.Time, value interface{}, tag string) error {
+	values, err := timeSeriesValuesFromStruct(ts.clazz, value)
+	if err != nil {
+		return err
+	}
+	return ts.timeSeries.Append(timestamp, values, tag)
+}
+
+// Increment adds values from value, which is a struct (or pointer to struct)
+// of the type of the time series, to the entry at timestamp of an
+// incremental time series
+func (ts *SessionDocumentTypedTimeSeries) Increment(timestamp time.Time, value interface{}) error {
+	values, err := timeSeriesValuesFromStruct(ts.clazz, value)
+	if err != nil {
+		return err
+	}
+	return ts.timeSeries.Increment(timestamp, values)
+}
+
+// Delete deletes entries between from and to (inclusive).
+// nil from or to means the range is unbounded
+func (ts *SessionDocumentTypedTimeSeries) Delete(from *time.Time, to *time.Time) error {
+	return ts.timeSeries.Delete(from, to)
+}
+
+// Get returns entries between from and to (inclusive), skipping start
+// entries and returning at most pageSize entries (0 means no limit).
+// It returns nil if the time series doesn't exist
+func (ts *SessionDocumentTypedTimeSeries) Get(from *time.Time, to *time.Time, start int, pageSize int) ([]*TypedTimeSeriesEntry, error) {
+	entries, err := ts.timeSeries.Get(from, to, start, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	var res []*TypedTimeSeriesEntry
+	for _, e := range entries {
+		typed, err := e.AsTypedEntry(ts.clazz)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, typed)
+	}
+	return res, nil
+}
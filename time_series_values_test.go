@@ -0,0 +1,101 @@
+package ravendb
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStockPrice struct {
+	Open   float64 `timeseries:"0"`
+	Close  float64 `timeseries:"1,close"`
+	Symbol string
+}
+
+func TestTimeSeriesValueFields(t *testing.T) {
+	clazz := reflect.TypeOf(&testStockPrice{})
+	names, err := GetTimeSeriesValueNames(clazz)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Open", "close"}, names)
+
+	values, err := timeSeriesValuesFromStruct(clazz, testStockPrice{Open: 1, Close: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2}, values)
+	values, err = timeSeriesValuesFromStruct(reflect.TypeOf(testStockPrice{}), &testStockPrice{Open: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{3, 0}, values)
+	_, err = timeSeriesValuesFromStruct(clazz, 5.0)
+	assert.Error(t, err)
+
+	v, err := timeSeriesValuesToStruct(clazz, []float64{4})
+	assert.NoError(t, err)
+	price := v.(*testStockPrice)
+	assert.Equal(t, 4.0, price.Open)
+	assert.True(t, math.IsNaN(price.Close))
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	entry := &TimeSeriesEntry{Timestamp: Time(ts), Tag: "nasdaq", Values: []float64{5, 6}}
+	typed, err := entry.AsTypedEntry(clazz)
+	assert.NoError(t, err)
+	assert.Equal(t, ts, typed.Timestamp)
+	assert.Equal(t, "nasdaq", typed.Tag)
+	assert.Equal(t, &testStockPrice{Open: 5, Close: 6}, typed.Value)
+	_, err = entry.AsTypedRollupEntry(clazz)
+	assert.Error(t, err)
+}
+
+func TestTimeSeriesRollupEntryAsTyped(t *testing.T) {
+	clazz := reflect.TypeOf(&testStockPrice{})
+	entry := &TimeSeriesEntry{
+		IsRollup: true,
+		// first, last, min, max, sum and count of Open, then of Close
+		Values: []float64{1, 2, 0.5, 3, 10, 4, 11, 12, 10.5, 13, 50, 4},
+	}
+	_, err := entry.AsTypedEntry(clazz)
+	assert.Error(t, err)
+
+	rollup, err := entry.AsTypedRollupEntry(clazz)
+	assert.NoError(t, err)
+	assert.Equal(t, &testStockPrice{Open: 1, Close: 11}, rollup.First)
+	assert.Equal(t, &testStockPrice{Open: 2, Close: 12}, rollup.Last)
+	assert.Equal(t, &testStockPrice{Open: 0.5, Close: 10.5}, rollup.Min)
+	assert.Equal(t, &testStockPrice{Open: 3, Close: 13}, rollup.Max)
+	assert.Equal(t, &testStockPrice{Open: 10, Close: 50}, rollup.Sum)
+	assert.Equal(t, &testStockPrice{Open: 4, Close: 4}, rollup.Count)
+
+	entry.Values = entry.Values[:7]
+	_, err = entry.AsTypedRollupEntry(clazz)
+	assert.Error(t, err)
+}
+
+func TestTimeSeriesValueFieldsInvalid(t *testing.T) {
+	invalid := []interface{}{
+		5,
+		struct{ A float64 }{},
+		struct {
+			A float64 `timeseries:"1"`
+		}{},
+		struct {
+			A float64 `timeseries:"0"`
+			B float64 `timeseries:"0"`
+		}{},
+		struct {
+			A int `timeseries:"0"`
+		}{},
+		struct {
+			A float64 `timeseries:"x"`
+		}{},
+		struct {
+			a float64 `timeseries:"0"`
+		}{},
+	}
+	for _, v := range invalid {
+		_, err := getTimeSeriesValueFields(reflect.TypeOf(v))
+		assert.Error(t, err, "%T", v)
+	}
+	_, err := getTimeSeriesValueFields(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package ravendb
+
+import "time"
+
+// TimeValueUnit is a unit of TimeValue
+type TimeValueUnit string
+
+const (
+	TimeValueUnitNone   TimeValueUnit = "None"
+	TimeValueUnitSecond TimeValueUnit = "Second"
+	TimeValueUnitMonth  TimeValueUnit = "Month"
+)
+
+var timeValueUnitValues = []string{string(TimeValueUnitNone), string(TimeValueUnitSecond), string(TimeValueUnitMonth)}
+
+// MarshalJSON returns an error if TimeValueUnit is not one of the defined values
+func (t TimeValueUnit) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(t), "TimeValueUnit", timeValueUnitValues)
+}
+
+// TimeValue represents a time span the way the server does: either a number
+// of seconds or a number of months (months have variable length)
+type TimeValue struct {
+	Value int           `json:"Value"`
+	Unit  TimeValueUnit `json:"Unit"`
+}
+
+// TimeValueZero returns zero TimeValue
+func TimeValueZero() TimeValue {
+	return TimeValue{Unit: TimeValueUnitNone}
+}
+
+// TimeValueFromSeconds returns TimeValue of n seconds
+func TimeValueFromSeconds(n int) TimeValue {
+	return TimeValue{Value: n, Unit: TimeValueUnitSecond}
+}
+
+// TimeValueFromMinutes returns TimeValue of n minutes
+func TimeValueFromMinutes(n int) TimeValue {
+	return TimeValueFromSeconds(n * 60)
+}
+
+// TimeValueFromHours returns TimeValue of n hours
+func TimeValueFromHours(n int) TimeValue {
+	return TimeValueFromSeconds(n * 3600)
+}
+
+// TimeValueFromDays returns TimeValue of n days
+func TimeValueFromDays(n int) TimeValue {
+	return TimeValueFromSeconds(n * 24 * 3600)
+}
+
+// TimeValueFromMonths returns TimeValue of n months
+func TimeValueFromMonths(n int) TimeValue {
+	return TimeValue{Value: n, Unit: TimeValueUnitMonth}
+}
+
+// TimeValueFromYears returns TimeValue of n years
+func TimeValueFromYears(n int) TimeValue {
+	return TimeValueFromMonths(n * 12)
+}
+
+// IsZero returns true if t represents no time (e.g. infinite retention)
+func (t TimeValue) IsZero() bool {
+	return t.Value == 0
+}
+
+// subtractFrom returns tm moved back in time by t
+func (t TimeValue) subtractFrom(tm time.Time) time.Time {
+	if t.Unit == TimeValueUnitMonth {
+		return tm.AddDate(0, -t.Value, 0)
+	}
+	return tm.Add(-time.Duration(t.Value) * time.Second)
+}
+
+// approximateDuration returns duration of t, assuming months have 30 days
+func (t TimeValue) approximateDuration() time.Duration {
+	if t.Unit == TimeValueUnitMonth {
+		return time.Duration(t.Value) * 30 * 24 * time.Hour
+	}
+	return time.Duration(t.Value) * time.Second
+}
@@ -0,0 +1,104 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IServerOperation = &ToggleDatabasesStateOperation{}
+)
+
+// ToggleDatabasesStateOperation disables or enables databases.
+// Disabled databases are unloaded and reject requests
+type ToggleDatabasesStateOperation struct {
+	databaseNames []string
+	disable       bool
+
+	Command *ToggleDatabasesStateCommand
+}
+
+// NewToggleDatabasesStateOperation returns new ToggleDatabasesStateOperation
+//
+// Deprecated: use operations.NewToggleDatabasesStateOperation.
+func NewToggleDatabasesStateOperation(databaseNames []string, disable bool) (*ToggleDatabasesStateOperation, error) {
+	if len(databaseNames) == 0 {
+		return nil, newIllegalArgumentError("databaseNames cannot be empty")
+	}
+	for _, name := range databaseNames {
+		if name == "" {
+			return nil, newIllegalArgumentError("database name cannot be empty")
+		}
+	}
+	return &ToggleDatabasesStateOperation{
+		databaseNames: databaseNames,
+		disable:       disable,
+	}, nil
+}
+
+func (o *ToggleDatabasesStateOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewToggleDatabasesStateCommand(o.databaseNames, o.disable)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &ToggleDatabasesStateCommand{}
+
+// ToggleDatabasesStateCommand describes "toggle databases state" command
+type ToggleDatabasesStateCommand struct {
+	RavenCommandBase
+
+	databaseNames []string
+	disable       bool
+
+	Result []*DisableDatabaseToggleResult
+}
+
+// NewToggleDatabasesStateCommand returns new ToggleDatabasesStateCommand
+func NewToggleDatabasesStateCommand(databaseNames []string, disable bool) *ToggleDatabasesStateCommand {
+	return &ToggleDatabasesStateCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		databaseNames: databaseNames,
+		disable:       disable,
+	}
+}
+
+func (c *ToggleDatabasesStateCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/admin/databases/"
+	if c.disable {
+		url += "disable"
+	} else {
+		url += "enable"
+	}
+
+	m := map[string]interface{}{
+		"DatabaseNames": c.databaseNames,
+	}
+	d, err := jsonMarshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *ToggleDatabasesStateCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	var res struct {
+		Status []*DisableDatabaseToggleResult `json:"Status"`
+	}
+	if err := jsonUnmarshal(response, &res); err != nil {
+		return err
+	}
+	c.Result = res.Status
+	return nil
+}
+
+// DisableDatabaseToggleResult describes the result of toggling state of a database
+type DisableDatabaseToggleResult struct {
+	Disabled bool   `json:"Disabled"`
+	Name     string `json:"Name"`
+	Success  bool   `json:"Success"`
+	Reason   string `json:"Reason"`
+}
@@ -0,0 +1,104 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var (
+	_ IMaintenanceOperation = &ToggleOngoingTaskStateOperation{}
+)
+
+// ToggleOngoingTaskStateOperation enables or disables a background task
+// like ETL, replication or backup
+type ToggleOngoingTaskStateOperation struct {
+	taskID   int64
+	taskName string
+	taskType OngoingTaskType
+	disable  bool
+
+	Command *ToggleOngoingTaskStateCommand
+}
+
+// NewToggleOngoingTaskStateOperation returns new ToggleOngoingTaskStateOperation
+//
+// Deprecated: use operations.NewToggleOngoingTaskStateOperation.
+func NewToggleOngoingTaskStateOperation(taskID int64, taskType OngoingTaskType, disable bool) (*ToggleOngoingTaskStateOperation, error) {
+	if taskID <= 0 {
+		return nil, newIllegalArgumentError("taskID must be positive")
+	}
+	if taskType == "" {
+		return nil, newIllegalArgumentError("taskType cannot be empty")
+	}
+	return &ToggleOngoingTaskStateOperation{
+		taskID:   taskID,
+		taskType: taskType,
+		disable:  disable,
+	}, nil
+}
+
+// NewToggleOngoingTaskStateOperationByName returns an operation that
+// enables or disables a task with a given name
+//
+// Deprecated: use operations.NewToggleOngoingTaskStateOperationByName.
+func NewToggleOngoingTaskStateOperationByName(taskName string, taskType OngoingTaskType, disable bool) (*ToggleOngoingTaskStateOperation, error) {
+	if taskName == "" {
+		return nil, newIllegalArgumentError("taskName cannot be empty")
+	}
+	if taskType == "" {
+		return nil, newIllegalArgumentError("taskType cannot be empty")
+	}
+	return &ToggleOngoingTaskStateOperation{
+		taskName: taskName,
+		taskType: taskType,
+		disable:  disable,
+	}, nil
+}
+
+func (o *ToggleOngoingTaskStateOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewToggleOngoingTaskStateCommand(o.taskID, o.taskType, o.disable)
+	o.Command.taskName = o.taskName
+	return o.Command, nil
+}
+
+var _ RavenCommand = &ToggleOngoingTaskStateCommand{}
+
+// ToggleOngoingTaskStateCommand describes "toggle ongoing task state" command
+type ToggleOngoingTaskStateCommand struct {
+	RavenCommandBase
+
+	taskID   int64
+	taskName string
+	taskType OngoingTaskType
+	disable  bool
+
+	Result *ModifyOngoingTaskResult
+}
+
+// NewToggleOngoingTaskStateCommand returns new ToggleOngoingTaskStateCommand
+func NewToggleOngoingTaskStateCommand(taskID int64, taskType OngoingTaskType, disable bool) *ToggleOngoingTaskStateCommand {
+	return &ToggleOngoingTaskStateCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID:   taskID,
+		taskType: taskType,
+		disable:  disable,
+	}
+}
+
+func (c *ToggleOngoingTaskStateCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/tasks/state?key=" + strconv.FormatInt(c.taskID, 10) + "&type=" + urlUtilsEscapeDataString(string(c.taskType)) + "&disable=" + strconv.FormatBool(c.disable)
+	if c.taskName != "" {
+		url += "&taskName=" + urlUtilsEscapeDataString(c.taskName)
+	}
+
+	return NewHttpPost(url, nil)
+}
+
+func (c *ToggleOngoingTaskStateCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
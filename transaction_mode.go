@@ -0,0 +1,19 @@
+package ravendb
+
+// TransactionMode describes how SaveChanges commits session changes
+type TransactionMode string
+
+const (
+	// TransactionModeSingleNode commits changes on a single node (default)
+	TransactionModeSingleNode TransactionMode = "SingleNode"
+	// TransactionModeClusterWide commits changes through cluster consensus.
+	// Required to modify compare exchange values in a session
+	TransactionModeClusterWide TransactionMode = "ClusterWide"
+)
+
+var transactionModeValues = []string{string(TransactionModeSingleNode), string(TransactionModeClusterWide)}
+
+// MarshalJSON returns an error if TransactionMode is not one of the defined values
+func (t TransactionMode) MarshalJSON() ([]byte, error) {
+	return enumToJSON(string(t), "TransactionMode", transactionModeValues)
+}
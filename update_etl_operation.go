@@ -0,0 +1,80 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var (
+	_ IMaintenanceOperation = &UpdateEtlOperation{}
+)
+
+// UpdateEtlOperation replaces configuration of an existing ETL task
+type UpdateEtlOperation struct {
+	taskID        int64
+	configuration IEtlConfiguration
+
+	Command *UpdateEtlCommand
+}
+
+// NewUpdateEtlOperation returns new UpdateEtlOperation. configuration is
+// *RavenEtlConfiguration, *SqlEtlConfiguration or *OlapEtlConfiguration
+//
+// Deprecated: use operations.NewUpdateEtlOperation.
+func NewUpdateEtlOperation(taskID int64, configuration IEtlConfiguration) (*UpdateEtlOperation, error) {
+	if taskID <= 0 {
+		return nil, newIllegalArgumentError("taskID must be positive")
+	}
+	if err := validateEtlConfiguration(configuration); err != nil {
+		return nil, err
+	}
+	return &UpdateEtlOperation{
+		taskID:        taskID,
+		configuration: configuration,
+	}, nil
+}
+
+func (o *UpdateEtlOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewUpdateEtlCommand(o.taskID, o.configuration)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &UpdateEtlCommand{}
+
+// UpdateEtlCommand describes "update etl" command
+type UpdateEtlCommand struct {
+	RavenCommandBase
+
+	taskID        int64
+	configuration IEtlConfiguration
+
+	Result *EtlOperationResult
+}
+
+// NewUpdateEtlCommand returns new UpdateEtlCommand
+func NewUpdateEtlCommand(taskID int64, configuration IEtlConfiguration) *UpdateEtlCommand {
+	return &UpdateEtlCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID:        taskID,
+		configuration: configuration,
+	}
+}
+
+func (c *UpdateEtlCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/etl?id=" + strconv.FormatInt(c.taskID, 10)
+
+	d, err := jsonMarshal(c.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpPut(url, d)
+}
+
+func (c *UpdateEtlCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -8,12 +8,17 @@ var (
 	_ IMaintenanceOperation = &UpdateExternalReplicationOperation{}
 )
 
+// UpdateExternalReplicationOperation creates or updates (if TaskID is set)
+// external replication to a database described by a connection string
 type UpdateExternalReplicationOperation struct {
 	_newWatcher *ExternalReplication
 
 	Command *UpdateExternalReplicationCommand
 }
 
+// NewUpdateExternalReplicationOperation returns new UpdateExternalReplicationOperation
+//
+// Deprecated: use operations.NewUpdateExternalReplicationOperation.
 func NewUpdateExternalReplicationOperation(newWatcher *ExternalReplication) *UpdateExternalReplicationOperation {
 	return &UpdateExternalReplicationOperation{
 		_newWatcher: newWatcher,
@@ -21,6 +26,12 @@ func NewUpdateExternalReplicationOperation(newWatcher *ExternalReplication) *Upd
 }
 
 func (o *UpdateExternalReplicationOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	if o._newWatcher == nil {
+		return nil, newIllegalArgumentError("newWatcher cannot be nil")
+	}
+	if o._newWatcher.ConnectionStringName == "" {
+		return nil, newIllegalArgumentError("ConnectionStringName cannot be empty")
+	}
 	o.Command = NewUpdateExternalReplicationCommand(o._newWatcher)
 	return o.Command, nil
 }
@@ -0,0 +1,85 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &UpdatePeriodicBackupOperation{}
+)
+
+// UpdatePeriodicBackupOperationResult is a result of UpdatePeriodicBackupOperation
+type UpdatePeriodicBackupOperationResult struct {
+	RaftCommandIndex int64 `json:"RaftCommandIndex"`
+	TaskID           int64 `json:"TaskId"`
+}
+
+// UpdatePeriodicBackupOperation creates a periodic backup task
+// or updates an existing one if configuration.TaskID is set
+type UpdatePeriodicBackupOperation struct {
+	configuration *PeriodicBackupConfiguration
+
+	Command *UpdatePeriodicBackupCommand
+}
+
+// NewUpdatePeriodicBackupOperation returns new UpdatePeriodicBackupOperation
+//
+// Deprecated: use operations.NewUpdatePeriodicBackupOperation.
+func NewUpdatePeriodicBackupOperation(configuration *PeriodicBackupConfiguration) *UpdatePeriodicBackupOperation {
+	return &UpdatePeriodicBackupOperation{
+		configuration: configuration,
+	}
+}
+
+func (o *UpdatePeriodicBackupOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewUpdatePeriodicBackupCommand(o.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var _ RavenCommand = &UpdatePeriodicBackupCommand{}
+
+// UpdatePeriodicBackupCommand describes "update periodic backup" command
+type UpdatePeriodicBackupCommand struct {
+	RavenCommandBase
+
+	configuration *PeriodicBackupConfiguration
+
+	Result *UpdatePeriodicBackupOperationResult
+}
+
+// NewUpdatePeriodicBackupCommand returns new UpdatePeriodicBackupCommand
+func NewUpdatePeriodicBackupCommand(configuration *PeriodicBackupConfiguration) (*UpdatePeriodicBackupCommand, error) {
+	if configuration == nil {
+		return nil, newIllegalArgumentError("Configuration cannot be null")
+	}
+	if err := configuration.validate(); err != nil {
+		return nil, err
+	}
+	return &UpdatePeriodicBackupCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		configuration: configuration,
+	}, nil
+}
+
+func (c *UpdatePeriodicBackupCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/periodic-backup"
+
+	d, err := jsonMarshal(c.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *UpdatePeriodicBackupCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -0,0 +1,78 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &UpdatePullReplicationAsSinkOperation{}
+)
+
+// UpdatePullReplicationAsSinkOperation creates or updates (if TaskID is set)
+// a pull replication sink
+type UpdatePullReplicationAsSinkOperation struct {
+	sink *PullReplicationAsSink
+
+	Command *UpdatePullReplicationAsSinkCommand
+}
+
+// NewUpdatePullReplicationAsSinkOperation returns new UpdatePullReplicationAsSinkOperation
+//
+// Deprecated: use operations.NewUpdatePullReplicationAsSinkOperation.
+func NewUpdatePullReplicationAsSinkOperation(sink *PullReplicationAsSink) (*UpdatePullReplicationAsSinkOperation, error) {
+	if sink == nil {
+		return nil, newIllegalArgumentError("sink cannot be nil")
+	}
+	if err := sink.validate(); err != nil {
+		return nil, err
+	}
+	return &UpdatePullReplicationAsSinkOperation{
+		sink: sink,
+	}, nil
+}
+
+func (o *UpdatePullReplicationAsSinkOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewUpdatePullReplicationAsSinkCommand(o.sink)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &UpdatePullReplicationAsSinkCommand{}
+
+// UpdatePullReplicationAsSinkCommand describes "update pull replication sink" command
+type UpdatePullReplicationAsSinkCommand struct {
+	RavenCommandBase
+
+	sink *PullReplicationAsSink
+
+	Result *ModifyOngoingTaskResult
+}
+
+// NewUpdatePullReplicationAsSinkCommand returns new UpdatePullReplicationAsSinkCommand
+func NewUpdatePullReplicationAsSinkCommand(sink *PullReplicationAsSink) *UpdatePullReplicationAsSinkCommand {
+	return &UpdatePullReplicationAsSinkCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		sink: sink,
+	}
+}
+
+func (c *UpdatePullReplicationAsSinkCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/tasks/sink-pull-replication"
+
+	m := map[string]interface{}{
+		"PullReplicationAsSink": c.sink,
+	}
+	d, err := jsonMarshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *UpdatePullReplicationAsSinkCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -71,6 +71,12 @@ func builderWriteInt(b *strings.Builder, n int) {
 	b.WriteString(strconv.Itoa(n))
 }
 
+// builderWriteFloat64 writes f without losing precision (%f would turn
+// e.g. a boost of 0.0000001 into 0.000000). Always includes a decimal point
 func builderWriteFloat64(b *strings.Builder, f float64) {
-	b.WriteString(fmt.Sprintf("%f", f))
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	b.WriteString(s)
 }
@@ -112,3 +112,21 @@ func TestDbg(t *testing.T) {
 	assert.Equal(t, s, "v: 5\n")
 	_ = os.Remove(path)
 }
+
+func TestBuilderWriteFloat64(t *testing.T) {
+	tests := []struct {
+		f   float64
+		exp string
+	}{
+		{3, "3.0"},
+		{0.5, "0.5"},
+		{1.25, "1.25"},
+		{0.0000001, "0.0000001"},
+		{-2, "-2.0"},
+	}
+	for _, test := range tests {
+		var b strings.Builder
+		builderWriteFloat64(&b, test.f)
+		assert.Equal(t, test.exp, b.String())
+	}
+}